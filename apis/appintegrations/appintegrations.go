@@ -0,0 +1,79 @@
+package appintegrations
+
+import (
+	"context"
+	"encoding/json"
+	"go/types"
+	"net/http"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/appIntegrations/2024-04-01"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.AppintegrationsClient for tests.
+type Client interface {
+	CreateNotification(ctx context.Context, request *CreateNotificationRequest) (*apis.CallResponse[CreateNotificationResponse], error)
+	DeleteNotifications(ctx context.Context, request *DeleteNotificationsRequest) error
+	RecordActionFeedback(ctx context.Context, notificationID string, request *RecordActionFeedbackRequest) error
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// CreateNotification creates a notification for the selling partner that is shown in Seller Central's
+// app notification center.
+func (a *API) CreateNotification(ctx context.Context, request *CreateNotificationRequest) (*apis.CallResponse[CreateNotificationResponse], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[CreateNotificationResponse](http.MethodPost, pathPrefix+"/notifications").
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// DeleteNotifications removes all notifications of the given template for the selling partner.
+func (a *API) DeleteNotifications(ctx context.Context, request *DeleteNotificationsRequest) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	_, err = apis.NewCall[types.Nil](http.MethodPost, pathPrefix+"/notifications/deletion").
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+	return err
+}
+
+// RecordActionFeedback records the selling partner's response to the notification with the given notificationID.
+func (a *API) RecordActionFeedback(ctx context.Context, notificationID string, request *RecordActionFeedbackRequest) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	_, err = apis.NewCall[types.Nil](http.MethodPost, pathPrefix+"/notifications/{notificationID}/feedback").
+		WithPathParam("notificationID", notificationID).
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+	return err
+}
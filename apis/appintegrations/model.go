@@ -0,0 +1,46 @@
+package appintegrations
+
+import "github.com/fond-of-vertigo/amazon-sp-api/constants"
+
+// DeletionReason The unique identifier that maps each notification status to a reason code.
+type DeletionReason string
+
+const (
+	DeletionReasonIncorrectContent   DeletionReason = "INCORRECT_CONTENT"
+	DeletionReasonIncorrectRecipient DeletionReason = "INCORRECT_RECIPIENT"
+)
+
+// FeedbackActionCode The unique identifier for each notification status.
+type FeedbackActionCode string
+
+const (
+	FeedbackActionCodeSellerActionCompleted FeedbackActionCode = "SELLER_ACTION_COMPLETED"
+)
+
+// CreateNotificationRequest The request for the CreateNotification operation.
+type CreateNotificationRequest struct {
+	// The unique identifier of the notification template you used to onboard your application.
+	TemplateID string `json:"templateId"`
+	// The dynamic parameters required by the notification templated specified by templateId.
+	NotificationParameters map[string]any `json:"notificationParameters"`
+	// An encrypted marketplace identifier for the posted notification.
+	MarketplaceID *constants.MarketplaceID `json:"marketplaceId,omitempty"`
+}
+
+// CreateNotificationResponse The response for the CreateNotification operation.
+type CreateNotificationResponse struct {
+	// The unique identifier assigned to each notification.
+	NotificationID *string `json:"notificationId,omitempty"`
+}
+
+// DeleteNotificationsRequest The request for the DeleteNotifications operation.
+type DeleteNotificationsRequest struct {
+	// The unique identifier of the notification template you used to onboard your application.
+	TemplateID     string         `json:"templateId"`
+	DeletionReason DeletionReason `json:"deletionReason"`
+}
+
+// RecordActionFeedbackRequest The request for the RecordActionFeedback operation.
+type RecordActionFeedbackRequest struct {
+	FeedbackActionCode FeedbackActionCode `json:"feedbackActionCode"`
+}
@@ -0,0 +1,44 @@
+package appmanagement
+
+import (
+	"context"
+	"go/types"
+	"net/http"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/applications/2023-11-30"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.AppmanagementClient for tests.
+type Client interface {
+	RotateApplicationClientSecret(ctx context.Context) error
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// RotateApplicationClientSecret rotates the LWA client secret of the application. This is a grantless operation,
+// the required access token for the client_credential:rotation scope is fetched automatically.
+// The new client secret is not returned, it is delivered asynchronously with an
+// APPLICATION_OAUTH_CLIENT_NEW_SECRET notification to the application's SQS destination.
+func (a *API) RotateApplicationClientSecret(ctx context.Context) error {
+	_, err := apis.NewCall[types.Nil](http.MethodPost, pathPrefix+"/clientSecret").
+		WithGrantlessScope(constants.ScopeClientCredentialRotation).
+		WithParseErrorListOnError().
+		WithRateLimit(0.0167, time.Second).
+		Execute(ctx, a.httpClient)
+	return err
+}
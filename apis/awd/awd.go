@@ -0,0 +1,75 @@
+package awd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/awd/2024-05-09"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.AwdClient for tests.
+type Client interface {
+	GetInboundShipment(ctx context.Context, shipmentID string, skuQuantities SkuQuantitiesVisibility) (*apis.CallResponse[InboundShipment], error)
+	ListInboundShipments(ctx context.Context, filter *ListInboundShipmentsFilter) (*apis.CallResponse[ShipmentListing], error)
+	ListInventory(ctx context.Context, filter *ListInventoryFilter) (*apis.CallResponse[InventoryListing], error)
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// GetInboundShipment returns the inbound shipment with the given shipmentID. Use skuQuantities to
+// include or exclude the SKU quantity details.
+func (a *API) GetInboundShipment(ctx context.Context, shipmentID string, skuQuantities SkuQuantitiesVisibility) (*apis.CallResponse[InboundShipment], error) {
+	params := url.Values{}
+	if skuQuantities != "" {
+		params.Add("skuQuantities", string(skuQuantities))
+	}
+
+	return apis.NewCall[InboundShipment](http.MethodGet, pathPrefix+"/inboundShipments/{shipmentID}").
+		WithPathParam("shipmentID", shipmentID).
+		WithQueryParams(params).
+		WithParseErrorListOnError().
+		WithRateLimit(2, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// ListInboundShipments returns a summary of the AWD inbound shipments matching the filter.
+func (a *API) ListInboundShipments(ctx context.Context, filter *ListInboundShipmentsFilter) (*apis.CallResponse[ShipmentListing], error) {
+	if filter.MaxResults != 0 && (filter.MaxResults < 1 || filter.MaxResults > 200) {
+		return nil, errors.New("maxResults must be between 1 and 200")
+	}
+
+	return apis.NewCall[ShipmentListing](http.MethodGet, pathPrefix+"/inboundShipments").
+		WithQueryParams(filter.GetQuery()).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// ListInventory returns the AWD inventory of the selling partner.
+func (a *API) ListInventory(ctx context.Context, filter *ListInventoryFilter) (*apis.CallResponse[InventoryListing], error) {
+	if filter.MaxResults != 0 && (filter.MaxResults < 1 || filter.MaxResults > 200) {
+		return nil, errors.New("maxResults must be between 1 and 200")
+	}
+
+	return apis.NewCall[InventoryListing](http.MethodGet, pathPrefix+"/inventory").
+		WithQueryParams(filter.GetQuery()).
+		WithParseErrorListOnError().
+		WithRateLimit(2, time.Second).
+		Execute(ctx, a.httpClient)
+}
@@ -0,0 +1,350 @@
+package awd
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/internal/utils"
+)
+
+// InboundShipmentStatus The possible shipment statuses for inbound shipments.
+type InboundShipmentStatus string
+
+const (
+	InboundShipmentStatusCreated   InboundShipmentStatus = "CREATED"
+	InboundShipmentStatusShipped   InboundShipmentStatus = "SHIPPED"
+	InboundShipmentStatusInTransit InboundShipmentStatus = "IN_TRANSIT"
+	InboundShipmentStatusReceiving InboundShipmentStatus = "RECEIVING"
+	InboundShipmentStatusDelivered InboundShipmentStatus = "DELIVERED"
+	InboundShipmentStatusClosed    InboundShipmentStatus = "CLOSED"
+	InboundShipmentStatusCancelled InboundShipmentStatus = "CANCELLED"
+)
+
+// InventoryUnitOfMeasurement Unit of measurement for the inventory.
+type InventoryUnitOfMeasurement string
+
+const (
+	InventoryUnitOfMeasurementProductUnits InventoryUnitOfMeasurement = "PRODUCT_UNITS"
+	InventoryUnitOfMeasurementCases        InventoryUnitOfMeasurement = "CASES"
+	InventoryUnitOfMeasurementPallets      InventoryUnitOfMeasurement = "PALLETS"
+)
+
+// DistributionPackageType Type of distribution packages.
+type DistributionPackageType string
+
+const (
+	DistributionPackageTypeCase   DistributionPackageType = "CASE"
+	DistributionPackageTypePallet DistributionPackageType = "PALLET"
+)
+
+// DimensionUnitOfMeasurement Unit of measurement for package dimensions.
+type DimensionUnitOfMeasurement string
+
+const (
+	DimensionUnitOfMeasurementInches      DimensionUnitOfMeasurement = "IN"
+	DimensionUnitOfMeasurementCentimeters DimensionUnitOfMeasurement = "CM"
+)
+
+// WeightUnitOfMeasurement Unit of measurement for the package weight.
+type WeightUnitOfMeasurement string
+
+const (
+	WeightUnitOfMeasurementPounds    WeightUnitOfMeasurement = "POUNDS"
+	WeightUnitOfMeasurementKilograms WeightUnitOfMeasurement = "KILOGRAMS"
+)
+
+// VolumeUnitOfMeasurement Unit of measurement for the package volume.
+type VolumeUnitOfMeasurement string
+
+const (
+	VolumeUnitOfMeasurementCubicInches      VolumeUnitOfMeasurement = "CU_IN"
+	VolumeUnitOfMeasurementCubicFeet        VolumeUnitOfMeasurement = "CBFT"
+	VolumeUnitOfMeasurementCubicCentimeters VolumeUnitOfMeasurement = "CBCM"
+)
+
+// SkuQuantitiesVisibility Enum to specify if returned shipment should include SKU quantity details.
+type SkuQuantitiesVisibility string
+
+const (
+	SkuQuantitiesVisibilityShow SkuQuantitiesVisibility = "SHOW"
+	SkuQuantitiesVisibilityHide SkuQuantitiesVisibility = "HIDE"
+)
+
+// InventoryDetailsVisibility Enum to specify if returned summaries should include additional summarized inventory details and quantities.
+type InventoryDetailsVisibility string
+
+const (
+	InventoryDetailsVisibilityShow InventoryDetailsVisibility = "SHOW"
+	InventoryDetailsVisibilityHide InventoryDetailsVisibility = "HIDE"
+)
+
+// SortOrder Sort order for a collection of items.
+type SortOrder string
+
+const (
+	SortOrderAscending  SortOrder = "ASCENDING"
+	SortOrderDescending SortOrder = "DESCENDING"
+)
+
+// ShipmentSortableField Denotes the field name on which the shipments are to be sorted.
+type ShipmentSortableField string
+
+const (
+	ShipmentSortableFieldUpdatedAt ShipmentSortableField = "UPDATED_AT"
+	ShipmentSortableFieldCreatedAt ShipmentSortableField = "CREATED_AT"
+)
+
+// ListInboundShipmentsFilter is used to filter the ListInboundShipments call.
+type ListInboundShipmentsFilter struct {
+	SortBy    ShipmentSortableField
+	SortOrder SortOrder
+	// ShipmentStatus filters the shipments by status.
+	ShipmentStatus InboundShipmentStatus
+	// UpdatedAfter returns only shipments updated after the given time.
+	UpdatedAfter apis.JsonTimeISO8601
+	// UpdatedBefore returns only shipments updated before the given time.
+	UpdatedBefore apis.JsonTimeISO8601
+	// MaxResults is the maximum number of results to return (1 to 200, defaults to 25).
+	MaxResults int
+	// NextToken is the token to fetch the next page of results.
+	NextToken string
+}
+
+func (f *ListInboundShipmentsFilter) GetQuery() url.Values {
+	q := url.Values{}
+	utils.AddToQueryIfSet(q, "sortBy", string(f.SortBy))
+	utils.AddToQueryIfSet(q, "sortOrder", string(f.SortOrder))
+	utils.AddToQueryIfSet(q, "shipmentStatus", string(f.ShipmentStatus))
+	utils.AddToQueryIfSet(q, "updatedAfter", f.UpdatedAfter.String())
+	utils.AddToQueryIfSet(q, "updatedBefore", f.UpdatedBefore.String())
+	if f.MaxResults != 0 {
+		q.Add("maxResults", strconv.Itoa(f.MaxResults))
+	}
+	utils.AddToQueryIfSet(q, "nextToken", f.NextToken)
+	return q
+}
+
+// ListInventoryFilter is used to filter the ListInventory call.
+type ListInventoryFilter struct {
+	// SKU filters the results by the given SKU.
+	SKU       string
+	SortOrder SortOrder
+	// Details specifies if the returned summaries should include the inventory details.
+	Details InventoryDetailsVisibility
+	// NextToken is the token to fetch the next page of results.
+	NextToken string
+	// MaxResults is the maximum number of results to return (1 to 200, defaults to 25).
+	MaxResults int
+}
+
+func (f *ListInventoryFilter) GetQuery() url.Values {
+	q := url.Values{}
+	utils.AddToQueryIfSet(q, "sku", f.SKU)
+	utils.AddToQueryIfSet(q, "sortOrder", string(f.SortOrder))
+	utils.AddToQueryIfSet(q, "details", string(f.Details))
+	utils.AddToQueryIfSet(q, "nextToken", f.NextToken)
+	if f.MaxResults != 0 {
+		q.Add("maxResults", strconv.Itoa(f.MaxResults))
+	}
+	return q
+}
+
+// Address Shipping address that represents the origin or destination location.
+type Address struct {
+	// First line of the address text.
+	AddressLine1 string `json:"addressLine1"`
+	// Optional second line of the address text.
+	AddressLine2 *string `json:"addressLine2,omitempty"`
+	// Optional third line of the address text.
+	AddressLine3 *string `json:"addressLine3,omitempty"`
+	// Optional city where this address is located.
+	City *string `json:"city,omitempty"`
+	// Two-digit, ISO 3166-1 alpha-2 formatted country code where this address is located.
+	CountryCode string `json:"countryCode"`
+	// Optional county where this address is located.
+	County *string `json:"county,omitempty"`
+	// Optional district where this address is located.
+	District *string `json:"district,omitempty"`
+	// Name of the person, business, or institution at this address.
+	Name string `json:"name"`
+	// Optional E.164-formatted phone number for an available contact at this address.
+	PhoneNumber *string `json:"phoneNumber,omitempty"`
+	// Optional postal code where this address is located.
+	PostalCode *string `json:"postalCode,omitempty"`
+	// State or region where this address is located.
+	StateOrRegion string `json:"stateOrRegion"`
+}
+
+// InventoryQuantity Quantity of inventory with an associated measurement unit context.
+type InventoryQuantity struct {
+	// Quantity of the respective inventory.
+	Quantity          float64                    `json:"quantity"`
+	UnitOfMeasurement InventoryUnitOfMeasurement `json:"unitOfMeasurement"`
+}
+
+// PackageDimensions Dimensions of the package.
+type PackageDimensions struct {
+	Height            float64                    `json:"height"`
+	Length            float64                    `json:"length"`
+	Width             float64                    `json:"width"`
+	UnitOfMeasurement DimensionUnitOfMeasurement `json:"unitOfMeasurement"`
+}
+
+// PackageVolume Represents the volume of the package with a unit of measurement.
+type PackageVolume struct {
+	UnitOfMeasurement VolumeUnitOfMeasurement `json:"unitOfMeasurement"`
+	Volume            float64                 `json:"volume"`
+}
+
+// PackageWeight Represents the weight of the package with a unit of measurement.
+type PackageWeight struct {
+	UnitOfMeasurement WeightUnitOfMeasurement `json:"unitOfMeasurement"`
+	Weight            float64                 `json:"weight"`
+}
+
+// MeasurementData Package weight and dimension.
+type MeasurementData struct {
+	Dimensions *PackageDimensions `json:"dimensions,omitempty"`
+	Volume     *PackageVolume     `json:"volume,omitempty"`
+	Weight     PackageWeight      `json:"weight"`
+}
+
+// ProductAttribute Product instance attribute that is not described at the SKU level in the catalog.
+type ProductAttribute struct {
+	// Product attribute name.
+	Name *string `json:"name,omitempty"`
+	// Product attribute value.
+	Value *string `json:"value,omitempty"`
+}
+
+// ProductQuantity Represents a product with the SKU details and the corresponding quantity.
+type ProductQuantity struct {
+	// Attributes for this instance of the product.
+	Attributes []ProductAttribute `json:"attributes,omitempty"`
+	// Product quantity.
+	Quantity int `json:"quantity"`
+	// The seller or merchant SKU.
+	SKU string `json:"sku"`
+}
+
+// DistributionPackageContents Represents the contents inside a package, which can be products or a nested package.
+type DistributionPackageContents struct {
+	// This is required only when DistributionPackageType is PALLET.
+	Packages []DistributionPackageQuantity `json:"packages,omitempty"`
+	// This is required only when DistributionPackageType is CASE.
+	Products []ProductQuantity `json:"products,omitempty"`
+}
+
+// DistributionPackage Represents an AWD distribution package.
+type DistributionPackage struct {
+	Contents     DistributionPackageContents `json:"contents"`
+	Measurements MeasurementData             `json:"measurements"`
+	Type         DistributionPackageType     `json:"type"`
+}
+
+// DistributionPackageQuantity Represents a distribution package with its respective quantity.
+type DistributionPackageQuantity struct {
+	// Number of cases or pallets with the same package configuration.
+	Count               int                 `json:"count"`
+	DistributionPackage DistributionPackage `json:"distributionPackage"`
+}
+
+// SkuQuantity Quantity details for a SKU as part of a shipment.
+type SkuQuantity struct {
+	ExpectedQuantity InventoryQuantity  `json:"expectedQuantity"`
+	ReceivedQuantity *InventoryQuantity `json:"receivedQuantity,omitempty"`
+	// The merchant stock keeping unit.
+	SKU string `json:"sku"`
+}
+
+// InboundShipment Represents an AWD inbound shipment.
+type InboundShipment struct {
+	// Timestamp when the shipment was created.
+	CreatedAt          *time.Time `json:"createdAt,omitempty"`
+	DestinationAddress Address    `json:"destinationAddress"`
+	// Client-provided reference ID that can correlate this shipment to client resources.
+	ExternalReferenceID *string `json:"externalReferenceId,omitempty"`
+	// The AWD inbound order ID that this inbound shipment belongs to.
+	OrderID       string  `json:"orderId"`
+	OriginAddress Address `json:"originAddress"`
+	// Quantity received (at the receiving end) as part of this shipment.
+	ReceivedQuantity []InventoryQuantity `json:"receivedQuantity,omitempty"`
+	// Timestamp when the shipment will be shipped.
+	ShipBy *time.Time `json:"shipBy,omitempty"`
+	// Packages that are part of this shipment.
+	ShipmentContainerQuantities []DistributionPackageQuantity `json:"shipmentContainerQuantities"`
+	// Unique shipment ID.
+	ShipmentID string `json:"shipmentId"`
+	// Quantity details at SKU level for the shipment.
+	ShipmentSKUQuantities []SkuQuantity         `json:"shipmentSkuQuantities,omitempty"`
+	ShipmentStatus        InboundShipmentStatus `json:"shipmentStatus"`
+	// Carrier-unique tracking ID for this shipment.
+	TrackingID *string `json:"trackingId,omitempty"`
+	// Timestamp when the shipment was updated.
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	// An AWD-provided reference ID that you can use to interact with the warehouse.
+	WarehouseReferenceID *string `json:"warehouseReferenceId,omitempty"`
+}
+
+// InboundShipmentSummary Summary for an AWD inbound shipment containing the shipment ID.
+type InboundShipmentSummary struct {
+	// Timestamp when the shipment was created.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	// Optional client-provided reference ID.
+	ExternalReferenceID *string `json:"externalReferenceId,omitempty"`
+	// The AWD inbound order ID that this inbound shipment belongs to.
+	OrderID string `json:"orderId"`
+	// A unique shipment ID.
+	ShipmentID     string                `json:"shipmentId"`
+	ShipmentStatus InboundShipmentStatus `json:"shipmentStatus"`
+	// Timestamp when the shipment was updated.
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// ShipmentListing A list of inbound shipment summaries filtered by the attributes specified in the request.
+type ShipmentListing struct {
+	// A token that is used to retrieve the next page of results.
+	NextToken *string                  `json:"nextToken,omitempty"`
+	Shipments []InboundShipmentSummary `json:"shipments,omitempty"`
+}
+
+// ExpirationDetails The expiration details of the inventory.
+type ExpirationDetails struct {
+	// The expiration date of the SKU.
+	Expiration *time.Time `json:"expiration,omitempty"`
+	// The quantity that is present in AWD.
+	OnhandQuantity *int `json:"onhandQuantity,omitempty"`
+}
+
+// InventoryDetails Additional inventory details.
+type InventoryDetails struct {
+	// Quantity that is available for downstream channel replenishment.
+	AvailableDistributableQuantity *int `json:"availableDistributableQuantity,omitempty"`
+	// Quantity that is in transit from AWD and has not yet been received at FBA.
+	ReplenishmentQuantity *int `json:"replenishmentQuantity,omitempty"`
+	// Quantity that is reserved for a downstream channel replenishment order that is being prepared for shipment.
+	ReservedDistributableQuantity *int `json:"reservedDistributableQuantity,omitempty"`
+}
+
+// InventorySummary Summary of inventory per SKU.
+type InventorySummary struct {
+	// The expiration details of the inventory.
+	ExpirationDetails []ExpirationDetails `json:"expirationDetails,omitempty"`
+	InventoryDetails  *InventoryDetails   `json:"inventoryDetails,omitempty"`
+	// The seller or merchant SKU.
+	SKU string `json:"sku"`
+	// Total quantity that is in-transit from the seller and has not yet been received at an AWD Distribution Center.
+	TotalInboundQuantity *int `json:"totalInboundQuantity,omitempty"`
+	// Total quantity that is present in AWD distribution centers.
+	TotalOnhandQuantity *int `json:"totalOnhandQuantity,omitempty"`
+}
+
+// InventoryListing AWD inventory payload.
+type InventoryListing struct {
+	// List of inventory summaries.
+	Inventory []InventorySummary `json:"inventory"`
+	// A token that is used to retrieve the next page of results.
+	NextToken *string `json:"nextToken,omitempty"`
+}
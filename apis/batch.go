@@ -0,0 +1,79 @@
+package apis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// BatchFunc is one item of a batch, typically a closure executing a Call, e.g.
+//
+//	func(ctx context.Context) (*apis.CallResponse[orders.GetOrderItemsResponse], error) {
+//		return client.OrdersAPI.GetOrderItems(ctx, orderID, nil, nil)
+//	}
+type BatchFunc[T any] func(ctx context.Context) (T, error)
+
+// BatchResult is the outcome of one BatchFunc.
+type BatchResult[T any] struct {
+	Value T
+	Err   error
+}
+
+// BatchResults are the results of ExecuteBatch in the order of the calls.
+type BatchResults[T any] []BatchResult[T]
+
+// Err joins the errors of all failed items, or returns nil if all succeeded.
+func (r BatchResults[T]) Err() error {
+	var errs []error
+	for i, result := range r {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("batch item %d: %w", i, result.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ExecuteBatch executes the calls with up to concurrency at a time and returns their results in the
+// order of calls. A failing item doesn't stop the others. Once ctx is done, the calls not started yet
+// fail with the error of ctx without being executed.
+//
+// The calls don't need to schedule themselves: a Call waits for the rate limiter of the HTTP client
+// for its operation, so concurrency only bounds the calls in flight while the limiter paces them to
+// the rate and burst of the operation.
+func ExecuteBatch[T any](ctx context.Context, calls []BatchFunc[T], concurrency int) BatchResults[T] {
+	results := make(BatchResults[T], len(calls))
+	slots := make(chan struct{}, max(1, concurrency))
+	var wg sync.WaitGroup
+	for i, call := range calls {
+		if !acquireSlot(ctx, slots) {
+			for j := i; j < len(calls); j++ {
+				results[j].Err = ctx.Err()
+			}
+			break
+		}
+		wg.Add(1)
+		go func(result *BatchResult[T], call BatchFunc[T]) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			result.Value, result.Err = call(ctx)
+		}(&results[i], call)
+	}
+	wg.Wait()
+	return results
+}
+
+// acquireSlot blocks until there is room in slots, or returns false if ctx is done first.
+func acquireSlot(ctx context.Context, slots chan struct{}) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	select {
+	case slots <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
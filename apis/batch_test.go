@@ -0,0 +1,85 @@
+package apis
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestExecuteBatch(t *testing.T) {
+	errOdd := errors.New("odd")
+	var running, maxRunning atomic.Int32
+	calls := make([]BatchFunc[int], 10)
+	for i := range calls {
+		i := i
+		calls[i] = func(context.Context) (int, error) {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				peak := maxRunning.Load()
+				if n <= peak || maxRunning.CompareAndSwap(peak, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			if i%2 == 1 {
+				return 0, errOdd
+			}
+			return i * i, nil
+		}
+	}
+
+	results := ExecuteBatch(context.Background(), calls, 3)
+
+	if len(results) != len(calls) {
+		t.Fatalf("got %d results, want %d", len(results), len(calls))
+	}
+	for i, result := range results {
+		if i%2 == 1 {
+			if !errors.Is(result.Err, errOdd) {
+				t.Errorf("results[%d].Err = %v, want %v", i, result.Err, errOdd)
+			}
+			continue
+		}
+		if result.Err != nil || result.Value != i*i {
+			t.Errorf("results[%d] = %d, %v, want %d", i, result.Value, result.Err, i*i)
+		}
+	}
+	if peak := maxRunning.Load(); peak > 3 {
+		t.Errorf("%d calls ran at once, want at most 3", peak)
+	}
+	if err := results.Err(); !errors.Is(err, errOdd) {
+		t.Errorf("Err() = %v, want it to wrap %v", err, errOdd)
+	}
+}
+
+func TestExecuteBatch_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var executed atomic.Int32
+	calls := make([]BatchFunc[int], 5)
+	for i := range calls {
+		calls[i] = func(ctx context.Context) (int, error) {
+			if executed.Add(1) == 2 {
+				cancel()
+			}
+			return 1, nil
+		}
+	}
+
+	results := ExecuteBatch(ctx, calls, 1)
+
+	if n := executed.Load(); n != 2 {
+		t.Errorf("%d calls were executed, want 2", n)
+	}
+	for i, result := range results[2:] {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("results[%d].Err = %v, want %v", i+2, result.Err, context.Canceled)
+		}
+	}
+	if err := (BatchResults[int]{{Value: 1}}).Err(); err != nil {
+		t.Errorf("Err() of successful results = %v, want nil", err)
+	}
+}
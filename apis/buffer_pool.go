@@ -0,0 +1,32 @@
+package apis
+
+import (
+	"bytes"
+	"sync"
+)
+
+// maxPooledBufferSize keeps buffers of exceptionally large responses out of the pool, so that
+// the pool doesn't hold on to their memory.
+const maxPooledBufferSize = 1 << 20
+
+// bufferPool recycles the buffers the response bodies are read into, which reduces the garbage of
+// services making thousands of calls per minute. Request bodies aren't pooled: encoding/json pools
+// its encoding state already and the body must stay valid while the transport may still send it.
+var bufferPool = sync.Pool{
+	New: func() any {
+		return new(bytes.Buffer)
+	},
+}
+
+func getBuffer() *bytes.Buffer {
+	return bufferPool.Get().(*bytes.Buffer)
+}
+
+// putBuffer returns buf to the pool. Its content must not be used anymore.
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBufferSize {
+		return
+	}
+	buf.Reset()
+	bufferPool.Put(buf)
+}
@@ -2,10 +2,15 @@ package apis
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"runtime"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/fond-of-vertigo/amazon-sp-api/constants"
@@ -14,35 +19,146 @@ import (
 type HTTPClient interface {
 	Do(*http.Request) (*http.Response, error)
 	GetEndpoint() constants.Endpoint
-	Close()
+	Close(ctx context.Context) error
 }
+
+// GrantlessHTTPClient is implemented by HTTP clients which can fetch access tokens for grantless operations.
+type GrantlessHTTPClient interface {
+	GetGrantlessAccessToken(ctx context.Context, scope constants.Scope) (string, error)
+}
+
+// RateLimitedHTTPClient is implemented by HTTP clients which limit the calls per operation
+// to the rate and burst of its usage plan.
+type RateLimitedHTTPClient interface {
+	WaitForRateLimit(ctx context.Context, operation string, interval time.Duration, burst int) error
+	// ObserveRateLimit adjusts the rate limit to the value of the x-amzn-RateLimit-Limit header.
+	ObserveRateLimit(operation string, callsPerSecond float64)
+}
+
 type CallResponse[responseBodyType any] struct {
-	Status       int
+	// Status is the HTTP status code of the response.
+	Status int
+	// Header contains the response headers, e.g. the x-amzn-RateLimit-Limit of the operation.
+	Header http.Header
+	// RequestID is the x-amzn-RequestId of the response, which Amazon support asks for.
+	RequestID    string
 	ResponseBody *responseBodyType
 	ErrorList    *ErrorList
+	// RawBody contains the undecoded response body if the call was created WithRawResponseBody.
+	RawBody []byte
+	// Body streams the response body if the call was created WithResponseBodyStream.
+	// ResponseBody is not decoded then, the caller must close Body.
+	Body io.ReadCloser
 }
 type Call[responseType any] struct {
-	Method                  string
-	URL                     string
+	Method string
+	URL    string
+	// PathParams replace the {name} placeholders of URL with escaped values, see WithPathParam.
+	PathParams              map[string]string
 	QueryParams             url.Values
 	Body                    []byte
+	Header                  http.Header
 	RestrictedDataToken     *string
+	GrantlessScope          constants.Scope
 	ParseErrorListOnError   bool
 	WaitDurationOnRateLimit time.Duration
+	// Burst is the number of calls of the operation which may be sent at once.
+	Burst int
+	// Operation identifies the operation for rate limiting, e.g. "orders.GetOrders".
+	Operation string
+	// Timeout limits the duration of Execute including retries. Zero means only the context applies.
+	Timeout time.Duration
+	// KeepRawResponseBody retains the response body in CallResponse.RawBody.
+	KeepRawResponseBody bool
+	// StreamResponseBody returns successful response bodies undecoded in CallResponse.Body.
+	StreamResponseBody bool
+	// BodyReader streams the request body instead of Body, see WithBodyReader.
+	BodyReader io.Reader
+	// BodyLength is the size of BodyReader, or -1 if unknown.
+	BodyLength int64
+	bodyStart  int64
+	// IdempotencyToken is sent as x-amzn-idempotency-token with every attempt, see WithIdempotencyToken.
+	IdempotencyToken string
+	// DecodeOptions replace the DecodeOptions of the HTTP client for this call, see WithDecodeOptions.
+	DecodeOptions *DecodeOptions
+	// Deprecation is the notice reported for calls of a deprecated operation, see WithDeprecation.
+	Deprecation string
 }
 
+// NewCall creates a call of the operation implemented by the calling function,
+// e.g. "orders.GetOrders" for calls created in orders.(*API).GetOrders.
 func NewCall[responseType any](method string, url string) *Call[responseType] {
 	return &Call[responseType]{
 		Method:                  method,
 		URL:                     url,
 		WaitDurationOnRateLimit: constants.DefaultWaitDurationOnTooManyRequestsError,
+		Burst:                   1,
+		Operation:               callingOperation(),
+	}
+}
+
+// callingOperation returns the package and function name of the caller of NewCall. Packages of
+// an API version keep the name of their parent, e.g. "finances/v2024.ListTransactions".
+func callingOperation() string {
+	pc := make([]uintptr, 1)
+	if runtime.Callers(3, pc) == 0 {
+		return ""
 	}
+	frame, _ := runtime.CallersFrames(pc).Next()
+	return operationName(frame.Function)
+}
+
+// operationName returns the operation of a function name like
+// "github.com/fond-of-vertigo/amazon-sp-api/apis/orders.(*API).GetOrders".
+func operationName(function string) string {
+	start := strings.LastIndex(function, "/") + 1
+	if isVersionElement(function[start:]) && start > 1 {
+		start = strings.LastIndex(function[:start-1], "/") + 1
+	}
+	return strings.Replace(function[start:], "(*API).", "", 1)
+}
+
+// isVersionElement reports whether the last element of a package path is an API version like "v2024".
+func isVersionElement(name string) bool {
+	return len(name) > 1 && name[0] == 'v' && name[1] >= '0' && name[1] <= '9'
+}
+
+type operationContextKey struct{}
+
+// OperationFromContext returns the operation of the call which created the request with the
+// context, e.g. "orders.GetOrders", or an empty string.
+func OperationFromContext(ctx context.Context) string {
+	operation, _ := ctx.Value(operationContextKey{}).(string)
+	return operation
 }
 
 // sleeper func as type for mocking
-type sleeper func(d time.Duration)
+type sleeper func(ctx context.Context, d time.Duration) error
+
+var sleepFunc sleeper = sleepContext
 
-var sleepFunc sleeper = time.Sleep
+// sleepContext waits for the duration d or until the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// WithPathParam replaces the placeholder {name} in the URL of the call with the escaped value,
+// so that IDs like SKUs containing "/", "#" or spaces stay a single path segment.
+func (a *Call[responseType]) WithPathParam(name, value string) *Call[responseType] {
+	if a.PathParams == nil {
+		a.PathParams = map[string]string{}
+	}
+	a.PathParams[name] = value
+	return a
+}
 
 func (a *Call[responseType]) WithQueryParams(queryParams url.Values) *Call[responseType] {
 	a.QueryParams = queryParams
@@ -54,12 +170,49 @@ func (a *Call[responseType]) WithBody(body []byte) *Call[responseType] {
 	return a
 }
 
+// WithBodyReader streams the request body from r instead of buffering it like WithBody, e.g. for
+// large payloads read from disk. contentLength is the size of the body, or -1 if unknown.
+// Failed calls are only retried if r implements io.Seeker, so that the body can be sent again.
+func (a *Call[responseType]) WithBodyReader(r io.Reader, contentLength int64) *Call[responseType] {
+	a.BodyReader = r
+	a.BodyLength = contentLength
+	if seeker, ok := r.(io.Seeker); ok {
+		a.bodyStart, _ = seeker.Seek(0, io.SeekCurrent)
+	}
+	return a
+}
+
+// WithHeader adds an additional header to the request, e.g. operation specific signature headers.
+// Callers of API methods can add headers with ContextWithHeader instead.
+func (a *Call[responseType]) WithHeader(key, value string) *Call[responseType] {
+	if a.Header == nil {
+		a.Header = http.Header{}
+	}
+	a.Header.Add(key, value)
+	return a
+}
+
+// WithIdempotencyToken sends a generated x-amzn-idempotency-token, which stays the same for all
+// retries of the call, so that retried POSTs don't create duplicates. A token added to the context
+// with ContextWithHeader or to the call with WithHeader takes precedence.
+func (a *Call[responseType]) WithIdempotencyToken() *Call[responseType] {
+	a.IdempotencyToken = NewIdempotencyToken()
+	return a
+}
+
 // WithRestrictedDataToken is optional and can be passed to replace the existing accessToken
 func (a *Call[responseType]) WithRestrictedDataToken(token *string) *Call[responseType] {
 	a.RestrictedDataToken = token
 	return a
 }
 
+// WithGrantlessScope marks the call as grantless operation. Instead of the seller's access token,
+// it is authorized with an access token of the LWA client-credentials flow for the given scope.
+func (a *Call[responseType]) WithGrantlessScope(scope constants.Scope) *Call[responseType] {
+	a.GrantlessScope = scope
+	return a
+}
+
 func (a *Call[responseType]) WithParseErrorListOnError() *Call[responseType] {
 	a.ParseErrorListOnError = true
 	return a
@@ -70,75 +223,327 @@ func (a *Call[responseType]) WithRateLimit(callsPer float32, duration time.Durat
 	return a
 }
 
-// Execute will return response object on success
-func (a *Call[responseType]) Execute(httpClient HTTPClient) (*CallResponse[responseType], error) {
-	resp, err := a.execute(httpClient)
+// WithRawResponseBody retains the undecoded response body in CallResponse.RawBody, e.g. for
+// non-JSON payloads or to debug decoding failures.
+func (a *Call[responseType]) WithRawResponseBody() *Call[responseType] {
+	a.KeepRawResponseBody = true
+	return a
+}
+
+// WithResponseBodyStream returns the body of successful responses in CallResponse.Body instead of
+// decoding it, e.g. for large or non-JSON payloads. Error responses are handled as usual.
+func (a *Call[responseType]) WithResponseBodyStream() *Call[responseType] {
+	a.StreamResponseBody = true
+	return a
+}
+
+// WithDecodeOptions decodes the response body with opts instead of the DecodeOptions of the HTTP client.
+func (a *Call[responseType]) WithDecodeOptions(opts DecodeOptions) *Call[responseType] {
+	a.DecodeOptions = &opts
+	return a
+}
+
+// WithTimeout limits the duration of the call including retries, in addition to the deadline of
+// the context passed to Execute.
+func (a *Call[responseType]) WithTimeout(timeout time.Duration) *Call[responseType] {
+	a.Timeout = timeout
+	return a
+}
+
+// WithBurst sets the burst of the operation's usage plan, i.e. the number of calls which may be
+// sent at once before the rate limit applies. Defaults to 1.
+func (a *Call[responseType]) WithBurst(burst int) *Call[responseType] {
+	a.Burst = burst
+	return a
+}
+
+// Execute will return response object on success. The context is used for the request
+// and for waiting between retries after a rate limit error.
+// If the response body can't be decoded, the response is returned along with the error.
+// Errors are wrapped in a *CallError with the operation, status and request ID.
+func (a *Call[responseType]) Execute(ctx context.Context, httpClient HTTPClient) (*CallResponse[responseType], error) {
+	callResp, err := a.executeAndDecode(ctx, httpClient)
+	if err == nil {
+		return callResp, nil
+	}
+
+	var callErr *CallError
+	if !errors.As(err, &callErr) {
+		callErr = &CallError{Err: err}
+		if callResp != nil {
+			callErr.StatusCode = callResp.Status
+			callErr.RequestID = callResp.RequestID
+		}
+	}
+	callErr.Operation = a.Operation
+	return callResp, callErr
+}
+
+func (a *Call[responseType]) executeAndDecode(ctx context.Context, httpClient HTTPClient) (*CallResponse[responseType], error) {
+	cancel := context.CancelFunc(func() {})
+	if a.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, a.Timeout)
+	}
+
+	resp, err := a.execute(ctx, httpClient)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 
 	callResp := &CallResponse[responseType]{
-		Status: resp.StatusCode,
+		Status:    resp.StatusCode,
+		Header:    resp.Header,
+		RequestID: resp.Header.Get(constants.RequestIDHeader),
+	}
+	a.reportDeprecation(httpClient, resp.Header)
+
+	if a.StreamResponseBody && !callResp.IsError() {
+		callResp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+		return callResp, nil
+	}
+	defer cancel()
+	defer resp.Body.Close()
+
+	buf := getBuffer()
+	defer putBuffer(buf)
+	if _, err = buf.ReadFrom(resp.Body); err != nil {
+		return nil, err
+	}
+	body := buf.Bytes()
+	if a.KeepRawResponseBody {
+		callResp.RawBody = bytes.Clone(body)
 	}
 
 	if callResp.IsError() {
-		err = fmt.Errorf("request with URL=%v returned with non-OK statuscode=%d", a.URL, callResp.Status)
+		apiErr := &APIError{StatusCode: callResp.Status, RequestID: callResp.RequestID, URL: a.URL}
 		if a.ParseErrorListOnError {
-			if parseErr := unmarshalBody(resp, &callResp.ErrorList); parseErr != nil {
-				return nil, errors.Join(err, parseErr)
+			if parseErr := decodeBody(body, &callResp.ErrorList, DecodeOptions{}); parseErr != nil {
+				return callResp, errors.Join(apiErr, parseErr)
+			}
+			if callResp.ErrorList != nil {
+				apiErr.Errors = callResp.ErrorList.Errors
 			}
-
-			return callResp, errors.Join(err, mapErrorListToError(callResp.ErrorList))
 		}
 
-		return callResp, err
+		return callResp, apiErr
 	}
 
-	if err = unmarshalBody(resp, &callResp.ResponseBody); err != nil {
-		return nil, err
+	opts := decodeOptionsOf(httpClient)
+	if a.DecodeOptions != nil {
+		opts = *a.DecodeOptions
+	}
+	if err = decodeBody(body, &callResp.ResponseBody, opts); err != nil {
+		return callResp, err
 	}
 	return callResp, nil
 }
 
-func (a *Call[responseType]) execute(httpClient HTTPClient) (*http.Response, error) {
-	for attempts := 0; attempts < constants.MaxRetryCountOnTooManyRequestsError; attempts++ {
-		req, err := a.createNewRequest(httpClient.GetEndpoint())
-		if err != nil {
-			return nil, err
+// cancelOnClose cancels the context of a streamed response body once it is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+func (a *Call[responseType]) execute(ctx context.Context, httpClient HTTPClient) (*http.Response, error) {
+	accessToken, err := a.accessToken(ctx, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := retryPolicyOf(httpClient)
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		resp, err := a.send(ctx, httpClient, accessToken)
+		retryable := err == nil && isRetryableStatus(resp.StatusCode) ||
+			err != nil && isTransientNetworkError(ctx, err)
+		if !retryable || !a.canResendBody() {
+			return resp, err
 		}
 
-		resp, err := httpClient.Do(req)
-		if err != nil {
+		event := RetryEvent{Operation: a.Operation, Attempt: attempt, Err: err}
+		if err == nil {
+			event.StatusCode = resp.StatusCode
+			event.Throttled = resp.StatusCode == http.StatusTooManyRequests
+		}
+		wait := policy.backoff(attempt)
+		if event.Throttled {
+			wait = max(wait, a.WaitDurationOnRateLimit)
+		}
+		if attempt >= policy.MaxAttempts || policy.MaxElapsedTime > 0 && time.Since(start)+wait > policy.MaxElapsedTime {
+			event.GaveUp = true
+			policy.notify(event)
+			return a.giveUp(resp, err, attempt)
+		}
+		event.Wait = wait
+		policy.notify(event)
+
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		if err = sleepFunc(ctx, wait); err != nil {
 			return nil, err
 		}
+	}
+}
+
+// send waits for the rate limiter and sends the request once.
+func (a *Call[responseType]) send(ctx context.Context, httpClient HTTPClient, accessToken string) (*http.Response, error) {
+	if err := a.waitForRateLimit(ctx, httpClient); err != nil {
+		return nil, err
+	}
+
+	req, err := a.createNewRequest(ctx, httpClient.GetEndpoint(), accessToken)
+	if err != nil {
+		return nil, err
+	}
 
-		if resp.StatusCode == http.StatusTooManyRequests {
-			sleepFunc(a.WaitDurationOnRateLimit)
-			continue
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	a.observeRateLimit(httpClient, resp)
+	return resp, nil
+}
+
+// giveUp returns the result of the last attempt once the RetryPolicy is exhausted. Calls which are
+// still rate limited fail with ErrMaxRetryCountReached, other responses are returned as they are.
+func (a *Call[responseType]) giveUp(resp *http.Response, err error, attempts int) (*http.Response, error) {
+	if err != nil {
+		return nil, fmt.Errorf("giving up after %d attempts: %w", attempts, err)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		_ = resp.Body.Close()
+		return nil, &CallError{
+			StatusCode: resp.StatusCode,
+			RequestID:  resp.Header.Get(constants.RequestIDHeader),
+			Err:        fmt.Errorf("%w after %d attempts", ErrMaxRetryCountReached, attempts),
 		}
+	}
+	return resp, nil
+}
 
-		return resp, nil
+// waitForRateLimit waits for the rate limiter of the HTTP client, if it has one.
+func (a *Call[responseType]) waitForRateLimit(ctx context.Context, httpClient HTTPClient) error {
+	limitedClient, ok := httpClient.(RateLimitedHTTPClient)
+	if !ok {
+		return nil
 	}
+	return limitedClient.WaitForRateLimit(ctx, a.Operation, a.WaitDurationOnRateLimit, a.Burst)
+}
 
-	return nil, ErrMaxRetryCountReached
+// observeRateLimit passes the x-amzn-RateLimit-Limit header of the response to the rate limiter
+// of the HTTP client, if it has one.
+func (a *Call[responseType]) observeRateLimit(httpClient HTTPClient, resp *http.Response) {
+	limitedClient, ok := httpClient.(RateLimitedHTTPClient)
+	if !ok {
+		return
+	}
+	if limit, err := strconv.ParseFloat(resp.Header.Get(constants.RateLimitHeader), 64); err == nil {
+		limitedClient.ObserveRateLimit(a.Operation, limit)
+	}
 }
 
-func (a *Call[responseType]) createNewRequest(endpoint constants.Endpoint) (*http.Request, error) {
-	callURL, err := url.Parse(string(endpoint) + a.URL)
+// accessToken returns the token replacing the seller's access token: the grantless access token
+// for grantless calls, the restrictedDataToken if set, otherwise an empty string.
+func (a *Call[responseType]) accessToken(ctx context.Context, httpClient HTTPClient) (string, error) {
+	if a.GrantlessScope != "" {
+		grantlessClient, ok := httpClient.(GrantlessHTTPClient)
+		if !ok {
+			return "", fmt.Errorf("http client does not support grantless operations of scope %s", a.GrantlessScope)
+		}
+		return grantlessClient.GetGrantlessAccessToken(ctx, a.GrantlessScope)
+	}
+	if a.RestrictedDataToken != nil {
+		return *a.RestrictedDataToken, nil
+	}
+	return "", nil
+}
+
+func (a *Call[responseType]) createNewRequest(ctx context.Context, endpoint constants.Endpoint, accessToken string) (*http.Request, error) {
+	callURL, err := url.Parse(string(endpoint) + a.path())
 	if err != nil {
 		return nil, err
 	}
 	callURL.RawQuery = a.QueryParams.Encode()
+	ctx = context.WithValue(ctx, operationContextKey{}, a.Operation)
+
+	if a.BodyReader != nil {
+		return a.createStreamingRequest(ctx, callURL.String(), accessToken)
+	}
 
-	req, err := http.NewRequest(a.Method, callURL.String(), bytes.NewBuffer(a.Body))
+	req, err := http.NewRequestWithContext(ctx, a.Method, callURL.String(), bytes.NewBuffer(a.Body))
 	if err == nil {
-		if a.RestrictedDataToken != nil && *a.RestrictedDataToken != "" {
-			req.Header.Add(constants.AccessTokenHeader, *a.RestrictedDataToken)
-		}
+		a.addHeaders(ctx, req, accessToken)
 	}
 	return req, err
 }
 
+// path returns the URL of the call with the placeholders replaced by the escaped PathParams.
+func (a *Call[responseType]) path() string {
+	if len(a.PathParams) == 0 {
+		return a.URL
+	}
+	replacements := make([]string, 0, 2*len(a.PathParams))
+	for name, value := range a.PathParams {
+		replacements = append(replacements, "{"+name+"}", url.PathEscape(value))
+	}
+	return strings.NewReplacer(replacements...).Replace(a.URL)
+}
+
+// createStreamingRequest creates a request reading the body from BodyReader. Seekable readers are
+// rewound, so that the request can be sent again.
+func (a *Call[responseType]) createStreamingRequest(ctx context.Context, callURL string, accessToken string) (*http.Request, error) {
+	seeker, seekable := a.BodyReader.(io.Seeker)
+	if seekable {
+		if _, err := seeker.Seek(a.bodyStart, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, a.Method, callURL, io.NopCloser(a.BodyReader))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = a.BodyLength
+	if seekable {
+		req.GetBody = func() (io.ReadCloser, error) {
+			_, err := seeker.Seek(a.bodyStart, io.SeekStart)
+			return io.NopCloser(a.BodyReader), err
+		}
+	}
+	a.addHeaders(ctx, req, accessToken)
+	return req, nil
+}
+
+// canResendBody reports whether the request body can be sent again for a retry.
+func (a *Call[responseType]) canResendBody() bool {
+	_, seekable := a.BodyReader.(io.Seeker)
+	return a.BodyReader == nil || seekable
+}
+
+// addHeaders adds the headers of the context and the call and the access token to the request.
+func (a *Call[responseType]) addHeaders(ctx context.Context, req *http.Request, accessToken string) {
+	for _, header := range []http.Header{HeaderFromContext(ctx), a.Header} {
+		for key, values := range header {
+			for _, value := range values {
+				req.Header.Add(key, value)
+			}
+		}
+	}
+	if a.IdempotencyToken != "" && req.Header.Get(constants.IdempotencyTokenHeader) == "" {
+		req.Header.Set(constants.IdempotencyTokenHeader, a.IdempotencyToken)
+	}
+	if accessToken != "" {
+		req.Header.Add(constants.AccessTokenHeader, accessToken)
+	}
+}
+
 // IsSuccess checks if the status is in range 2xx
 func (r *CallResponse[any]) IsSuccess() bool {
 	return r.Status >= http.StatusOK && r.Status < http.StatusMultipleChoices
@@ -2,11 +2,16 @@ package apis
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strings"
+	"syscall"
 	"testing"
 	"time"
 
@@ -32,7 +37,8 @@ func (r *dummyHTTPClient) Do(req *http.Request) (*http.Response, error) {
 func (r *dummyHTTPClient) GetEndpoint() constants.Endpoint {
 	return r.endpoint
 }
-func (r *dummyHTTPClient) Close() {
+func (r *dummyHTTPClient) Close(context.Context) error {
+	return nil
 }
 
 func Test_call_Execute(t *testing.T) {
@@ -179,7 +185,7 @@ func Test_call_Execute(t *testing.T) {
 				call = call.WithRestrictedDataToken(&tt.args.restrictedDataToken)
 			}
 
-			got, err := call.Execute(client)
+			got, err := call.Execute(context.Background(), client)
 
 			// then:
 			if (err != nil) != tt.wantErr {
@@ -234,6 +240,588 @@ func Test_call_Execute(t *testing.T) {
 	}
 }
 
+func Test_call_ExecuteCancelledWhileRateLimited(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := &dummyHTTPClient{
+		endpoint: constants.Europe,
+		resp: &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+		},
+	}
+	_, err := NewCall[dummyBody](http.MethodGet, "/test").
+		WithRateLimit(0.0167, time.Second).
+		Execute(ctx, client)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Execute() error = '%v', want context.Canceled", err)
+	}
+}
+
+func Test_call_ExecuteReturnsAPIError(t *testing.T) {
+	client := &dummyHTTPClient{
+		endpoint: constants.Europe,
+		resp: &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     http.Header{"X-Amzn-Requestid": []string{"b4a3d7c2-1f5e-4c3a-9a0b-6d2e8f7c1a90"}},
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+		},
+	}
+	got, err := NewCall[dummyBody](http.MethodGet, "/test").Execute(context.Background(), client)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Execute() error = '%v', want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.RequestID != "b4a3d7c2-1f5e-4c3a-9a0b-6d2e8f7c1a90" {
+		t.Errorf("Execute(): got APIError %+v", apiErr)
+	}
+	if got.Status != http.StatusNotFound || got.RequestID != "b4a3d7c2-1f5e-4c3a-9a0b-6d2e8f7c1a90" {
+		t.Errorf("Execute(): got status %d and request ID '%s'", got.Status, got.RequestID)
+	}
+	var callErr *CallError
+	if !errors.As(err, &callErr) || callErr.Operation != "apis.Test_call_ExecuteReturnsAPIError" || callErr.RequestID != apiErr.RequestID {
+		t.Errorf("Execute() error = '%v', want *CallError with operation and request ID", err)
+	}
+}
+
+func Test_call_ExecuteWrapsErrors(t *testing.T) {
+	client := &dummyHTTPClient{endpoint: constants.Europe, resp: &http.Response{}, errResp: errors.New("connection refused")}
+	_, err := NewCall[dummyBody](http.MethodGet, "/test").Execute(context.Background(), client)
+	var callErr *CallError
+	if !errors.As(err, &callErr) || callErr.StatusCode != 0 {
+		t.Fatalf("Execute() error = '%v', want *CallError without status", err)
+	}
+	if want := "apis.Test_call_ExecuteWrapsErrors failed: connection refused"; err.Error() != want {
+		t.Errorf("Execute() error = '%v', want '%v'", err, want)
+	}
+}
+
+func Test_call_ExecuteWithContextHeader(t *testing.T) {
+	client := &dummyHTTPClient{
+		endpoint: constants.Europe,
+		resp: &http.Response{
+			StatusCode: http.StatusNoContent,
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+		},
+	}
+	ctx := ContextWithHeader(context.Background(), "x-amzn-shipping-business-id", "AmazonShipping_UK")
+	_, err := NewCall[dummyBody](http.MethodPost, "/shipping/v2/shipments").
+		WithHeader("x-amzn-idempotency-token", "token-1").
+		Execute(ctx, client)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = '%v'", err)
+	}
+	if got := client.req.Header.Get("x-amzn-shipping-business-id"); got != "AmazonShipping_UK" {
+		t.Errorf("Execute(): header of context different. got = '%v'", got)
+	}
+	if got := client.req.Header.Get("x-amzn-idempotency-token"); got != "token-1" {
+		t.Errorf("Execute(): header of call different. got = '%v'", got)
+	}
+}
+
+func Test_call_ExecuteWithPathParam(t *testing.T) {
+	client := &dummyHTTPClient{
+		endpoint: constants.Europe,
+		resp: &http.Response{
+			StatusCode: http.StatusNoContent,
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+		},
+	}
+	_, err := NewCall[dummyBody](http.MethodGet, "/fba/smallAndLight/v1/enrollments/{sellerSKU}").
+		WithPathParam("sellerSKU", "BOX/10 #2 red").
+		WithQueryParams(url.Values{"marketplaceIds": {"A1PA6795UKMFR9"}}).
+		Execute(context.Background(), client)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = '%v'", err)
+	}
+	want := string(constants.Europe) + "/fba/smallAndLight/v1/enrollments/BOX%2F10%20%232%20red?marketplaceIds=A1PA6795UKMFR9"
+	if got := client.req.URL.String(); got != want {
+		t.Errorf("Execute(): URL different. got = '%v', want = '%v'", got, want)
+	}
+	if got := OperationFromContext(client.req.Context()); got != "apis.Test_call_ExecuteWithPathParam" {
+		t.Errorf("Execute(): operation of request context = '%v'", got)
+	}
+}
+
+func Test_call_ExecuteRawAndStreamedBody(t *testing.T) {
+	newClient := func(body string) *dummyHTTPClient {
+		return &dummyHTTPClient{
+			endpoint: constants.Europe,
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			},
+		}
+	}
+
+	got, err := NewCall[dummyBody](http.MethodGet, "/test").
+		WithRawResponseBody().
+		Execute(context.Background(), newClient(`{"Message":"All ok"`))
+	if err == nil || got == nil || string(got.RawBody) != `{"Message":"All ok"` {
+		t.Errorf("Execute() = %v, '%v', want the raw body along with the decoding error", got, err)
+	}
+
+	got, err = NewCall[dummyBody](http.MethodGet, "/test").
+		WithResponseBodyStream().
+		WithTimeout(time.Minute).
+		Execute(context.Background(), newClient("%PDF-1.7"))
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = '%v'", err)
+	}
+	defer got.Body.Close()
+	body, err := io.ReadAll(got.Body)
+	if err != nil || string(body) != "%PDF-1.7" || got.ResponseBody != nil {
+		t.Errorf("Execute(): streamed body = '%s', error = '%v'", body, err)
+	}
+}
+
+func Test_call_ExecuteKeepsRawBodyOfPooledBuffer(t *testing.T) {
+	execute := func(body string) *CallResponse[dummyBody] {
+		got, err := NewCall[dummyBody](http.MethodGet, "/test").
+			WithRawResponseBody().
+			Execute(context.Background(), &dummyHTTPClient{
+				endpoint: constants.Europe,
+				resp:     &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))},
+			})
+		if err != nil {
+			t.Fatalf("Execute() unexpected error = '%v'", err)
+		}
+		return got
+	}
+
+	first := execute(`{"Message":"first"}`)
+	second := execute(`{"Message":"other"}`)
+	if string(first.RawBody) != `{"Message":"first"}` || first.ResponseBody.Message != "first" {
+		t.Errorf("Execute(): first response changed to '%s', %+v", first.RawBody, first.ResponseBody)
+	}
+	if string(second.RawBody) != `{"Message":"other"}` {
+		t.Errorf("Execute(): RawBody = '%s'", second.RawBody)
+	}
+}
+
+func Test_call_ExecuteWithBodyReader(t *testing.T) {
+	defer func(f sleeper) { sleepFunc = f }(sleepFunc)
+	sleepFunc = func(_ context.Context, _ time.Duration) error { return nil }
+
+	var bodies []string
+	client := &sequenceHTTPClient{dummyHTTPClient: dummyHTTPClient{endpoint: constants.Europe}, statusCodes: []int{http.StatusServiceUnavailable, http.StatusOK}}
+	recording := &recordingHTTPClient{sequenceHTTPClient: client, bodies: &bodies}
+
+	payload := strings.NewReader("skip:" + `{"large":"payload"}`)
+	_, _ = payload.Seek(int64(len("skip:")), io.SeekStart)
+	_, err := NewCall[dummyBody](http.MethodPost, "/test").
+		WithBodyReader(payload, int64(len(`{"large":"payload"}`))).
+		Execute(context.Background(), recording)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = '%v'", err)
+	}
+	if want := []string{`{"large":"payload"}`, `{"large":"payload"}`}; !reflect.DeepEqual(bodies, want) {
+		t.Errorf("Execute(): sent bodies %q, want %q", bodies, want)
+	}
+
+	bodies = nil
+	client.calls = 0
+	_, err = NewCall[dummyBody](http.MethodPost, "/test").
+		WithBodyReader(io.MultiReader(strings.NewReader("unseekable")), -1).
+		Execute(context.Background(), recording)
+	if err == nil || len(bodies) != 1 {
+		t.Errorf("Execute() error = '%v' after %d attempts, want no retry of unseekable bodies", err, len(bodies))
+	}
+}
+
+type recordingHTTPClient struct {
+	*sequenceHTTPClient
+	bodies *[]string
+}
+
+func (r *recordingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	body, _ := io.ReadAll(req.Body)
+	*r.bodies = append(*r.bodies, string(body))
+	return r.sequenceHTTPClient.Do(req)
+}
+
+type headerRecordingHTTPClient struct {
+	*sequenceHTTPClient
+	values []string
+}
+
+func (r *headerRecordingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	r.values = append(r.values, strings.Join(req.Header.Values(constants.IdempotencyTokenHeader), ","))
+	return r.sequenceHTTPClient.Do(req)
+}
+
+func Test_call_ExecuteWithIdempotencyToken(t *testing.T) {
+	defer func(f sleeper) { sleepFunc = f }(sleepFunc)
+	sleepFunc = func(_ context.Context, _ time.Duration) error { return nil }
+
+	client := &headerRecordingHTTPClient{sequenceHTTPClient: &sequenceHTTPClient{
+		dummyHTTPClient: dummyHTTPClient{endpoint: constants.Europe},
+		statusCodes:     []int{http.StatusServiceUnavailable, http.StatusOK},
+	}}
+	_, err := NewCall[dummyBody](http.MethodPost, "/test").
+		WithIdempotencyToken().
+		Execute(context.Background(), client)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = '%v'", err)
+	}
+	if len(client.values) != 2 || client.values[0] == "" || client.values[0] != client.values[1] {
+		t.Errorf("Execute(): idempotency tokens %q, want the same token for all attempts", client.values)
+	}
+
+	client.values = nil
+	client.calls = 1
+	ctx := ContextWithHeader(context.Background(), constants.IdempotencyTokenHeader, "order-4711")
+	_, _ = NewCall[dummyBody](http.MethodPost, "/test").
+		WithIdempotencyToken().
+		Execute(ctx, client)
+	if want := []string{"order-4711"}; !reflect.DeepEqual(client.values, want) {
+		t.Errorf("Execute(): idempotency tokens %q, want %q of the context", client.values, want)
+	}
+}
+
+type blockingHTTPClient struct {
+	dummyHTTPClient
+}
+
+func (r *blockingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	<-req.Context().Done()
+	return nil, req.Context().Err()
+}
+
+func Test_call_ExecuteWithTimeout(t *testing.T) {
+	client := &blockingHTTPClient{dummyHTTPClient{endpoint: constants.Europe}}
+	_, err := NewCall[dummyBody](http.MethodGet, "/test").
+		WithTimeout(10*time.Millisecond).
+		Execute(context.Background(), client)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Execute() error = '%v', want context.DeadlineExceeded", err)
+	}
+}
+
+type dummyGrantlessHTTPClient struct {
+	dummyHTTPClient
+	scope constants.Scope
+}
+
+func (r *dummyGrantlessHTTPClient) GetGrantlessAccessToken(_ context.Context, scope constants.Scope) (string, error) {
+	r.scope = scope
+	return "GRANTLESS-TOKEN", nil
+}
+
+func Test_call_ExecuteGrantless(t *testing.T) {
+	client := &dummyGrantlessHTTPClient{dummyHTTPClient: dummyHTTPClient{
+		endpoint: constants.Europe,
+		resp: &http.Response{
+			StatusCode: http.StatusNoContent,
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+		},
+	}}
+	_, err := NewCall[dummyBody](http.MethodGet, "/notifications/v1/destinations").
+		WithGrantlessScope(constants.ScopeNotifications).
+		Execute(context.Background(), client)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = '%v'", err)
+	}
+	if client.scope != constants.ScopeNotifications {
+		t.Errorf("Execute(): scope different. got = '%v', want '%v'", client.scope, constants.ScopeNotifications)
+	}
+	if got := client.req.Header.Get(constants.AccessTokenHeader); got != "GRANTLESS-TOKEN" {
+		t.Errorf("Execute(): AccessTokenHeader different. got = '%v', want 'GRANTLESS-TOKEN'", got)
+	}
+
+	_, err = NewCall[dummyBody](http.MethodGet, "/notifications/v1/destinations").
+		WithGrantlessScope(constants.ScopeNotifications).
+		Execute(context.Background(), &client.dummyHTTPClient)
+	if err == nil {
+		t.Error("Execute() should fail for clients without grantless support")
+	}
+}
+
+type dummyRateLimitedHTTPClient struct {
+	dummyHTTPClient
+	operation string
+	interval  time.Duration
+	burst     int
+	observed  float64
+}
+
+func (r *dummyRateLimitedHTTPClient) ObserveRateLimit(_ string, callsPerSecond float64) {
+	r.observed = callsPerSecond
+}
+
+func (r *dummyRateLimitedHTTPClient) WaitForRateLimit(_ context.Context, operation string, interval time.Duration, burst int) error {
+	r.operation, r.interval, r.burst = operation, interval, burst
+	return nil
+}
+
+func Test_call_ExecuteWaitsForRateLimit(t *testing.T) {
+	client := &dummyRateLimitedHTTPClient{dummyHTTPClient: dummyHTTPClient{
+		endpoint: constants.Europe,
+		resp: &http.Response{
+			StatusCode: http.StatusNoContent,
+			Header:     http.Header{"X-Amzn-Ratelimit-Limit": []string{"0.0055"}},
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+		},
+	}}
+	_, err := NewCall[dummyBody](http.MethodGet, "/test").
+		WithRateLimit(2, time.Second).
+		WithBurst(15).
+		Execute(context.Background(), client)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = '%v'", err)
+	}
+	if client.operation != "apis.Test_call_ExecuteWaitsForRateLimit" || client.interval != 500*time.Millisecond || client.burst != 15 {
+		t.Errorf("Execute(): WaitForRateLimit(%s, %v, %d) different", client.operation, client.interval, client.burst)
+	}
+	if client.observed != 0.0055 {
+		t.Errorf("Execute(): observed rate limit different. got = '%v', want '0.0055'", client.observed)
+	}
+}
+
+type dummyDeprecationReporter struct {
+	dummyHTTPClient
+	operation string
+	notice    string
+}
+
+func (r *dummyDeprecationReporter) ReportDeprecation(operation, notice string) {
+	r.operation, r.notice = operation, notice
+}
+
+func Test_call_ExecuteReportsDeprecation(t *testing.T) {
+	tests := []struct {
+		name        string
+		deprecation string
+		header      http.Header
+		want        string
+	}{
+		{name: "Current operation", header: http.Header{}, want: ""},
+		{name: "Deprecated operation", deprecation: "use v2.Get", header: http.Header{}, want: "use v2.Get"},
+		{
+			name:   "Sunset header",
+			header: http.Header{"Deprecation": []string{"@1735689600"}, "Sunset": []string{"Wed, 31 Dec 2025 23:59:59 GMT"}},
+			want:   "Deprecation: @1735689600, Sunset: Wed, 31 Dec 2025 23:59:59 GMT",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &dummyDeprecationReporter{dummyHTTPClient: dummyHTTPClient{
+				endpoint: constants.Europe,
+				resp: &http.Response{
+					StatusCode: http.StatusNoContent,
+					Header:     tt.header,
+					Body:       io.NopCloser(bytes.NewReader(nil)),
+				},
+			}}
+			call := NewCall[dummyBody](http.MethodGet, "/test")
+			if tt.deprecation != "" {
+				call.WithDeprecation(tt.deprecation)
+			}
+			if _, err := call.Execute(context.Background(), client); err != nil {
+				t.Fatalf("Execute() unexpected error = '%v'", err)
+			}
+			if client.notice != tt.want {
+				t.Errorf("reported notice = %q, want %q", client.notice, tt.want)
+			}
+			if tt.want != "" && client.operation != call.Operation {
+				t.Errorf("reported operation = %q, want %q", client.operation, call.Operation)
+			}
+		})
+	}
+}
+
+func Test_operationName(t *testing.T) {
+	for function, want := range map[string]string{
+		"github.com/fond-of-vertigo/amazon-sp-api/apis/orders.(*API).GetOrders":                "orders.GetOrders",
+		"github.com/fond-of-vertigo/amazon-sp-api/apis/finances/v2024.(*API).ListTransactions": "finances/v2024.ListTransactions",
+		"github.com/fond-of-vertigo/amazon-sp-api/apis.Test_call_Execute":                      "apis.Test_call_Execute",
+		"main.main": "main.main",
+	} {
+		if got := operationName(function); got != want {
+			t.Errorf("operationName(%q) = %q, want %q", function, got, want)
+		}
+	}
+}
+
+type sequenceHTTPClient struct {
+	dummyHTTPClient
+	statusCodes []int
+	errs        []error
+	calls       int
+}
+
+func (r *sequenceHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	i := min(r.calls, len(r.statusCodes)-1)
+	r.calls++
+	if r.errs != nil && r.errs[i] != nil {
+		return nil, r.errs[i]
+	}
+	return &http.Response{StatusCode: r.statusCodes[i], Body: io.NopCloser(bytes.NewReader([]byte("{}"))), Request: req}, nil
+}
+
+func (r *sequenceHTTPClient) RetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second, MaxBackoff: 4 * time.Second}
+}
+
+type decodingHTTPClient struct {
+	*dummyHTTPClient
+	opts DecodeOptions
+}
+
+func (c *decodingHTTPClient) DecodeOptions() DecodeOptions {
+	return c.opts
+}
+
+func Test_call_ExecuteDecodeOptions(t *testing.T) {
+	newClient := func(body string, opts DecodeOptions) *decodingHTTPClient {
+		return &decodingHTTPClient{opts: opts, dummyHTTPClient: &dummyHTTPClient{
+			endpoint: constants.Europe,
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			},
+		}}
+	}
+	const unknownField = `{"Message":"All ok","Unknown":true}`
+
+	if _, err := NewCall[dummyBody](http.MethodGet, "/test").Execute(context.Background(), newClient(unknownField, DecodeOptions{})); err != nil {
+		t.Errorf("Execute() unexpected error = '%v'", err)
+	}
+	got, err := NewCall[dummyBody](http.MethodGet, "/test").
+		Execute(context.Background(), newClient(unknownField, DecodeOptions{DisallowUnknownFields: true}))
+	if err == nil || got == nil || !strings.Contains(err.Error(), `unknown field "Unknown"`) {
+		t.Errorf("Execute() = %v, '%v', want the response along with the unknown field error", got, err)
+	}
+	if _, err = NewCall[dummyBody](http.MethodGet, "/test").
+		WithDecodeOptions(DecodeOptions{}).
+		Execute(context.Background(), newClient(unknownField, DecodeOptions{DisallowUnknownFields: true})); err != nil {
+		t.Errorf("Execute() with lenient call options unexpected error = '%v'", err)
+	}
+
+	values, err := NewCall[map[string]any](http.MethodGet, "/test").
+		Execute(context.Background(), newClient(`{"Amount":19.99}`, DecodeOptions{UseNumber: true}))
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = '%v'", err)
+	}
+	if amount := (*values.ResponseBody)["Amount"]; amount != json.Number("19.99") {
+		t.Errorf("Execute(): Amount = %#v, want json.Number(\"19.99\")", amount)
+	}
+}
+
+func Test_decodeBodyTrailingData(t *testing.T) {
+	opts := DecodeOptions{DisallowUnknownFields: true}
+	for body, want := range map[string]string{
+		`{"Message":"ok"} {"Message":"again"}`: "unexpected data after the JSON value",
+		`{"Message":"ok"} ]`:                   "unexpected data after the JSON value: invalid character ']' looking for beginning of value",
+	} {
+		var into dummyBody
+		if err := decodeBody([]byte(body), &into, opts); err == nil || err.Error() != want {
+			t.Errorf("decodeBody(%s) error = '%v', want '%s'", body, err, want)
+		}
+	}
+	var into dummyBody
+	if err := decodeBody([]byte("{\"Message\":\"ok\"}\n"), &into, opts); err != nil {
+		t.Errorf("decodeBody() with trailing whitespace unexpected error = '%v'", err)
+	}
+}
+
+func Test_call_ExecuteRetries(t *testing.T) {
+	defer func(f sleeper) { sleepFunc = f }(sleepFunc)
+	var waits []time.Duration
+	sleepFunc = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+	connReset := &net.OpError{Op: "read", Err: syscall.ECONNRESET}
+
+	tests := []struct {
+		name        string
+		statusCodes []int
+		errs        []error
+		wantStatus  int
+		wantErr     error
+		wantWaits   []time.Duration
+	}{
+		{name: "Retries 5xx", statusCodes: []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK}, wantStatus: http.StatusOK, wantWaits: []time.Duration{time.Second, 2 * time.Second}},
+		{name: "Returns last 5xx", statusCodes: []int{http.StatusInternalServerError}, wantStatus: http.StatusInternalServerError, wantWaits: []time.Duration{time.Second, 2 * time.Second}},
+		{name: "Waits at least rate limit on 429", statusCodes: []int{http.StatusTooManyRequests, http.StatusOK}, wantStatus: http.StatusOK, wantWaits: []time.Duration{2 * time.Second}},
+		{name: "Fails if still rate limited", statusCodes: []int{http.StatusTooManyRequests}, wantErr: ErrMaxRetryCountReached, wantWaits: []time.Duration{2 * time.Second, 2 * time.Second}},
+		{name: "Retries network errors", statusCodes: []int{0, http.StatusOK}, errs: []error{connReset, nil}, wantStatus: http.StatusOK, wantWaits: []time.Duration{time.Second}},
+		{name: "No retry on 4xx", statusCodes: []int{http.StatusNotFound}, wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			waits = nil
+			client := &sequenceHTTPClient{dummyHTTPClient: dummyHTTPClient{endpoint: constants.Europe}, statusCodes: tt.statusCodes, errs: tt.errs}
+			resp, err := NewCall[dummyBody](http.MethodGet, "/test").
+				WithRateLimit(0.5, time.Second).
+				Execute(context.Background(), client)
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Execute() error = '%v', want '%v'", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (resp == nil || resp.Status != tt.wantStatus) {
+				t.Errorf("Execute() = '%v', error = '%v', want status %d", resp, err, tt.wantStatus)
+			}
+			if !reflect.DeepEqual(waits, tt.wantWaits) {
+				t.Errorf("Execute() waited %v, want %v", waits, tt.wantWaits)
+			}
+		})
+	}
+}
+
+type retryHookHTTPClient struct {
+	*sequenceHTTPClient
+	events []RetryEvent
+}
+
+func (r *retryHookHTTPClient) RetryPolicy() RetryPolicy {
+	policy := r.sequenceHTTPClient.RetryPolicy()
+	policy.OnRetry = func(event RetryEvent) {
+		r.events = append(r.events, event)
+	}
+	return policy
+}
+
+func Test_call_ExecuteNotifiesRetries(t *testing.T) {
+	defer func(f sleeper) { sleepFunc = f }(sleepFunc)
+	sleepFunc = func(_ context.Context, _ time.Duration) error { return nil }
+
+	client := &retryHookHTTPClient{sequenceHTTPClient: &sequenceHTTPClient{
+		dummyHTTPClient: dummyHTTPClient{endpoint: constants.Europe},
+		statusCodes:     []int{http.StatusServiceUnavailable, http.StatusTooManyRequests},
+	}}
+	_, err := NewCall[dummyBody](http.MethodGet, "/test").
+		WithRateLimit(0.5, time.Second).
+		Execute(context.Background(), client)
+	if !errors.Is(err, ErrMaxRetryCountReached) {
+		t.Fatalf("Execute() error = '%v', want '%v'", err, ErrMaxRetryCountReached)
+	}
+
+	operation := "apis.Test_call_ExecuteNotifiesRetries"
+	want := []RetryEvent{
+		{Operation: operation, Attempt: 1, Wait: time.Second, StatusCode: http.StatusServiceUnavailable},
+		{Operation: operation, Attempt: 2, Wait: 2 * time.Second, StatusCode: http.StatusTooManyRequests, Throttled: true},
+		{Operation: operation, Attempt: 3, StatusCode: http.StatusTooManyRequests, Throttled: true, GaveUp: true},
+	}
+	if !reflect.DeepEqual(client.events, want) {
+		t.Errorf("OnRetry() events = %+v, want %+v", client.events, want)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	defer func(f func() float64) { jitterFunc = f }(jitterFunc)
+	jitterFunc = func() float64 { return 1 }
+
+	policy := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second, Jitter: 0.2}
+	want := []time.Duration{1200 * time.Millisecond, 2400 * time.Millisecond, 4800 * time.Millisecond, 6 * time.Second}
+	for i, w := range want {
+		if got := policy.backoff(i + 1); got != w {
+			t.Errorf("backoff(%d) = %v, want %v", i+1, got, w)
+		}
+	}
+}
+
 func diff(want any, got any) bool {
 	if want == nil && !reflect.ValueOf(want).IsNil() {
 		return true
@@ -252,8 +840,8 @@ func mockResponse(callResp *CallResponse[dummyBody]) (*http.Response, error) {
 			return nil, err
 		}
 		return &http.Response{
-			Status:        "500 Internal Server Error",
-			StatusCode:    http.StatusInternalServerError,
+			Status:        "400 Bad Request",
+			StatusCode:    http.StatusBadRequest,
 			Body:          io.NopCloser(bytes.NewReader(bodyBytes)),
 			ContentLength: int64(len(bodyBytes)),
 		}, nil
@@ -0,0 +1,160 @@
+package apis
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+const charsetSniffLen = 4096
+
+// toUTF8 converts the document to UTF-8. The charset is taken from a byte order mark or the contentType header.
+// Text that is not valid UTF-8 is assumed to be Windows-1252, which Amazon uses for many flat-file reports,
+// even if the header claims UTF-8.
+// Binary content is passed through unchanged.
+func toUTF8(r io.Reader, contentType string) io.Reader {
+	br := bufio.NewReaderSize(r, charsetSniffLen)
+	head, _ := br.Peek(charsetSniffLen)
+
+	switch {
+	case bytes.HasPrefix(head, []byte{0xEF, 0xBB, 0xBF}):
+		_, _ = br.Discard(3)
+		return br
+	case bytes.HasPrefix(head, []byte{0xFF, 0xFE}):
+		_, _ = br.Discard(2)
+		return &utf16Reader{r: br, littleEndian: true}
+	case bytes.HasPrefix(head, []byte{0xFE, 0xFF}):
+		_, _ = br.Discard(2)
+		return &utf16Reader{r: br}
+	}
+
+	switch declaredCharset(contentType) {
+	case "utf-16le":
+		return &utf16Reader{r: br, littleEndian: true}
+	case "utf-16", "utf-16be":
+		return &utf16Reader{r: br}
+	case "windows-1252", "cp1252", "iso-8859-1", "latin1":
+		return &cp1252Reader{r: br}
+	case "", "utf-8":
+		if strings.HasPrefix(http.DetectContentType(head), "text/") && !validUTF8Prefix(head) {
+			return &cp1252Reader{r: br}
+		}
+	}
+	return br
+}
+
+func declaredCharset(contentType string) string {
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(params["charset"])
+}
+
+// validUTF8Prefix ignores a rune that is cut off at the end of the sniffed prefix.
+func validUTF8Prefix(b []byte) bool {
+	for i := 0; i < utf8.UTFMax && len(b) > 0; i++ {
+		if utf8.Valid(b) {
+			return true
+		}
+		b = b[:len(b)-1]
+	}
+	return utf8.Valid(b)
+}
+
+// cp1252 maps the bytes 0x80 - 0x9F of Windows-1252 which differ from ISO-8859-1.
+var cp1252 = [32]rune{
+	'€', '\u0081', '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', '\u008D', 'Ž', '\u008F',
+	'\u0090', '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', '\u009D', 'ž', 'Ÿ',
+}
+
+type cp1252Reader struct {
+	r   io.Reader
+	buf []byte
+	in  [1024]byte
+}
+
+func (c *cp1252Reader) Read(p []byte) (int, error) {
+	for len(c.buf) == 0 {
+		n, err := c.r.Read(c.in[:])
+		for _, b := range c.in[:n] {
+			switch {
+			case b < utf8.RuneSelf:
+				c.buf = append(c.buf, b)
+			case b < 0xA0:
+				c.buf = utf8.AppendRune(c.buf, cp1252[b-0x80])
+			default:
+				c.buf = utf8.AppendRune(c.buf, rune(b))
+			}
+		}
+		if len(c.buf) == 0 && err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+type utf16Reader struct {
+	r            *bufio.Reader
+	littleEndian bool
+	buf          []byte
+	pending      rune
+	hasPending   bool
+}
+
+func (u *utf16Reader) readUnit() (uint16, error) {
+	var pair [2]byte
+	if _, err := io.ReadFull(u.r, pair[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return 0, io.EOF
+		}
+		return 0, err
+	}
+	if u.littleEndian {
+		return uint16(pair[0]) | uint16(pair[1])<<8, nil
+	}
+	return uint16(pair[1]) | uint16(pair[0])<<8, nil
+}
+
+func (u *utf16Reader) Read(p []byte) (int, error) {
+	for len(u.buf) < len(p) {
+		var r rune
+		if u.hasPending {
+			r, u.hasPending = u.pending, false
+		} else {
+			unit, err := u.readUnit()
+			if err != nil {
+				if len(u.buf) > 0 {
+					break
+				}
+				return 0, err
+			}
+			r = rune(unit)
+		}
+
+		if utf16.IsSurrogate(r) {
+			unit, err := u.readUnit()
+			if err == nil {
+				if decoded := utf16.DecodeRune(r, rune(unit)); decoded != utf8.RuneError {
+					r = decoded
+				} else {
+					u.pending, u.hasPending = rune(unit), true
+					r = utf8.RuneError
+				}
+			} else {
+				r = utf8.RuneError
+			}
+		}
+		u.buf = utf8.AppendRune(u.buf, r)
+	}
+	n := copy(p, u.buf)
+	u.buf = u.buf[n:]
+	return n, nil
+}
@@ -0,0 +1,119 @@
+package customerfeedback
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/customerFeedback/2024-06-01"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.CustomerfeedbackClient for tests.
+type Client interface {
+	GetItemReviewTopics(ctx context.Context, asin string, marketplaceID constants.MarketplaceID, sortBy SortBy) (*apis.CallResponse[ItemReviewTopicsResponse], error)
+	GetItemReviewTrends(ctx context.Context, asin string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[ItemReviewTrendsResponse], error)
+	GetItemBrowseNode(ctx context.Context, asin string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[BrowseNodeResponse], error)
+	GetBrowseNodeReviewTopics(ctx context.Context, browseNodeID string, marketplaceID constants.MarketplaceID, sortBy SortBy) (*apis.CallResponse[BrowseNodeReviewTopicsResponse], error)
+	GetBrowseNodeReviewTrends(ctx context.Context, browseNodeID string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[BrowseNodeReviewTrendsResponse], error)
+	GetBrowseNodeReturnTopics(ctx context.Context, browseNodeID string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[BrowseNodeReturnTopicsResponse], error)
+	GetBrowseNodeReturnTrends(ctx context.Context, browseNodeID string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[BrowseNodeReturnTrendsResponse], error)
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// GetItemReviewTopics returns the most positive and most negative review topics of the item with the given asin.
+func (a *API) GetItemReviewTopics(ctx context.Context, asin string, marketplaceID constants.MarketplaceID, sortBy SortBy) (*apis.CallResponse[ItemReviewTopicsResponse], error) {
+	params := marketplaceQuery(marketplaceID)
+	params.Add("sortBy", string(sortBy))
+
+	return apis.NewCall[ItemReviewTopicsResponse](http.MethodGet, pathPrefix+"/items/{asin}/reviews/topics").
+		WithPathParam("asin", asin).
+		WithQueryParams(params).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetItemReviewTrends returns the trends of the review topics of the item with the given asin over the past six months.
+func (a *API) GetItemReviewTrends(ctx context.Context, asin string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[ItemReviewTrendsResponse], error) {
+	return apis.NewCall[ItemReviewTrendsResponse](http.MethodGet, pathPrefix+"/items/{asin}/reviews/trends").
+		WithPathParam("asin", asin).
+		WithQueryParams(marketplaceQuery(marketplaceID)).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetItemBrowseNode returns the browse node that the item with the given asin is compared against.
+func (a *API) GetItemBrowseNode(ctx context.Context, asin string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[BrowseNodeResponse], error) {
+	return apis.NewCall[BrowseNodeResponse](http.MethodGet, pathPrefix+"/items/{asin}/browseNode").
+		WithPathParam("asin", asin).
+		WithQueryParams(marketplaceQuery(marketplaceID)).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetBrowseNodeReviewTopics returns the most positive and most negative review topics of a browse node.
+func (a *API) GetBrowseNodeReviewTopics(ctx context.Context, browseNodeID string, marketplaceID constants.MarketplaceID, sortBy SortBy) (*apis.CallResponse[BrowseNodeReviewTopicsResponse], error) {
+	params := marketplaceQuery(marketplaceID)
+	params.Add("sortBy", string(sortBy))
+
+	return apis.NewCall[BrowseNodeReviewTopicsResponse](http.MethodGet, pathPrefix+"/browseNodes/{browseNodeID}/reviews/topics").
+		WithPathParam("browseNodeID", browseNodeID).
+		WithQueryParams(params).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetBrowseNodeReviewTrends returns the trends of the review topics of a browse node over the past six months.
+func (a *API) GetBrowseNodeReviewTrends(ctx context.Context, browseNodeID string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[BrowseNodeReviewTrendsResponse], error) {
+	return apis.NewCall[BrowseNodeReviewTrendsResponse](http.MethodGet, pathPrefix+"/browseNodes/{browseNodeID}/reviews/trends").
+		WithPathParam("browseNodeID", browseNodeID).
+		WithQueryParams(marketplaceQuery(marketplaceID)).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetBrowseNodeReturnTopics returns the most frequent return topics of a browse node.
+func (a *API) GetBrowseNodeReturnTopics(ctx context.Context, browseNodeID string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[BrowseNodeReturnTopicsResponse], error) {
+	return apis.NewCall[BrowseNodeReturnTopicsResponse](http.MethodGet, pathPrefix+"/browseNodes/{browseNodeID}/returns/topics").
+		WithPathParam("browseNodeID", browseNodeID).
+		WithQueryParams(marketplaceQuery(marketplaceID)).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetBrowseNodeReturnTrends returns the trends of the return topics of a browse node over the past six months.
+func (a *API) GetBrowseNodeReturnTrends(ctx context.Context, browseNodeID string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[BrowseNodeReturnTrendsResponse], error) {
+	return apis.NewCall[BrowseNodeReturnTrendsResponse](http.MethodGet, pathPrefix+"/browseNodes/{browseNodeID}/returns/trends").
+		WithPathParam("browseNodeID", browseNodeID).
+		WithQueryParams(marketplaceQuery(marketplaceID)).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+func marketplaceQuery(marketplaceID constants.MarketplaceID) url.Values {
+	q := url.Values{}
+	q.Add("marketplaceId", string(marketplaceID))
+	return q
+}
@@ -0,0 +1,228 @@
+package customerfeedback
+
+import "time"
+
+// SortBy The metric by which to sort review topics.
+type SortBy string
+
+const (
+	SortByMentions         SortBy = "MENTIONS"
+	SortByStarRatingImpact SortBy = "STAR_RATING_IMPACT"
+)
+
+// DateRange A date range.
+type DateRange struct {
+	StartDate time.Time `json:"startDate"`
+	EndDate   time.Time `json:"endDate"`
+}
+
+// ReviewTopicMetrics The metrics of a review topic for an item, its parent or its browse node.
+type ReviewTopicMetrics struct {
+	// The number of times reviews mention the topic.
+	NumberOfMentions *int `json:"numberOfMentions,omitempty"`
+	// The percentage of reviews that mention the topic.
+	OccurrencePercentage *float64 `json:"occurrencePercentage,omitempty"`
+	// The effect of the topic on the star rating. Only available for items.
+	StarRatingImpact *float64 `json:"starRatingImpact,omitempty"`
+}
+
+// ReviewSubtopic A more specific aspect of a review topic.
+type ReviewSubtopic struct {
+	// The name of the subtopic.
+	Subtopic string              `json:"subtopic"`
+	Metrics  *ReviewTopicMetrics `json:"metrics,omitempty"`
+	// A list of up to three snippets from reviews that contain the subtopic.
+	ReviewSnippets []string `json:"reviewSnippets,omitempty"`
+}
+
+// ItemReviewTopic A review topic of an item.
+type ItemReviewTopic struct {
+	// The name of the topic.
+	Topic             string              `json:"topic"`
+	ASINMetrics       *ReviewTopicMetrics `json:"asinMetrics,omitempty"`
+	ParentASINMetrics *ReviewTopicMetrics `json:"parentAsinMetrics,omitempty"`
+	BrowseNodeMetrics *ReviewTopicMetrics `json:"browseNodeMetrics,omitempty"`
+	// The ASINs of the child items that are most affected by the topic.
+	ChildASINs []string `json:"childAsins,omitempty"`
+	// A list of up to three snippets from reviews that contain the topic.
+	ReviewSnippets []string         `json:"reviewSnippets,omitempty"`
+	Subtopics      []ReviewSubtopic `json:"subtopics,omitempty"`
+}
+
+// ItemReviewTopics The positive and negative review topics of an item.
+type ItemReviewTopics struct {
+	PositiveTopics []ItemReviewTopic `json:"positiveTopics,omitempty"`
+	NegativeTopics []ItemReviewTopic `json:"negativeTopics,omitempty"`
+}
+
+// ItemReviewTopicsResponse The response of the GetItemReviewTopics operation.
+type ItemReviewTopicsResponse struct {
+	ASIN string `json:"asin"`
+	// The name of the item.
+	ItemName *string `json:"itemName,omitempty"`
+	// The two digit country code of the marketplace.
+	CountryCode *string          `json:"countryCode,omitempty"`
+	DateRange   *DateRange       `json:"dateRange,omitempty"`
+	Topics      ItemReviewTopics `json:"topics"`
+}
+
+// ReviewTrendMetrics The occurrence of a review topic within a trend period.
+type ReviewTrendMetrics struct {
+	// The percentage of reviews that mention the topic.
+	OccurrencePercentage *float64 `json:"occurrencePercentage,omitempty"`
+}
+
+// ItemReviewTrendPoint The review trend metrics of an item for one period.
+type ItemReviewTrendPoint struct {
+	DateRange         DateRange           `json:"dateRange"`
+	ASINMetrics       *ReviewTrendMetrics `json:"asinMetrics,omitempty"`
+	ParentASINMetrics *ReviewTrendMetrics `json:"parentAsinMetrics,omitempty"`
+	BrowseNodeMetrics *ReviewTrendMetrics `json:"browseNodeMetrics,omitempty"`
+}
+
+// ItemReviewTrend The trend of a review topic of an item.
+type ItemReviewTrend struct {
+	// The name of the topic.
+	Topic        string                 `json:"topic"`
+	TrendMetrics []ItemReviewTrendPoint `json:"trendMetrics"`
+}
+
+// ItemReviewTrends The positive and negative review trends of an item.
+type ItemReviewTrends struct {
+	PositiveTopics []ItemReviewTrend `json:"positiveTopics,omitempty"`
+	NegativeTopics []ItemReviewTrend `json:"negativeTopics,omitempty"`
+}
+
+// ItemReviewTrendsResponse The response of the GetItemReviewTrends operation.
+type ItemReviewTrendsResponse struct {
+	ASIN string `json:"asin"`
+	// The name of the item.
+	ItemName *string `json:"itemName,omitempty"`
+	// The marketplace ID.
+	MarketplaceID *string          `json:"marketplaceId,omitempty"`
+	DateRange     *DateRange       `json:"dateRange,omitempty"`
+	ReviewTrends  ItemReviewTrends `json:"reviewTrends"`
+}
+
+// BrowseNodeResponse The browse node that is used for review comparisons of an item.
+type BrowseNodeResponse struct {
+	// The browse node ID.
+	BrowseNodeID string `json:"browseNodeId"`
+	// The display name of the browse node.
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+// BrowseNodeReviewTopic A review topic of a browse node.
+type BrowseNodeReviewTopic struct {
+	// The name of the topic.
+	Topic             string              `json:"topic"`
+	BrowseNodeMetrics *ReviewTopicMetrics `json:"browseNodeMetrics,omitempty"`
+	// A list of up to three snippets from reviews that contain the topic.
+	ReviewSnippets []string         `json:"reviewSnippets,omitempty"`
+	Subtopics      []ReviewSubtopic `json:"subtopics,omitempty"`
+}
+
+// BrowseNodeReviewTopics The positive and negative review topics of a browse node.
+type BrowseNodeReviewTopics struct {
+	PositiveTopics []BrowseNodeReviewTopic `json:"positiveTopics,omitempty"`
+	NegativeTopics []BrowseNodeReviewTopic `json:"negativeTopics,omitempty"`
+}
+
+// BrowseNodeReviewTopicsResponse The response of the GetBrowseNodeReviewTopics operation.
+type BrowseNodeReviewTopicsResponse struct {
+	BrowseNodeID string `json:"browseNodeId"`
+	// The display name of the browse node.
+	DisplayName *string `json:"displayName,omitempty"`
+	// The marketplace ID.
+	MarketplaceID *string                `json:"marketplaceId,omitempty"`
+	DateRange     *DateRange             `json:"dateRange,omitempty"`
+	Topics        BrowseNodeReviewTopics `json:"topics"`
+}
+
+// BrowseNodeTrendPoint The trend metrics of a browse node for one period.
+type BrowseNodeTrendPoint struct {
+	DateRange         DateRange           `json:"dateRange"`
+	BrowseNodeMetrics *ReviewTrendMetrics `json:"browseNodeMetrics,omitempty"`
+}
+
+// BrowseNodeReviewTrend The trend of a review topic of a browse node.
+type BrowseNodeReviewTrend struct {
+	// The name of the topic.
+	Topic        string                 `json:"topic"`
+	TrendMetrics []BrowseNodeTrendPoint `json:"trendMetrics"`
+}
+
+// BrowseNodeReviewTrends The positive and negative review trends of a browse node.
+type BrowseNodeReviewTrends struct {
+	PositiveTopics []BrowseNodeReviewTrend `json:"positiveTopics,omitempty"`
+	NegativeTopics []BrowseNodeReviewTrend `json:"negativeTopics,omitempty"`
+}
+
+// BrowseNodeReviewTrendsResponse The response of the GetBrowseNodeReviewTrends operation.
+type BrowseNodeReviewTrendsResponse struct {
+	BrowseNodeID string `json:"browseNodeId"`
+	// The display name of the browse node.
+	DisplayName *string `json:"displayName,omitempty"`
+	// The marketplace ID.
+	MarketplaceID *string                `json:"marketplaceId,omitempty"`
+	DateRange     *DateRange             `json:"dateRange,omitempty"`
+	ReviewTrends  BrowseNodeReviewTrends `json:"reviewTrends"`
+}
+
+// ReturnTopicMetrics The metrics of a return topic.
+type ReturnTopicMetrics struct {
+	// The number of returns that mention the topic.
+	NumberOfReturns *int `json:"numberOfReturns,omitempty"`
+	// The percentage of returns that mention the topic.
+	PercentageOfReturns *float64 `json:"percentageOfReturns,omitempty"`
+}
+
+// BrowseNodeReturnTopic A return reason topic of a browse node.
+type BrowseNodeReturnTopic struct {
+	// The name of the topic.
+	Topic             string              `json:"topic"`
+	BrowseNodeMetrics *ReturnTopicMetrics `json:"browseNodeMetrics,omitempty"`
+	Subtopics         []ReturnSubtopic    `json:"subtopics,omitempty"`
+}
+
+// ReturnSubtopic A more specific aspect of a return topic.
+type ReturnSubtopic struct {
+	// The name of the subtopic.
+	Subtopic string              `json:"subtopic"`
+	Metrics  *ReturnTopicMetrics `json:"metrics,omitempty"`
+}
+
+// BrowseNodeReturnTopicsResponse The response of the GetBrowseNodeReturnTopics operation.
+type BrowseNodeReturnTopicsResponse struct {
+	BrowseNodeID string `json:"browseNodeId"`
+	// The display name of the browse node.
+	DisplayName *string `json:"displayName,omitempty"`
+	// The marketplace ID.
+	MarketplaceID *string                 `json:"marketplaceId,omitempty"`
+	DateRange     *DateRange              `json:"dateRange,omitempty"`
+	Topics        []BrowseNodeReturnTopic `json:"topics"`
+}
+
+// BrowseNodeReturnTrendPoint The return trend metrics of a browse node for one period.
+type BrowseNodeReturnTrendPoint struct {
+	DateRange         DateRange           `json:"dateRange"`
+	BrowseNodeMetrics *ReturnTopicMetrics `json:"browseNodeMetrics,omitempty"`
+}
+
+// BrowseNodeReturnTrend The trend of a return topic of a browse node.
+type BrowseNodeReturnTrend struct {
+	// The name of the topic.
+	Topic        string                       `json:"topic"`
+	TrendMetrics []BrowseNodeReturnTrendPoint `json:"trendMetrics"`
+}
+
+// BrowseNodeReturnTrendsResponse The response of the GetBrowseNodeReturnTrends operation.
+type BrowseNodeReturnTrendsResponse struct {
+	BrowseNodeID string `json:"browseNodeId"`
+	// The display name of the browse node.
+	DisplayName *string `json:"displayName,omitempty"`
+	// The marketplace ID.
+	MarketplaceID *string                 `json:"marketplaceId,omitempty"`
+	DateRange     *DateRange              `json:"dateRange,omitempty"`
+	ReturnTrends  []BrowseNodeReturnTrend `json:"returnTrends"`
+}
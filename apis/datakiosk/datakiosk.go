@@ -0,0 +1,95 @@
+package datakiosk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go/types"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/dataKiosk/2023-11-15"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.DatakioskClient for tests.
+type Client interface {
+	GetQueries(ctx context.Context, filter *GetQueriesFilter) (*apis.CallResponse[GetQueriesResponse], error)
+	CreateQuery(ctx context.Context, specification *CreateQuerySpecification) (*apis.CallResponse[CreateQueryResponse], error)
+	GetQuery(ctx context.Context, queryID string) (*apis.CallResponse[Query], error)
+	CancelQuery(ctx context.Context, queryID string) error
+	GetDocument(ctx context.Context, documentID string) (*apis.CallResponse[GetDocumentResponse], error)
+	OpenDocument(ctx context.Context, documentID string) (io.ReadCloser, error)
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// GetQueries returns details for the Data Kiosk queries that match the specified filters.
+func (a *API) GetQueries(ctx context.Context, filter *GetQueriesFilter) (*apis.CallResponse[GetQueriesResponse], error) {
+	if filter.PageSize != 0 && (filter.PageSize < 1 || filter.PageSize > 100) {
+		return nil, errors.New("pageSize must be between 1 and 100")
+	}
+
+	return apis.NewCall[GetQueriesResponse](http.MethodGet, pathPrefix+"/queries").
+		WithQueryParams(filter.GetQuery()).
+		WithParseErrorListOnError().
+		WithRateLimit(0.0222, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// CreateQuery creates a Data Kiosk query request.
+// The retrieval of the query results is asynchronous, poll GetQuery until the processing status is DONE.
+func (a *API) CreateQuery(ctx context.Context, specification *CreateQuerySpecification) (*apis.CallResponse[CreateQueryResponse], error) {
+	body, err := json.Marshal(specification)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[CreateQueryResponse](http.MethodPost, pathPrefix+"/queries").
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(0.0167, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetQuery returns query details for the query specified by the queryID parameter.
+func (a *API) GetQuery(ctx context.Context, queryID string) (*apis.CallResponse[Query], error) {
+	return apis.NewCall[Query](http.MethodGet, pathPrefix+"/queries/{queryID}").
+		WithPathParam("queryID", queryID).
+		WithParseErrorListOnError().
+		WithRateLimit(2, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// CancelQuery cancels the query specified by the queryID parameter. Only queries with a non-terminal
+// processingStatus (IN_QUEUE, IN_PROGRESS) can be cancelled.
+func (a *API) CancelQuery(ctx context.Context, queryID string) error {
+	_, err := apis.NewCall[types.Nil](http.MethodDelete, pathPrefix+"/queries/{queryID}").
+		WithPathParam("queryID", queryID).
+		WithParseErrorListOnError().
+		WithRateLimit(0.0222, time.Second).
+		Execute(ctx, a.httpClient)
+	return err
+}
+
+// GetDocument returns the information required for retrieving a Data Kiosk document's contents.
+func (a *API) GetDocument(ctx context.Context, documentID string) (*apis.CallResponse[GetDocumentResponse], error) {
+	return apis.NewCall[GetDocumentResponse](http.MethodGet, pathPrefix+"/documents/{documentID}").
+		WithPathParam("documentID", documentID).
+		WithParseErrorListOnError().
+		WithRateLimit(0.0167, time.Second).
+		Execute(ctx, a.httpClient)
+}
@@ -0,0 +1,76 @@
+package datakiosk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+)
+
+// OpenDocument downloads the Data Kiosk document with the given documentID (the dataDocumentId or
+// errorDocumentId of a Query) and returns its decompressed content as stream. The caller must close
+// the returned reader.
+func (a *API) OpenDocument(ctx context.Context, documentID string) (io.ReadCloser, error) {
+	resp, err := a.GetDocument(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if resp.ResponseBody == nil {
+		return nil, fmt.Errorf("no documentUrl returned for Data Kiosk document %s", documentID)
+	}
+	return apis.OpenDocument(ctx, a.httpClient, resp.ResponseBody.DocumentURL)
+}
+
+// RecordDecoder decodes the JSON Lines of a Data Kiosk document one record at a time,
+// so that even multi-GB documents can be processed with constant memory.
+type RecordDecoder[T any] struct {
+	dec  *json.Decoder
+	line int
+}
+
+// NewRecordDecoder returns a RecordDecoder reading from r.
+func NewRecordDecoder[T any](r io.Reader) *RecordDecoder[T] {
+	return &RecordDecoder[T]{dec: json.NewDecoder(r)}
+}
+
+// Next decodes the next record. It returns io.EOF when there are no more records.
+func (d *RecordDecoder[T]) Next() (*T, error) {
+	record := new(T)
+	if err := d.dec.Decode(record); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("decoding record %d failed: %w", d.line+1, err)
+	}
+	d.line++
+	return record, nil
+}
+
+// DecodeDocument downloads the Data Kiosk document with the given documentID and calls fn for every record.
+// Decoding stops at the first error returned by fn.
+func DecodeDocument[T any](ctx context.Context, api *API, documentID string, fn func(record *T) error) (err error) {
+	doc, err := api.OpenDocument(ctx, documentID)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, doc.Close())
+	}()
+
+	dec := NewRecordDecoder[T](doc)
+	for {
+		record, err := dec.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = fn(record); err != nil {
+			return err
+		}
+	}
+}
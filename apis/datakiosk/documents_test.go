@@ -0,0 +1,72 @@
+package datakiosk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type salesRecord struct {
+	StartDate string `json:"startDate"`
+	Sales     struct {
+		UnitsOrdered int `json:"unitsOrdered"`
+	} `json:"sales"`
+}
+
+func TestRecordDecoder_Next(t *testing.T) {
+	doc := `{"startDate":"2023-11-01","sales":{"unitsOrdered":3}}
+{"startDate":"2023-11-02","sales":{"unitsOrdered":5}}
+`
+	dec := NewRecordDecoder[salesRecord](strings.NewReader(doc))
+
+	first, err := dec.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-11-01", first.StartDate)
+	assert.Equal(t, 3, first.Sales.UnitsOrdered)
+
+	second, err := dec.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 5, second.Sales.UnitsOrdered)
+
+	_, err = dec.Next()
+	assert.True(t, errors.Is(err, io.EOF))
+}
+
+func TestRecordDecoder_NextInvalidRecord(t *testing.T) {
+	dec := NewRecordDecoder[salesRecord](strings.NewReader("{\"startDate\":\"2023-11-01\"}\n{broken\n"))
+
+	_, err := dec.Next()
+	assert.NoError(t, err)
+
+	_, err = dec.Next()
+	assert.ErrorContains(t, err, "record 2")
+}
+
+func TestAPI_OpenDocument_NoResponseBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+	client, err := httpx.NewClient(httpx.ClientConfig{
+		TokenProvider: httpx.TokenProviderFunc(func(ctx context.Context) (string, error) {
+			return "ACCESS-TOKEN", nil
+		}),
+		HTTPClient: srv.Client(),
+		Endpoint:   constants.Endpoint(srv.URL),
+	})
+	require.NoError(t, err)
+	defer client.Close(context.Background())
+
+	_, err = NewAPI(client).OpenDocument(context.Background(), "amzn1.tortuga.4.eu.1234")
+
+	assert.ErrorContains(t, err, "no documentUrl returned for Data Kiosk document amzn1.tortuga.4.eu.1234")
+}
@@ -0,0 +1,110 @@
+package datakiosk
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/fond-of-vertigo/amazon-sp-api/internal/utils"
+)
+
+// GetQueriesFilter specifies optional filters for the getQueries operation.
+type GetQueriesFilter struct {
+	// ProcessingStatuses a list of processing statuses used to filter queries.
+	ProcessingStatuses []constants.ProcessingStatus
+	// PageSize the maximum number of queries to return in a single call. Minimum 1. Maximum 100. Default 10.
+	PageSize int
+	// CreatedSince the earliest query creation date and time for queries to include in the response, in ISO 8601 date time format.
+	// The default is 90 days ago.
+	CreatedSince apis.JsonTimeISO8601
+	// CreatedUntil the latest query creation date and time for queries to include in the response, in ISO 8601 date time format.
+	// The default is the time of the getQueries request.
+	CreatedUntil apis.JsonTimeISO8601
+	// PaginationToken a token to fetch a certain page of results when there are multiple pages of results available.
+	// The value of this token is fetched from the pagination.nextToken field returned in the GetQueriesResponse object.
+	// All other parameters must be provided with the same values that were provided with the request that generated this token,
+	// with the exception of pageSize which can be modified between calls to getQueries.
+	PaginationToken string
+}
+
+// GetQuery returns the query parameters for GetQueriesFilter.
+func (f *GetQueriesFilter) GetQuery() url.Values {
+	q := url.Values{}
+	utils.AddToQueryIfSet(q, "processingStatuses", utils.MapToCommaString(f.ProcessingStatuses))
+	if f.PageSize > 0 {
+		q.Set("pageSize", strconv.Itoa(f.PageSize))
+	}
+	utils.AddToQueryIfSet(q, "createdSince", f.CreatedSince.String())
+	utils.AddToQueryIfSet(q, "createdUntil", f.CreatedUntil.String())
+	utils.AddToQueryIfSet(q, "paginationToken", f.PaginationToken)
+	return q
+}
+
+// CreateQuerySpecification Information required to create the query.
+type CreateQuerySpecification struct {
+	// The GraphQL query to submit. A query must be at most 8000 characters after unnecessary whitespace is removed.
+	Query string `json:"query"`
+	// A token to fetch a certain page of query results when there are multiple pages of query results available.
+	// The value of this token must be fetched from the pagination.nextToken field of the Query object, and the
+	// query field must be set to the query field of the same Query object.
+	PaginationToken *string `json:"paginationToken,omitempty"`
+}
+
+// CreateQueryResponse The response for the createQuery operation.
+type CreateQueryResponse struct {
+	// The identifier for the query. This identifier is unique only in combination with a selling partner account ID.
+	QueryID string `json:"queryId"`
+}
+
+// Pagination When a query produces results that are not included in the data document, pagination occurs.
+// This means the results are divided into pages. To retrieve the next page, you must pass a
+// CreateQuerySpecification object with paginationToken set to this object's nextToken and with query set
+// to this object's query in the subsequent createQuery request.
+type Pagination struct {
+	// A token that can be used to fetch the next page of results.
+	NextToken *string `json:"nextToken,omitempty"`
+}
+
+// Query Detailed information about the query.
+type Query struct {
+	// The query identifier. This identifier is unique only in combination with a selling partner account ID.
+	QueryID string `json:"queryId"`
+	// The submitted query.
+	Query string `json:"query"`
+	// The date and time when the query was created, in ISO 8601 date time format.
+	CreatedTime time.Time `json:"createdTime"`
+	// The processing status of the query.
+	ProcessingStatus constants.ProcessingStatus `json:"processingStatus"`
+	// The date and time when the query processing started, in ISO 8601 date time format.
+	ProcessingStartTime *time.Time `json:"processingStartTime,omitempty"`
+	// The date and time when the query processing completed, in ISO 8601 date time format.
+	ProcessingEndTime *time.Time `json:"processingEndTime,omitempty"`
+	// The data document identifier. This identifier is only present when there is data available as a result
+	// of the query. This identifier is unique only in combination with a selling partner account ID.
+	// Pass this identifier into the getDocument operation to get the information required to retrieve the
+	// data document's contents.
+	DataDocumentID *string `json:"dataDocumentId,omitempty"`
+	// The error document identifier. This identifier is only present when an error occurs during query processing.
+	// This identifier is unique only in combination with a selling partner account ID. Pass this identifier into
+	// the getDocument operation to get the information required to retrieve the error document's contents.
+	ErrorDocumentID *string     `json:"errorDocumentId,omitempty"`
+	Pagination      *Pagination `json:"pagination,omitempty"`
+}
+
+// GetQueriesResponse The response for the getQueries operation.
+type GetQueriesResponse struct {
+	// A list of queries.
+	Queries    []Query     `json:"queries"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+// GetDocumentResponse The response for the getDocument operation.
+type GetDocumentResponse struct {
+	// The identifier for the Data Kiosk document. This identifier is unique only in combination with a selling partner account ID.
+	DocumentID string `json:"documentId"`
+	// A presigned URL that can be used to retrieve the Data Kiosk document. This URL expires after 5 minutes.
+	// If the Data Kiosk document is compressed, the Content-Encoding header will indicate the compression algorithm.
+	DocumentURL string `json:"documentUrl"`
+}
@@ -0,0 +1,62 @@
+package apis
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// DecodeOptions configure how response bodies are decoded into the models. Error responses are
+// always decoded leniently.
+type DecodeOptions struct {
+	// DisallowUnknownFields fails the call if the response has fields missing in the model, e.g. to
+	// detect changes of the SP-API schemas in CI. The error is returned along with the response.
+	DisallowUnknownFields bool
+	// UseNumber decodes numbers into json.Number instead of float64 where the model has no fixed
+	// type, e.g. map[string]any, so that monetary values keep their exact decimal representation.
+	// Monetary fields of the models are strings or json.Number already.
+	UseNumber bool
+}
+
+// DecodingHTTPClient is implemented by HTTP clients with configurable DecodeOptions.
+type DecodingHTTPClient interface {
+	DecodeOptions() DecodeOptions
+}
+
+func decodeOptionsOf(httpClient HTTPClient) DecodeOptions {
+	if decodingClient, ok := httpClient.(DecodingHTTPClient); ok {
+		return decodingClient.DecodeOptions()
+	}
+	return DecodeOptions{}
+}
+
+func decodeBody(body []byte, into any, opts DecodeOptions) error {
+	if len(body) == 0 {
+		return nil
+	}
+	if !opts.DisallowUnknownFields && !opts.UseNumber {
+		return json.Unmarshal(body, into)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	if opts.DisallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+	if opts.UseNumber {
+		decoder.UseNumber()
+	}
+	if err := decoder.Decode(into); err != nil {
+		return err
+	}
+	_, err := decoder.Token()
+	switch {
+	case err == io.EOF:
+		return nil
+	case err == nil:
+		// another JSON value
+		return errors.New("unexpected data after the JSON value")
+	}
+	return fmt.Errorf("unexpected data after the JSON value: %w", err)
+}
@@ -0,0 +1,41 @@
+package apis
+
+import (
+	"net/http"
+	"strings"
+)
+
+// DeprecationReporter is implemented by HTTP clients which report the calls of deprecated
+// operations, e.g. by logging a warning once per operation.
+type DeprecationReporter interface {
+	ReportDeprecation(operation, notice string)
+}
+
+// WithDeprecation marks the operation as deprecated, e.g. because Amazon released a newer version
+// of the API. The notice should name the successor, e.g. "use finances/v2024.ListTransactions".
+// The Go method should carry a "Deprecated:" doc comment as well, so that linters flag its callers.
+func (a *Call[responseType]) WithDeprecation(notice string) *Call[responseType] {
+	a.Deprecation = notice
+	return a
+}
+
+// reportDeprecation passes the notice of a deprecated operation, or the Deprecation and Sunset
+// headers of the response (RFC 9745, RFC 8594), to the HTTP client, if it is a DeprecationReporter.
+func (a *Call[responseType]) reportDeprecation(httpClient HTTPClient, header http.Header) {
+	reporter, ok := httpClient.(DeprecationReporter)
+	if !ok {
+		return
+	}
+	notices := []string{}
+	if a.Deprecation != "" {
+		notices = append(notices, a.Deprecation)
+	}
+	for _, name := range []string{"Deprecation", "Sunset"} {
+		if value := header.Get(name); value != "" {
+			notices = append(notices, name+": "+value)
+		}
+	}
+	if len(notices) > 0 {
+		reporter.ReportDeprecation(a.Operation, strings.Join(notices, ", "))
+	}
+}
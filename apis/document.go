@@ -0,0 +1,146 @@
+package apis
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// PresignedHTTPClient sends requests to presigned document URLs.
+type PresignedHTTPClient interface {
+	DoPresigned(req *http.Request) (*http.Response, error)
+}
+
+// DocumentOption configures how OpenDocument processes the downloaded content.
+type DocumentOption func(*documentOptions)
+
+type documentOptions struct {
+	keepCharset bool
+	reportType  string
+}
+
+// WithoutCharsetConversion returns the document content in its original encoding.
+func WithoutCharsetConversion() DocumentOption {
+	return func(o *documentOptions) {
+		o.keepCharset = true
+	}
+}
+
+// WithReportType names the report type of a report document that is fetched by its ID, so that a
+// restrictedDataToken is attached for restricted report types. Other downloads ignore it.
+func WithReportType(reportType string) DocumentOption {
+	return func(o *documentOptions) {
+		o.reportType = reportType
+	}
+}
+
+// DocumentReportType returns the report type set by WithReportType, or "" if there is none.
+func DocumentReportType(opts ...DocumentOption) string {
+	options := documentOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options.reportType
+}
+
+// OpenDocument downloads the document behind a presigned URL and returns its content as stream.
+// GZIP compressed documents are decompressed on the fly: the gzip reader is chained over the response
+// body, so the first rows can be read while the download is still running and memory stays flat
+// regardless of the document size. Only the first 4 KB are buffered ahead to detect the charset.
+// Text documents encoded in UTF-16 or Windows-1252 are converted to UTF-8, unless
+// WithoutCharsetConversion is passed.
+// Reading fails with an error matching ErrDocumentCorrupt if the download ends before the
+// Content-Length or doesn't match a checksum of the response, see TruncatedDocumentError and
+// ChecksumMismatchError. The caller must close the returned reader.
+func OpenDocument(ctx context.Context, httpClient PresignedHTTPClient, url string, opts ...DocumentOption) (io.ReadCloser, error) {
+	options := documentOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.DoPresigned(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("document download returned with non-OK statuscode=%d", resp.StatusCode)
+	}
+
+	doc := &documentReader{closers: []io.Closer{resp.Body}}
+	body := bufio.NewReader(newVerifyingReader(resp))
+	doc.Reader = body
+	if isGzip(resp, body) {
+		gz, err := gzip.NewReader(body)
+		if err != nil {
+			_ = resp.Body.Close()
+			return nil, fmt.Errorf("document is not a valid gzip stream: %w", err)
+		}
+		doc.Reader = gz
+		doc.closers = append([]io.Closer{gz}, doc.closers...)
+	}
+
+	if !options.keepCharset {
+		doc.Reader = toUTF8(doc.Reader, resp.Header.Get("Content-Type"))
+	}
+	return doc, nil
+}
+
+// UploadDocument uploads the content of a document, e.g. a feed document, to the presigned URL returned
+// by createFeedDocument. The content is streamed from r; contentLength is its size, or -1 if unknown.
+// contentType must match the content type of the document specification.
+func UploadDocument(ctx context.Context, httpClient PresignedHTTPClient, url string, contentType string, r io.Reader, contentLength int64) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, io.NopCloser(r))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = contentLength
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := httpClient.DoPresigned(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("document upload returned with non-OK statuscode=%d", resp.StatusCode)
+	}
+	return nil
+}
+
+// isGzip checks the Content-Encoding header and falls back to the gzip magic number,
+// since presigned document URLs often serve compressed content as application/octet-stream.
+func isGzip(resp *http.Response, body *bufio.Reader) bool {
+	if resp.Uncompressed {
+		return false
+	}
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		return true
+	}
+	magic, err := body.Peek(2)
+	return err == nil && magic[0] == 0x1f && magic[1] == 0x8b
+}
+
+type documentReader struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (d *documentReader) Close() error {
+	var errs error
+	for _, c := range d.closers {
+		errs = errors.Join(errs, c.Close())
+	}
+	return errs
+}
@@ -0,0 +1,142 @@
+package apis
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ErrDocumentCorrupt matches the errors of documents which are truncated or fail their checksum:
+//
+//	if errors.Is(err, apis.ErrDocumentCorrupt) {
+//		// download the document again
+//	}
+var ErrDocumentCorrupt = errors.New("document is corrupt")
+
+// TruncatedDocumentError is returned while reading a document which ended before its Content-Length,
+// e.g. because the connection was interrupted.
+type TruncatedDocumentError struct {
+	// ContentLength is the size announced by the response, or -1 if unknown.
+	ContentLength int64
+	// Received is the number of bytes received.
+	Received int64
+	Err      error
+}
+
+func (e *TruncatedDocumentError) Error() string {
+	if e.ContentLength < 0 {
+		return fmt.Sprintf("document truncated after %d bytes: %v", e.Received, e.Err)
+	}
+	return fmt.Sprintf("document truncated after %d of %d bytes", e.Received, e.ContentLength)
+}
+
+func (e *TruncatedDocumentError) Unwrap() error {
+	return e.Err
+}
+
+func (e *TruncatedDocumentError) Is(target error) bool {
+	return target == ErrDocumentCorrupt
+}
+
+// ChecksumMismatchError is returned at the end of a document whose content doesn't match the
+// checksum sent with the response, e.g. x-amz-checksum-sha256.
+type ChecksumMismatchError struct {
+	// Header is the response header of the checksum, e.g. "Content-Md5".
+	Header   string
+	Expected string
+	Actual   string
+}
+
+func (e *ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("document checksum %s mismatch: expected %s, got %s", e.Header, e.Expected, e.Actual)
+}
+
+func (e *ChecksumMismatchError) Is(target error) bool {
+	return target == ErrDocumentCorrupt
+}
+
+// checksumHeaders are the response headers with base64 encoded checksums of the body, as sent by S3.
+var checksumHeaders = map[string]func() hash.Hash{
+	"Content-Md5":           md5.New,
+	"X-Amz-Checksum-Sha256": sha256.New,
+	"X-Amz-Checksum-Sha1":   sha1.New,
+	"X-Amz-Checksum-Crc32":  func() hash.Hash { return crc32.NewIEEE() },
+	"X-Amz-Checksum-Crc32c": func() hash.Hash { return crc32.New(crc32.MakeTable(crc32.Castagnoli)) },
+}
+
+type checksum struct {
+	header string
+	want   string
+	hash   hash.Hash
+}
+
+// verifyingReader reads a document body and checks its length and checksums at the end.
+type verifyingReader struct {
+	r             io.Reader
+	contentLength int64
+	received      int64
+	checksums     []checksum
+	err           error
+}
+
+// newVerifyingReader returns a reader of the response body which fails with a *TruncatedDocumentError
+// or a *ChecksumMismatchError instead of io.EOF if the body is incomplete. Bodies decompressed by the
+// transport aren't verified, since the length and checksums refer to the compressed content.
+func newVerifyingReader(resp *http.Response) io.Reader {
+	v := &verifyingReader{r: resp.Body, contentLength: resp.ContentLength}
+	if resp.Uncompressed {
+		v.contentLength = -1
+		return v
+	}
+	for header, newHash := range checksumHeaders {
+		want := resp.Header.Get(header)
+		// checksums of multipart uploads end with the number of parts and can't be verified
+		if want == "" || strings.Contains(want, "-") {
+			continue
+		}
+		v.checksums = append(v.checksums, checksum{header: header, want: want, hash: newHash()})
+	}
+	return v
+}
+
+func (v *verifyingReader) Read(p []byte) (int, error) {
+	if v.err != nil {
+		return 0, v.err
+	}
+	n, err := v.r.Read(p)
+	v.received += int64(n)
+	for _, c := range v.checksums {
+		c.hash.Write(p[:n])
+	}
+
+	switch {
+	case err == io.EOF:
+		if verifyErr := v.verify(); verifyErr != nil {
+			err = verifyErr
+		}
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		err = &TruncatedDocumentError{ContentLength: v.contentLength, Received: v.received, Err: err}
+	}
+	v.err = err
+	return n, err
+}
+
+func (v *verifyingReader) verify() error {
+	if v.contentLength >= 0 && v.received != v.contentLength {
+		return &TruncatedDocumentError{ContentLength: v.contentLength, Received: v.received, Err: io.ErrUnexpectedEOF}
+	}
+	for _, c := range v.checksums {
+		if got := base64.StdEncoding.EncodeToString(c.hash.Sum(nil)); got != c.want {
+			return &ChecksumMismatchError{Header: c.header, Expected: c.want, Actual: got}
+		}
+	}
+	return nil
+}
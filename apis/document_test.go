@@ -0,0 +1,242 @@
+package apis
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type presignedClient struct{}
+
+func (p presignedClient) DoPresigned(req *http.Request) (*http.Response, error) {
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func gzipBytes(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(data)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestOpenDocument(t *testing.T) {
+	content := []byte("sku\tquantity\nABC\t4\n")
+	tests := []struct {
+		name            string
+		body            []byte
+		contentEncoding string
+		status          int
+		wantErr         bool
+	}{
+		{name: "Plain", body: content, status: http.StatusOK},
+		{name: "Gzip detected by magic number", body: gzipBytes(t, content), status: http.StatusOK},
+		{name: "Gzip detected by header", body: gzipBytes(t, content), contentEncoding: "gzip", status: http.StatusOK},
+		{name: "Expired URL", body: []byte("<Error/>"), status: http.StatusForbidden, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Empty(t, r.Header.Get("X-Amz-Access-Token"))
+				if tt.contentEncoding != "" {
+					w.Header().Set("Content-Encoding", tt.contentEncoding)
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write(tt.body)
+			}))
+			defer srv.Close()
+
+			doc, err := OpenDocument(context.Background(), presignedClient{}, srv.URL)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			got, err := io.ReadAll(doc)
+			assert.NoError(t, err)
+			assert.NoError(t, doc.Close())
+			assert.Equal(t, content, got)
+		})
+	}
+}
+
+func TestOpenDocument_CharsetConversion(t *testing.T) {
+	want := "sku\tname\nABC\tGrüße €5 “quoted”\n"
+	utf16LE := []byte{0xFF, 0xFE}
+	for _, r := range want {
+		utf16LE = append(utf16LE, byte(r), byte(r>>8))
+	}
+	cp1252 := []byte("sku\tname\nABC\tGr\xfc\xdfe \x805 \x93quoted\x94\n")
+	zipHeader := []byte("PK\x03\x04\x14\x00\x00\x00\x08\x00\xe4\xfc")
+
+	tests := []struct {
+		name        string
+		body        []byte
+		contentType string
+		opts        []DocumentOption
+		want        []byte
+	}{
+		{name: "UTF-8", body: []byte(want), want: []byte(want)},
+		{name: "UTF-8 with BOM", body: append([]byte{0xEF, 0xBB, 0xBF}, want...), want: []byte(want)},
+		{name: "UTF-16LE with BOM", body: utf16LE, want: []byte(want)},
+		{name: "Windows-1252 detected", body: cp1252, want: []byte(want)},
+		{name: "Windows-1252 declared", body: cp1252, contentType: "text/plain; charset=Cp1252", want: []byte(want)},
+		{name: "Binary content is untouched", body: zipHeader, contentType: "application/zip", want: zipHeader},
+		{name: "Conversion disabled", body: cp1252, opts: []DocumentOption{WithoutCharsetConversion()}, want: cp1252},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.contentType != "" {
+					w.Header().Set("Content-Type", tt.contentType)
+				}
+				_, _ = w.Write(tt.body)
+			}))
+			defer srv.Close()
+
+			doc, err := OpenDocument(context.Background(), presignedClient{}, srv.URL, tt.opts...)
+			assert.NoError(t, err)
+			got, err := io.ReadAll(doc)
+			assert.NoError(t, err)
+			assert.NoError(t, doc.Close())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOpenDocument_Integrity(t *testing.T) {
+	content := []byte("sku\tquantity\nABC\t4\n")
+	compressed := gzipBytes(t, content)
+	md5Sum := md5.Sum(content)
+	sha256Sum := sha256.Sum256(content)
+	crc := crc32.NewIEEE()
+	_, _ = crc.Write(content)
+
+	tests := []struct {
+		name          string
+		body          []byte
+		header        http.Header
+		contentLength int
+		wantErr       any
+	}{
+		{name: "Valid checksums", body: content, header: http.Header{
+			"Content-Md5":           {base64.StdEncoding.EncodeToString(md5Sum[:])},
+			"X-Amz-Checksum-Sha256": {base64.StdEncoding.EncodeToString(sha256Sum[:])},
+			"X-Amz-Checksum-Crc32":  {base64.StdEncoding.EncodeToString(crc.Sum(nil))},
+		}},
+		{name: "Multipart checksum is ignored", body: content, header: http.Header{"X-Amz-Checksum-Crc32": {"AAAAAA==-3"}}},
+		{name: "Checksum mismatch", body: content, header: http.Header{"X-Amz-Checksum-Sha256": {base64.StdEncoding.EncodeToString(make([]byte, 32))}},
+			wantErr: new(*ChecksumMismatchError)},
+		{name: "Truncated", body: content, contentLength: len(content) + 10, wantErr: new(*TruncatedDocumentError)},
+		{name: "Truncated gzip", body: compressed, contentLength: len(compressed) + 10, wantErr: new(*TruncatedDocumentError)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				for key, values := range tt.header {
+					w.Header()[key] = values
+				}
+				if tt.contentLength > 0 {
+					w.Header().Set("Content-Length", strconv.Itoa(tt.contentLength))
+				}
+				_, _ = w.Write(tt.body)
+			}))
+			defer srv.Close()
+
+			doc, err := OpenDocument(context.Background(), presignedClient{}, srv.URL)
+			assert.NoError(t, err)
+			defer doc.Close()
+			got, err := io.ReadAll(doc)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, content, got)
+				return
+			}
+			assert.ErrorIs(t, err, ErrDocumentCorrupt)
+			assert.ErrorAs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestOpenDocument_StreamsWhileDownloading(t *testing.T) {
+	rows := func(from, to int) []byte {
+		var b bytes.Buffer
+		for i := from; i < to; i++ {
+			b.WriteString("SKU-" + strconv.Itoa(i) + "\t1\n")
+		}
+		return b.Bytes()
+	}
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		gz := gzip.NewWriter(w)
+		_, _ = gz.Write(rows(0, 1000))
+		_ = gz.Flush()
+		w.(http.Flusher).Flush()
+		<-release
+		_, _ = gz.Write(rows(1000, 2000))
+		_ = gz.Close()
+	}))
+	defer srv.Close()
+	defer func() {
+		select {
+		case <-release:
+		default:
+			close(release)
+		}
+	}()
+
+	doc, err := OpenDocument(context.Background(), presignedClient{}, srv.URL)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer doc.Close()
+
+	firstRows := make([]byte, len(rows(0, 1000)))
+	read := make(chan error, 1)
+	go func() {
+		_, err := io.ReadFull(doc, firstRows)
+		read <- err
+	}()
+	select {
+	case err := <-read:
+		assert.NoError(t, err)
+		assert.Equal(t, rows(0, 1000), firstRows)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the first rows weren't decompressed before the download completed")
+	}
+
+	close(release)
+	rest, err := io.ReadAll(doc)
+	assert.NoError(t, err)
+	assert.Equal(t, rows(1000, 2000), rest)
+}
+
+func TestUploadDocument(t *testing.T) {
+	var uploaded []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "text/xml; charset=UTF-8", r.Header.Get("Content-Type"))
+		assert.Equal(t, int64(len("<feed/>")), r.ContentLength)
+		uploaded, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	err := UploadDocument(context.Background(), presignedClient{}, server.URL, "text/xml; charset=UTF-8", strings.NewReader("<feed/>"), int64(len("<feed/>")))
+	assert.NoError(t, err)
+	assert.Equal(t, "<feed/>", string(uploaded))
+}
@@ -0,0 +1,123 @@
+package easyship
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/easyShip/2022-03-23"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.EasyshipClient for tests.
+type Client interface {
+	ListHandoverSlots(ctx context.Context, request *ListHandoverSlotsRequest) (*apis.CallResponse[ListHandoverSlotsResponse], error)
+	GetScheduledPackage(ctx context.Context, filter *GetScheduledPackageFilter) (*apis.CallResponse[Package], error)
+	CreateScheduledPackage(ctx context.Context, request *CreateScheduledPackageRequest) (*apis.CallResponse[Package], error)
+	UpdateScheduledPackages(ctx context.Context, request *UpdateScheduledPackagesRequest) (*apis.CallResponse[Packages], error)
+	CreateScheduledPackageBulk(ctx context.Context, request *CreateScheduledPackagesRequest) (*apis.CallResponse[CreateScheduledPackagesResponse], error)
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// ListHandoverSlots returns time slots available for Easy Ship orders to be scheduled based on the package weight and dimensions that the seller specifies.
+// This operation is available for scheduled and unscheduled orders based on marketplace support.
+func (a *API) ListHandoverSlots(ctx context.Context, request *ListHandoverSlotsRequest) (*apis.CallResponse[ListHandoverSlotsResponse], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[ListHandoverSlotsResponse](http.MethodPost, pathPrefix+"/timeSlot").
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetScheduledPackage returns information about a package, including dimensions, weight, time slot information
+// for handover, invoice and item information, and status.
+func (a *API) GetScheduledPackage(ctx context.Context, filter *GetScheduledPackageFilter) (*apis.CallResponse[Package], error) {
+	if filter.AmazonOrderID == "" || filter.MarketplaceID == "" {
+		return nil, errors.New("amazonOrderID and marketplaceID are required")
+	}
+
+	params := url.Values{}
+	params.Add("amazonOrderId", filter.AmazonOrderID)
+	params.Add("marketplaceId", string(filter.MarketplaceID))
+
+	return apis.NewCall[Package](http.MethodGet, pathPrefix+"/package").
+		WithQueryParams(params).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// CreateScheduledPackage schedules an Easy Ship order and returns the scheduled package information.
+func (a *API) CreateScheduledPackage(ctx context.Context, request *CreateScheduledPackageRequest) (*apis.CallResponse[Package], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[Package](http.MethodPost, pathPrefix+"/package").
+		WithIdempotencyToken().
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// UpdateScheduledPackages updates the time slot for handing over the package indicated by the specified scheduledPackageId.
+// You can get the new slotId value for the time slot by calling the ListHandoverSlots operation before making another PATCH call.
+func (a *API) UpdateScheduledPackages(ctx context.Context, request *UpdateScheduledPackagesRequest) (*apis.CallResponse[Packages], error) {
+	if len(request.UpdatePackageDetailsList) > 25 {
+		return nil, errors.New("updatePackageDetailsList must not contain more than 25 elements")
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[Packages](http.MethodPatch, pathPrefix+"/package").
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// CreateScheduledPackageBulk schedules multiple Easy Ship orders and returns the scheduled packages, the rejected
+// orders and a presigned URL of a ZIP file with the shipping labels.
+func (a *API) CreateScheduledPackageBulk(ctx context.Context, request *CreateScheduledPackagesRequest) (*apis.CallResponse[CreateScheduledPackagesResponse], error) {
+	if len(request.OrderScheduleDetailsList) > 100 {
+		return nil, errors.New("orderScheduleDetailsList must not contain more than 100 elements")
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[CreateScheduledPackagesResponse](http.MethodPost, pathPrefix+"/packages/bulk").
+		WithIdempotencyToken().
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
@@ -0,0 +1,221 @@
+package easyship
+
+import (
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+// HandoverMethod Identifies the method by which a seller will hand a package over to Amazon Logistics.
+type HandoverMethod string
+
+const (
+	HandoverMethodPickup  HandoverMethod = "PICKUP"
+	HandoverMethodDropOff HandoverMethod = "DROPOFF"
+)
+
+// LabelFormat The file format in which the shipping label will be created.
+type LabelFormat string
+
+const (
+	LabelFormatPDF LabelFormat = "PDF"
+	LabelFormatZPL LabelFormat = "ZPL"
+)
+
+// PackageStatus The status of the package.
+type PackageStatus string
+
+const (
+	PackageStatusReadyForPickup   PackageStatus = "ReadyForPickup"
+	PackageStatusPickedUp         PackageStatus = "PickedUp"
+	PackageStatusAtOriginFC       PackageStatus = "AtOriginFC"
+	PackageStatusAtDestinationFC  PackageStatus = "AtDestinationFC"
+	PackageStatusDelivered        PackageStatus = "Delivered"
+	PackageStatusRejected         PackageStatus = "Rejected"
+	PackageStatusUndeliverable    PackageStatus = "Undeliverable"
+	PackageStatusReturnedToSeller PackageStatus = "ReturnedToSeller"
+	PackageStatusLostInTransit    PackageStatus = "LostInTransit"
+	PackageStatusLabelCanceled    PackageStatus = "LabelCanceled"
+	PackageStatusDamagedInTransit PackageStatus = "DamagedInTransit"
+	PackageStatusOutForDelivery   PackageStatus = "OutForDelivery"
+)
+
+// Dimensions The dimensions of the scheduled package.
+type Dimensions struct {
+	// The numerical value of the specified dimension.
+	Length *float64 `json:"length,omitempty"`
+	// The numerical value of the specified dimension.
+	Width *float64 `json:"width,omitempty"`
+	// The numerical value of the specified dimension.
+	Height *float64 `json:"height,omitempty"`
+	// The unit of measurement used to measure the length. Possible value: cm.
+	Unit *string `json:"unit,omitempty"`
+	// A string of up to 255 characters.
+	Identifier *string `json:"identifier,omitempty"`
+}
+
+// Weight The weight of the scheduled package.
+type Weight struct {
+	// The weight of the package.
+	Value *float64 `json:"value,omitempty"`
+	// The unit of measurement used to measure the weight. Possible values: grams, g.
+	Unit *string `json:"unit,omitempty"`
+}
+
+// TimeSlot A time window to hand over an Easy Ship package to Amazon Logistics.
+type TimeSlot struct {
+	// A string of up to 255 characters.
+	SlotID string `json:"slotId"`
+	// A datetime value in ISO 8601 format.
+	StartTime *time.Time `json:"startTime,omitempty"`
+	// A datetime value in ISO 8601 format.
+	EndTime *time.Time `json:"endTime,omitempty"`
+	// Identifies the method by which a seller will hand a package over to Amazon Logistics.
+	HandoverMethod *HandoverMethod `json:"handoverMethod,omitempty"`
+}
+
+// Item Item identifier and serial number information.
+type Item struct {
+	// The Amazon-defined order item identifier.
+	OrderItemID *string `json:"orderItemId,omitempty"`
+	// A list of serial numbers for the items associated with the OrderItemId value.
+	OrderItemSerialNumbers []string `json:"orderItemSerialNumbers,omitempty"`
+}
+
+// ScheduledPackageID Identifies the scheduled package to be updated.
+type ScheduledPackageID struct {
+	// An Amazon-defined order identifier. Identifies the order that the seller wants to deliver using Amazon Easy Ship.
+	AmazonOrderID string `json:"amazonOrderId"`
+	// An Amazon-defined identifier for the scheduled package.
+	PackageID *string `json:"packageId,omitempty"`
+}
+
+// InvoiceData Invoice number and date.
+type InvoiceData struct {
+	// A string of up to 255 characters.
+	InvoiceNumber string `json:"invoiceNumber"`
+	// A datetime value in ISO 8601 format.
+	InvoiceDate *time.Time `json:"invoiceDate,omitempty"`
+}
+
+// TrackingDetails Representation of tracking metadata.
+type TrackingDetails struct {
+	// A string of up to 255 characters.
+	TrackingID *string `json:"trackingId,omitempty"`
+}
+
+// Package represents an Easy Ship package, including the time slot and the status.
+type Package struct {
+	ScheduledPackageID ScheduledPackageID `json:"scheduledPackageId"`
+	PackageDimensions  Dimensions         `json:"packageDimensions"`
+	PackageWeight      Weight             `json:"packageWeight"`
+	// A list of items contained in the package.
+	PackageItems    []Item   `json:"packageItems,omitempty"`
+	PackageTimeSlot TimeSlot `json:"packageTimeSlot"`
+	// Optional seller-created identifier that is printed on the shipping label to help the seller identify the package.
+	PackageIdentifier *string          `json:"packageIdentifier,omitempty"`
+	Invoice           *InvoiceData     `json:"invoice,omitempty"`
+	PackageStatus     *PackageStatus   `json:"packageStatus,omitempty"`
+	TrackingDetails   *TrackingDetails `json:"trackingDetails,omitempty"`
+}
+
+// Packages A list of packages.
+type Packages struct {
+	Packages []Package `json:"packages"`
+}
+
+// ListHandoverSlotsRequest The request schema for the listHandoverSlots operation.
+type ListHandoverSlotsRequest struct {
+	// A string of up to 255 characters.
+	MarketplaceID constants.MarketplaceID `json:"marketplaceId"`
+	// An Amazon-defined order identifier. Identifies the order that the seller wants to deliver using Amazon Easy Ship.
+	AmazonOrderID     string     `json:"amazonOrderId"`
+	PackageDimensions Dimensions `json:"packageDimensions"`
+	PackageWeight     Weight     `json:"packageWeight"`
+}
+
+// ListHandoverSlotsResponse The response schema for the listHandoverSlots operation.
+type ListHandoverSlotsResponse struct {
+	// An Amazon-defined order identifier. Identifies the order that the seller wants to deliver using Amazon Easy Ship.
+	AmazonOrderID string `json:"amazonOrderId"`
+	// A list of time slots.
+	TimeSlots []TimeSlot `json:"timeSlots"`
+}
+
+// GetScheduledPackageFilter is used to identify the package in the GetScheduledPackage call.
+type GetScheduledPackageFilter struct {
+	// AmazonOrderID an Amazon-defined order identifier. Required.
+	AmazonOrderID string
+	// MarketplaceID an identifier for the marketplace in which the seller is selling. Required.
+	MarketplaceID constants.MarketplaceID
+}
+
+// PackageDetails Package details. Includes packageItems, packageTimeSlot, and packageIdentifier.
+type PackageDetails struct {
+	// A list of items contained in the package.
+	PackageItems    []Item   `json:"packageItems,omitempty"`
+	PackageTimeSlot TimeSlot `json:"packageTimeSlot"`
+	// Optional seller-created identifier that is printed on the shipping label to help the seller identify the package.
+	PackageIdentifier *string `json:"packageIdentifier,omitempty"`
+}
+
+// CreateScheduledPackageRequest The request schema for the createScheduledPackage operation.
+type CreateScheduledPackageRequest struct {
+	// An Amazon-defined order identifier. Identifies the order that the seller wants to deliver using Amazon Easy Ship.
+	AmazonOrderID string `json:"amazonOrderId"`
+	// A string of up to 255 characters.
+	MarketplaceID  constants.MarketplaceID `json:"marketplaceId"`
+	PackageDetails PackageDetails          `json:"packageDetails"`
+}
+
+// UpdatePackageDetails Request to update the time slot of a package.
+type UpdatePackageDetails struct {
+	ScheduledPackageID ScheduledPackageID `json:"scheduledPackageId"`
+	PackageTimeSlot    TimeSlot           `json:"packageTimeSlot"`
+}
+
+// UpdateScheduledPackagesRequest The request schema for the updateScheduledPackages operation.
+type UpdateScheduledPackagesRequest struct {
+	// A string of up to 255 characters.
+	MarketplaceID constants.MarketplaceID `json:"marketplaceId"`
+	// A list of package details.
+	UpdatePackageDetailsList []UpdatePackageDetails `json:"updatePackageDetailsList"`
+}
+
+// OrderScheduleDetails This object allows users to specify an order to be scheduled. Only the amazonOrderId is required.
+type OrderScheduleDetails struct {
+	// An Amazon-defined order identifier. Identifies the order that the seller wants to deliver using Amazon Easy Ship.
+	AmazonOrderID  string          `json:"amazonOrderId"`
+	PackageDetails *PackageDetails `json:"packageDetails,omitempty"`
+}
+
+// CreateScheduledPackagesRequest The request body for the POST /easyShip/2022-03-23/packages/bulk API.
+type CreateScheduledPackagesRequest struct {
+	// A string of up to 255 characters.
+	MarketplaceID constants.MarketplaceID `json:"marketplaceId"`
+	// An array allowing users to specify orders to be scheduled.
+	OrderScheduleDetailsList []OrderScheduleDetails `json:"orderScheduleDetailsList"`
+	// The file format in which the shipping label will be created.
+	LabelFormat LabelFormat `json:"labelFormat"`
+}
+
+// RejectedOrder A order which we couldn't schedule on your behalf. It contains its id, and information on the error.
+type RejectedOrder struct {
+	// An Amazon-defined order identifier. Identifies the order that the seller wants to deliver using Amazon Easy Ship.
+	AmazonOrderID string      `json:"amazonOrderId"`
+	Error         *apis.Error `json:"error,omitempty"`
+}
+
+// CreateScheduledPackagesResponse The response schema for the bulk scheduling API. It returns by the bulk
+// scheduling API containing an array of the scheduled packtages, an optional list of orders we couldn't
+// schedule with the reason, and a pre-signed URL for a ZIP file containing the associated shipping labels
+// plus the documents enabled for your marketplace.
+type CreateScheduledPackagesResponse struct {
+	// A list of packages. Refer to the Package object.
+	ScheduledPackages []Package `json:"scheduledPackages,omitempty"`
+	// A list of orders we couldn't scheduled on your behalf. Each element contains the reason and details on the error.
+	RejectedOrders []RejectedOrder `json:"rejectedOrders,omitempty"`
+	// A pre-signed URL for the zip document containing the shipping labels and the documents enabled for your marketplace.
+	PrintableDocumentsURL *string `json:"printableDocumentsUrl,omitempty"`
+}
@@ -1,12 +1,13 @@
 package apis
 
 import (
+	"errors"
 	"fmt"
-	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"strings"
 )
 
 var (
-	ErrMaxRetryCountReached = fmt.Errorf("max retry count of %d reached", constants.MaxRetryCountOnTooManyRequestsError)
+	ErrMaxRetryCountReached = errors.New("max retry count reached")
 )
 
 // Error response returned when the request is unsuccessful.
@@ -23,3 +24,67 @@ type Error struct {
 type ErrorList struct {
 	Errors []Error `json:"errors"`
 }
+
+// APIError is the cause of the error returned by Call.Execute if SP-API answers with a 4xx or
+// 5xx status code. Use errors.As to inspect it:
+//
+//	var apiErr *apis.APIError
+//	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound { ... }
+type APIError struct {
+	StatusCode int
+	// RequestID is the x-amzn-RequestId of the response, which Amazon support asks for.
+	RequestID string
+	URL       string
+	// Errors contains the errors of the response body, if the call parses them.
+	Errors []Error
+}
+
+func (e *APIError) Error() string {
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "request with URL=%v returned with non-OK statuscode=%d", e.URL, e.StatusCode)
+	for _, err := range e.Errors {
+		fmt.Fprintf(&msg, "\ncode=%s, message=%s", err.Code, err.Message)
+		if err.Details != nil {
+			fmt.Fprintf(&msg, ", details=%s", *err.Details)
+		}
+	}
+	return msg.String()
+}
+
+// CallError wraps all errors returned by Call.Execute with the identifiers Amazon support asks for.
+// Err is an *APIError for 4xx and 5xx responses, so both can be inspected with errors.As:
+//
+//	var callErr *apis.CallError
+//	if errors.As(err, &callErr) {
+//		log.Error("SP-API call failed", "operation", callErr.Operation, "requestID", callErr.RequestID)
+//	}
+type CallError struct {
+	// Operation is the operation of the call, e.g. "orders.GetOrders".
+	Operation string
+	// StatusCode is the status of the last response, or 0 if no response was received.
+	StatusCode int
+	// RequestID is the x-amzn-RequestId of the last response, if any.
+	RequestID string
+	Err       error
+}
+
+func (e *CallError) Error() string {
+	if e.StatusCode == 0 {
+		return fmt.Sprintf("%s failed: %v", e.Operation, e.Err)
+	}
+	return fmt.Sprintf("%s failed with status %d (request ID %s): %v", e.Operation, e.StatusCode, e.RequestID, e.Err)
+}
+
+func (e *CallError) Unwrap() error {
+	return e.Err
+}
+
+// HasCode reports whether the response contains an error with the given code, e.g. "InvalidInput".
+func (e *APIError) HasCode(code string) bool {
+	for _, err := range e.Errors {
+		if err.Code == code {
+			return true
+		}
+	}
+	return false
+}
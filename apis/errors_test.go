@@ -0,0 +1,30 @@
+package apis
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	details := "marketplaceIds must not be empty"
+	var err error = &APIError{
+		StatusCode: http.StatusBadRequest,
+		URL:        "/orders/v0/orders",
+		Errors:     []Error{{Code: "InvalidInput", Message: "Invalid Input", Details: &details}},
+	}
+	wrapped := fmt.Errorf("listing orders: %w", err)
+
+	var apiErr *APIError
+	if !errors.As(wrapped, &apiErr) {
+		t.Fatal("errors.As should find the APIError")
+	}
+	if !apiErr.HasCode("InvalidInput") || apiErr.HasCode("NotFound") {
+		t.Errorf("HasCode() different for %v", apiErr.Errors)
+	}
+	want := "request with URL=/orders/v0/orders returned with non-OK statuscode=400\ncode=InvalidInput, message=Invalid Input, details=marketplaceIds must not be empty"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,105 @@
+package fbasmallandlight
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go/types"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+// pathPrefix of the FBA Small and Light API. In marketplaces where the program has been replaced
+// by the Low-Price FBA rates, Amazon keeps serving eligibility and fee previews through these endpoints.
+const pathPrefix = "/fba/smallAndLight/v1"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.FbasmallandlightClient for tests.
+type Client interface {
+	GetEnrollmentBySellerSKU(ctx context.Context, sellerSKU string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[Enrollment], error)
+	PutEnrollmentBySellerSKU(ctx context.Context, sellerSKU string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[Enrollment], error)
+	DeleteEnrollmentBySellerSKU(ctx context.Context, sellerSKU string, marketplaceID constants.MarketplaceID) error
+	GetEligibilityBySellerSKU(ctx context.Context, sellerSKU string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[Eligibility], error)
+	GetFeePreview(ctx context.Context, request *FeePreviewRequest) (*apis.CallResponse[FeePreviews], error)
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// GetEnrollmentBySellerSKU returns the Small and Light enrollment status for the item indicated by the sellerSKU.
+func (a *API) GetEnrollmentBySellerSKU(ctx context.Context, sellerSKU string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[Enrollment], error) {
+	return apis.NewCall[Enrollment](http.MethodGet, pathPrefix+"/enrollments/{sellerSKU}").
+		WithPathParam("sellerSKU", sellerSKU).
+		WithQueryParams(marketplaceQuery(marketplaceID)).
+		WithParseErrorListOnError().
+		WithRateLimit(2, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// PutEnrollmentBySellerSKU enrolls the item indicated by the sellerSKU in the Small and Light program.
+func (a *API) PutEnrollmentBySellerSKU(ctx context.Context, sellerSKU string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[Enrollment], error) {
+	return apis.NewCall[Enrollment](http.MethodPut, pathPrefix+"/enrollments/{sellerSKU}").
+		WithPathParam("sellerSKU", sellerSKU).
+		WithQueryParams(marketplaceQuery(marketplaceID)).
+		WithParseErrorListOnError().
+		WithRateLimit(2, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// DeleteEnrollmentBySellerSKU removes the item indicated by the sellerSKU from the Small and Light program.
+func (a *API) DeleteEnrollmentBySellerSKU(ctx context.Context, sellerSKU string, marketplaceID constants.MarketplaceID) error {
+	_, err := apis.NewCall[types.Nil](http.MethodDelete, pathPrefix+"/enrollments/{sellerSKU}").
+		WithPathParam("sellerSKU", sellerSKU).
+		WithQueryParams(marketplaceQuery(marketplaceID)).
+		WithParseErrorListOnError().
+		WithRateLimit(2, time.Second).
+		Execute(ctx, a.httpClient)
+	return err
+}
+
+// GetEligibilityBySellerSKU returns the Small and Light eligibility status for the item indicated by the sellerSKU.
+func (a *API) GetEligibilityBySellerSKU(ctx context.Context, sellerSKU string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[Eligibility], error) {
+	return apis.NewCall[Eligibility](http.MethodGet, pathPrefix+"/eligibilities/{sellerSKU}").
+		WithPathParam("sellerSKU", sellerSKU).
+		WithQueryParams(marketplaceQuery(marketplaceID)).
+		WithParseErrorListOnError().
+		WithRateLimit(2, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetFeePreview returns the Small and Light fee estimates for the specified items. At most 25 items can be requested.
+func (a *API) GetFeePreview(ctx context.Context, request *FeePreviewRequest) (*apis.CallResponse[FeePreviews], error) {
+	if len(request.Items) == 0 || len(request.Items) > 25 {
+		return nil, errors.New("items must contain between 1 and 25 entries")
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[FeePreviews](http.MethodPost, pathPrefix+"/feePreviews").
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+func marketplaceQuery(marketplaceID constants.MarketplaceID) url.Values {
+	q := url.Values{}
+	q.Add("marketplaceIds", string(marketplaceID))
+	return q
+}
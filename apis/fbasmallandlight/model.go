@@ -0,0 +1,96 @@
+package fbasmallandlight
+
+import (
+	"encoding/json"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+// EnrollmentStatus The Small and Light enrollment status of the item.
+type EnrollmentStatus string
+
+const (
+	EnrollmentStatusEnrolled    EnrollmentStatus = "ENROLLED"
+	EnrollmentStatusNotEnrolled EnrollmentStatus = "NOT_ENROLLED"
+)
+
+// EligibilityStatus The Small and Light eligibility status of the item.
+type EligibilityStatus string
+
+const (
+	EligibilityStatusEligible    EligibilityStatus = "ELIGIBLE"
+	EligibilityStatusNotEligible EligibilityStatus = "NOT_ELIGIBLE"
+)
+
+// FeeType The type of fee charged to a seller.
+type FeeType string
+
+const (
+	FeeTypeFBAWeightBasedFee         FeeType = "FBAWeightBasedFee"
+	FeeTypeFBAPerOrderFulfillmentFee FeeType = "FBAPerOrderFulfillmentFee"
+	FeeTypeFBAPerUnitFulfillmentFee  FeeType = "FBAPerUnitFulfillmentFee"
+	FeeTypeCommission                FeeType = "Commission"
+)
+
+// Enrollment The Small and Light enrollment status of the item indicated by the specified seller SKU.
+type Enrollment struct {
+	MarketplaceID constants.MarketplaceID `json:"marketplaceId"`
+	// Identifies an item in the given marketplace. SellerSKU is qualified by the seller's SellerId.
+	SellerSKU string           `json:"sellerSKU"`
+	Status    EnrollmentStatus `json:"status"`
+}
+
+// Eligibility The Small and Light eligibility status of the item indicated by the specified seller SKU.
+type Eligibility struct {
+	MarketplaceID constants.MarketplaceID `json:"marketplaceId"`
+	// Identifies an item in the given marketplace. SellerSKU is qualified by the seller's SellerId.
+	SellerSKU string            `json:"sellerSKU"`
+	Status    EligibilityStatus `json:"status"`
+}
+
+// MoneyType An amount of money, including units in the form of currency.
+type MoneyType struct {
+	// The currency code in ISO 4217 format.
+	CurrencyCode *string `json:"currencyCode,omitempty"`
+	// The monetary value.
+	Amount *json.Number `json:"amount,omitempty"`
+}
+
+// Item An item to be sold.
+type Item struct {
+	// The Amazon Standard Identification Number (ASIN) value used to identify the item.
+	ASIN  string    `json:"asin"`
+	Price MoneyType `json:"price"`
+}
+
+// FeePreviewRequest Request schema for submitting items for which to retrieve fee estimates.
+type FeePreviewRequest struct {
+	MarketplaceID constants.MarketplaceID `json:"marketplaceId"`
+	// A list of items for which to retrieve fee estimates (limit: 25).
+	Items []Item `json:"items"`
+}
+
+// FeeLineItem Fee details for a specific fee.
+type FeeLineItem struct {
+	FeeType   FeeType   `json:"feeType"`
+	FeeCharge MoneyType `json:"feeCharge"`
+}
+
+// FeePreview The fee estimate for a specific item.
+type FeePreview struct {
+	// The Amazon Standard Identification Number (ASIN) value used to identify the item.
+	ASIN  *string    `json:"asin,omitempty"`
+	Price *MoneyType `json:"price,omitempty"`
+	// A list of the Small and Light fees for the item, if any.
+	FeeBreakdown []FeeLineItem `json:"feeBreakdown,omitempty"`
+	TotalFees    *MoneyType    `json:"totalFees,omitempty"`
+	// One or more unexpected errors occurred during the fee estimate.
+	Errors []apis.Error `json:"errors,omitempty"`
+}
+
+// FeePreviews The response of the GetFeePreview operation.
+type FeePreviews struct {
+	// A list of fee estimates for the requested items. The order of the fee estimates will follow the order of the items in the request.
+	Data []FeePreview `json:"data,omitempty"`
+}
@@ -0,0 +1,21 @@
+package fbasmallandlight
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoneyType_KeepsExactAmount(t *testing.T) {
+	var money MoneyType
+	require.NoError(t, json.Unmarshal([]byte(`{"currencyCode":"EUR","amount":19.99}`), &money))
+	require.NotNil(t, money.Amount)
+	assert.Equal(t, json.Number("19.99"), *money.Amount)
+
+	out, err := json.Marshal(money)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"currencyCode":"EUR","amount":19.99}`, string(out))
+	assert.Contains(t, string(out), `"amount":19.99`)
+}
@@ -1,8 +1,10 @@
 package feeds
 
 import (
+	"context"
 	"encoding/json"
 	"go/types"
+	"io"
 	"net/http"
 	"time"
 
@@ -16,6 +18,20 @@ type API struct {
 	httpClient *httpx.Client
 }
 
+// Client is the interface of API, implemented by *API and by mocks.FeedsClient for tests.
+type Client interface {
+	GetFeeds(ctx context.Context, filter *GetFeedsRequestFilter) (*apis.CallResponse[GetFeedsResponse], error)
+	FeedsPaginator(filter *GetFeedsRequestFilter) *apis.Paginator[Feed]
+	CreateFeed(ctx context.Context, specification *CreateFeedSpecification) (*apis.CallResponse[CreateFeedResponse], error)
+	GetFeed(ctx context.Context, feedID string) (*apis.CallResponse[Feed], error)
+	CancelFeed(ctx context.Context, feedID string) error
+	CreateFeedDocument(ctx context.Context, specification *CreateFeedDocumentSpecification) (*apis.CallResponse[CreateFeedDocumentResponse], error)
+	UploadFeedDocument(ctx context.Context, document *CreateFeedDocumentResponse, contentType string, r io.Reader, contentLength int64) error
+	GetFeedDocument(ctx context.Context, feedDocumentID string) (*apis.CallResponse[FeedDocument], error)
+}
+
+var _ Client = (*API)(nil)
+
 func NewAPI(httpClient *httpx.Client) *API {
 	return &API{
 		httpClient: httpClient,
@@ -23,16 +39,36 @@ func NewAPI(httpClient *httpx.Client) *API {
 }
 
 // GetFeeds returns feed details for the feeds that match the filters that you specify.
-func (a *API) GetFeeds(filter *GetFeedsRequestFilter) (*apis.CallResponse[GetFeedsResponse], error) {
+func (a *API) GetFeeds(ctx context.Context, filter *GetFeedsRequestFilter) (*apis.CallResponse[GetFeedsResponse], error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
 	return apis.NewCall[GetFeedsResponse](http.MethodGet, pathPrefix+"/feeds").
 		WithQueryParams(filter.GetQuery()).
 		WithParseErrorListOnError().
 		WithRateLimit(0.0222, time.Second).
-		Execute(a.httpClient)
+		WithBurst(10).
+		Execute(ctx, a.httpClient)
+}
+
+// FeedsPaginator returns a Paginator over the feeds that match the filters that you specify.
+// The first page is fetched with the filter, the following pages with their nextToken only.
+func (a *API) FeedsPaginator(filter *GetFeedsRequestFilter) *apis.Paginator[Feed] {
+	return apis.NewPaginator(func(ctx context.Context, nextToken string) ([]Feed, string, error) {
+		pageFilter := filter
+		if nextToken != "" {
+			pageFilter = &GetFeedsRequestFilter{NextToken: nextToken}
+		}
+		resp, err := a.GetFeeds(ctx, pageFilter)
+		if err != nil || resp.ResponseBody == nil {
+			return nil, "", err
+		}
+		return resp.ResponseBody.Feeds, apis.Deref(resp.ResponseBody.NextToken), nil
+	})
 }
 
 // CreateFeed creates a feed. Upload the contents of the feed document before calling this operation.
-func (a *API) CreateFeed(specification *CreateFeedSpecification) (*apis.CallResponse[CreateFeedResponse], error) {
+func (a *API) CreateFeed(ctx context.Context, specification *CreateFeedSpecification) (*apis.CallResponse[CreateFeedResponse], error) {
 	body, err := json.Marshal(specification)
 	if err != nil {
 		return nil, err
@@ -42,31 +78,36 @@ func (a *API) CreateFeed(specification *CreateFeedSpecification) (*apis.CallResp
 		WithBody(body).
 		WithParseErrorListOnError().
 		WithRateLimit(0.0083, time.Second).
-		Execute(a.httpClient)
+		WithBurst(15).
+		Execute(ctx, a.httpClient)
 }
 
 // GetFeed returns feed details (including the resultDocumentId, if available) for the feed that you specify.
-func (a *API) GetFeed(feedID string) (*apis.CallResponse[Feed], error) {
-	return apis.NewCall[Feed](http.MethodGet, pathPrefix+"/feeds/"+feedID).
+func (a *API) GetFeed(ctx context.Context, feedID string) (*apis.CallResponse[Feed], error) {
+	return apis.NewCall[Feed](http.MethodGet, pathPrefix+"/feeds/{feedID}").
+		WithPathParam("feedID", feedID).
 		WithParseErrorListOnError().
 		WithRateLimit(2, time.Second).
-		Execute(a.httpClient)
+		WithBurst(15).
+		Execute(ctx, a.httpClient)
 }
 
 // CancelFeed cancels the feed that you specify. Only feeds with processingStatus=IN_QUEUE can be cancelled.
 // Cancelled feeds are returned in subsequent calls to the getFeed and getFeeds operations.
-func (a *API) CancelFeed(feedID string) error {
-	_, err := apis.NewCall[types.Nil](http.MethodDelete, pathPrefix+"/feeds/"+feedID).
+func (a *API) CancelFeed(ctx context.Context, feedID string) error {
+	_, err := apis.NewCall[types.Nil](http.MethodDelete, pathPrefix+"/feeds/{feedID}").
+		WithPathParam("feedID", feedID).
 		WithParseErrorListOnError().
 		WithRateLimit(0.0222, time.Second).
-		Execute(a.httpClient)
+		WithBurst(10).
+		Execute(ctx, a.httpClient)
 	return err
 }
 
 // CreateFeedDocument creates a feed document for the feed type that you specify.
 // This operation returns a presigned URL for uploading the feed document contents.
 // It also returns a feedDocumentId value that you can pass in with a subsequent call to the createFeed operation.
-func (a *API) CreateFeedDocument(specification *CreateFeedDocumentSpecification) (*apis.CallResponse[CreateFeedDocumentResponse], error) {
+func (a *API) CreateFeedDocument(ctx context.Context, specification *CreateFeedDocumentSpecification) (*apis.CallResponse[CreateFeedDocumentResponse], error) {
 	body, err := json.Marshal(specification)
 	if err != nil {
 		return nil, err
@@ -76,13 +117,22 @@ func (a *API) CreateFeedDocument(specification *CreateFeedDocumentSpecification)
 		WithBody(body).
 		WithParseErrorListOnError().
 		WithRateLimit(0.0083, time.Second).
-		Execute(a.httpClient)
+		WithBurst(15).
+		Execute(ctx, a.httpClient)
+}
+
+// UploadFeedDocument streams the feed contents from r to the presigned URL of a document created by
+// CreateFeedDocument. contentType must match the one of the CreateFeedDocumentSpecification,
+// contentLength is the size of the contents, or -1 if unknown.
+func (a *API) UploadFeedDocument(ctx context.Context, document *CreateFeedDocumentResponse, contentType string, r io.Reader, contentLength int64) error {
+	return apis.UploadDocument(ctx, a.httpClient, document.Url, contentType, r, contentLength)
 }
 
 // GetFeedDocument the information required for retrieving a feed document's contents.
-func (a *API) GetFeedDocument(feedDocumentID string) (*apis.CallResponse[FeedDocument], error) {
-	return apis.NewCall[FeedDocument](http.MethodGet, pathPrefix+"/documents/"+feedDocumentID).
+func (a *API) GetFeedDocument(ctx context.Context, feedDocumentID string) (*apis.CallResponse[FeedDocument], error) {
+	return apis.NewCall[FeedDocument](http.MethodGet, pathPrefix+"/documents/{feedDocumentID}").
+		WithPathParam("feedDocumentID", feedDocumentID).
 		WithParseErrorListOnError().
 		WithRateLimit(1.0, time.Minute). // documented value (2/sec) seems way too much (many http 429 errors)
-		Execute(a.httpClient)
+		Execute(ctx, a.httpClient)
 }
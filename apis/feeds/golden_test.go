@@ -0,0 +1,22 @@
+package feeds
+
+import (
+	"testing"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/internal/golden"
+)
+
+func TestGoldenFixtures(t *testing.T) {
+	t.Run("getFeed", func(t *testing.T) {
+		golden.RoundTrip[Feed](t, "testdata/getFeed.json")
+	})
+	t.Run("getFeeds", func(t *testing.T) {
+		golden.RoundTrip[GetFeedsResponse](t, "testdata/getFeeds.json")
+	})
+	t.Run("createFeedDocument", func(t *testing.T) {
+		golden.RoundTrip[CreateFeedDocumentResponse](t, "testdata/createFeedDocument.json")
+	})
+	t.Run("getFeedDocument", func(t *testing.T) {
+		golden.RoundTrip[FeedDocument](t, "testdata/getFeedDocument.json")
+	})
+}
@@ -1,6 +1,7 @@
 package feeds
 
 import (
+	"errors"
 	"github.com/fond-of-vertigo/amazon-sp-api/internal/utils"
 	"net/url"
 	"strconv"
@@ -26,6 +27,11 @@ const (
 	ProcessingStatusInQueue ProcessingStatus = "IN_QUEUE"
 )
 
+// IsTerminal reports whether the feed processing finished and the status won't change anymore.
+func (s ProcessingStatus) IsTerminal() bool {
+	return s == ProcessingStatusDone || s == ProcessingStatusCanceled || s == ProcessingStatusFatal
+}
+
 // Feed contains detailed information about the feed.
 type Feed struct {
 	// The identifier for the feed. This identifier is unique only in combination with a seller ID.
@@ -87,19 +93,34 @@ type GetFeedsRequestFilter struct {
 	// Minimum 1. Maximum 100.
 	PageSize int `json:"pageSize,omitempty"`
 	// A list of processing statuses used to filter feeds.
-	ProcessingStatuses []string `json:"processingStatuses,omitempty"`
+	ProcessingStatuses []ProcessingStatus `json:"processingStatuses,omitempty"`
 	// The earliest feed creation date and time for feeds included in the response, in ISO 8601 format.
 	//The default is 90 days ago. Feeds are retained for a maximum of 90 days.
 	CreatedSince apis.JsonTimeISO8601 `json:"createdSince,omitempty"`
 	// The latest feed creation date and time for feeds included in the response, in ISO 8601 format.
 	// The default is now.
 	CreatedUntil apis.JsonTimeISO8601 `json:"createdUntil,omitempty"`
-	// The token returned by a previous call to this operation.
+	// The token returned by a previous call to this operation. The getFeeds operation rejects nextToken
+	// combined with other parameters, so if it is set, it is sent as the only parameter and the other
+	// filters are ignored. Set it on the filter of the first page to get the next one.
 	NextToken string `json:"nextToken,omitempty"`
 }
 
+// Validate checks that feedTypes or nextToken is set, as the getFeeds operation requires.
+func (f *GetFeedsRequestFilter) Validate() error {
+	if f.NextToken == "" && len(f.FeedTypes) == 0 {
+		return errors.New("feedTypes or nextToken is required")
+	}
+	return nil
+}
+
+// GetQuery returns the query parameters of the filter, only nextToken if NextToken is set.
 func (f *GetFeedsRequestFilter) GetQuery() url.Values {
 	q := url.Values{}
+	if f.NextToken != "" {
+		q.Set("nextToken", f.NextToken)
+		return q
+	}
 
 	feedTypes := strings.Join(utils.FirstNElementsOfSlice(f.FeedTypes, 10), ",")
 	if feedTypes != "" {
@@ -116,7 +137,7 @@ func (f *GetFeedsRequestFilter) GetQuery() url.Values {
 		q.Set("pageSize", strconv.Itoa(f.PageSize))
 	}
 
-	processingStatuses := strings.Join(f.ProcessingStatuses, ",")
+	processingStatuses := utils.MapToCommaString(f.ProcessingStatuses)
 	if processingStatuses != "" {
 		q.Set("processingStatuses", processingStatuses)
 	}
@@ -129,10 +150,6 @@ func (f *GetFeedsRequestFilter) GetQuery() url.Values {
 		q.Set("createdUntil", f.CreatedUntil.String())
 	}
 
-	if f.NextToken != "" {
-		q.Set("nextToken", f.NextToken)
-	}
-
 	return q
 }
 
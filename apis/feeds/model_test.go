@@ -0,0 +1,31 @@
+package feeds
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFeedsRequestFilter_GetQuery(t *testing.T) {
+	filter := &GetFeedsRequestFilter{
+		FeedTypes:      []string{"POST_PRODUCT_DATA"},
+		MarketplaceIDs: []constants.MarketplaceID{constants.Germany},
+		PageSize:       20,
+	}
+	assert.Equal(t, url.Values{
+		"feedTypes":      {"POST_PRODUCT_DATA"},
+		"marketplaceIds": {"A1PA6795UKMFR9"},
+		"pageSize":       {"20"},
+	}, filter.GetQuery())
+
+	filter.NextToken = "abc"
+	assert.Equal(t, url.Values{"nextToken": {"abc"}}, filter.GetQuery(), "the other filters must not be sent with nextToken")
+}
+
+func TestGetFeedsRequestFilter_Validate(t *testing.T) {
+	assert.NoError(t, (&GetFeedsRequestFilter{FeedTypes: []string{"POST_PRODUCT_DATA"}}).Validate())
+	assert.NoError(t, (&GetFeedsRequestFilter{NextToken: "abc", PageSize: 20}).Validate())
+	assert.Error(t, (&GetFeedsRequestFilter{}).Validate())
+}
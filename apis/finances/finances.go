@@ -1,6 +1,7 @@
 package finances
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"time"
@@ -15,6 +16,16 @@ type API struct {
 	httpClient *httpx.Client
 }
 
+// Client is the interface of API, implemented by *API and by mocks.FinancesClient for tests.
+type Client interface {
+	ListFinancialEventGroups(ctx context.Context, filter *ListFinancialEventGroupsFilter) (*apis.CallResponse[ListFinancialEventGroupsResponse], error)
+	ListFinancialEventsByGroupID(ctx context.Context, eventGroupID string, filter *ListFinancialEventsByIDFilter) (*apis.CallResponse[ListFinancialEventsResponse], error)
+	ListFinancialEventsByOrderID(ctx context.Context, orderID string, filter *ListFinancialEventsByIDFilter) (*apis.CallResponse[ListFinancialEventsResponse], error)
+	ListFinancialEvents(ctx context.Context, filter *ListFinancialEventsFilter) (*apis.CallResponse[ListFinancialEventsResponse], error)
+}
+
+var _ Client = (*API)(nil)
+
 func NewAPI(httpClient *httpx.Client) *API {
 	return &API{
 		httpClient: httpClient,
@@ -22,7 +33,7 @@ func NewAPI(httpClient *httpx.Client) *API {
 }
 
 // ListFinancialEventGroups returns financial event groups for a given date range.
-func (a *API) ListFinancialEventGroups(filter *ListFinancialEventGroupsFilter) (*apis.CallResponse[ListFinancialEventGroupsResponse], error) {
+func (a *API) ListFinancialEventGroups(ctx context.Context, filter *ListFinancialEventGroupsFilter) (*apis.CallResponse[ListFinancialEventGroupsResponse], error) {
 	if filter.MaxResultsPerPage != nil && (*filter.MaxResultsPerPage < 1 || *filter.MaxResultsPerPage > 100) {
 		return nil, errors.New("maxResultsPerPage must be between 1 and 100")
 	}
@@ -30,35 +41,40 @@ func (a *API) ListFinancialEventGroups(filter *ListFinancialEventGroupsFilter) (
 	return apis.NewCall[ListFinancialEventGroupsResponse](http.MethodGet, pathPrefix+"/financialEventGroups").
 		WithQueryParams(filter.GetQuery()).
 		WithRateLimit(0.5, time.Second).
-		Execute(a.httpClient)
+		WithBurst(30).
+		Execute(ctx, a.httpClient)
 }
 
 // ListFinancialEventsByGroupID returns all financial events for the specified financial event group.
-func (a *API) ListFinancialEventsByGroupID(eventGroupID string, filter *ListFinancialEventsByIDFilter) (*apis.CallResponse[ListFinancialEventsResponse], error) {
+func (a *API) ListFinancialEventsByGroupID(ctx context.Context, eventGroupID string, filter *ListFinancialEventsByIDFilter) (*apis.CallResponse[ListFinancialEventsResponse], error) {
 	if filter.MaxResultsPerPage != nil && (*filter.MaxResultsPerPage < 1 || *filter.MaxResultsPerPage > 100) {
 		return nil, errors.New("maxResultsPerPage must be between 1 and 100")
 	}
 
-	return apis.NewCall[ListFinancialEventsResponse](http.MethodGet, pathPrefix+"/financialEventGroups/"+eventGroupID+"/financialEvents").
+	return apis.NewCall[ListFinancialEventsResponse](http.MethodGet, pathPrefix+"/financialEventGroups/{eventGroupID}/financialEvents").
+		WithPathParam("eventGroupID", eventGroupID).
 		WithQueryParams(filter.GetQuery()).
 		WithRateLimit(0.5, time.Second).
-		Execute(a.httpClient)
+		WithBurst(30).
+		Execute(ctx, a.httpClient)
 }
 
 // ListFinancialEventsByOrderID returns all financial events for the specified order.
-func (a *API) ListFinancialEventsByOrderID(orderID string, filter *ListFinancialEventsByIDFilter) (*apis.CallResponse[ListFinancialEventsResponse], error) {
+func (a *API) ListFinancialEventsByOrderID(ctx context.Context, orderID string, filter *ListFinancialEventsByIDFilter) (*apis.CallResponse[ListFinancialEventsResponse], error) {
 	if filter.MaxResultsPerPage != nil && (*filter.MaxResultsPerPage < 1 || *filter.MaxResultsPerPage > 100) {
 		return nil, errors.New("maxResultsPerPage must be between 1 and 100")
 	}
 
-	return apis.NewCall[ListFinancialEventsResponse](http.MethodGet, pathPrefix+"/orders/"+orderID+"/financialEvents").
+	return apis.NewCall[ListFinancialEventsResponse](http.MethodGet, pathPrefix+"/orders/{orderID}/financialEvents").
+		WithPathParam("orderID", orderID).
 		WithQueryParams(filter.GetQuery()).
 		WithRateLimit(0.5, time.Second).
-		Execute(a.httpClient)
+		WithBurst(30).
+		Execute(ctx, a.httpClient)
 }
 
 // ListFinancialEvents returns financial events for the specified data range.
-func (a *API) ListFinancialEvents(filter *ListFinancialEventsFilter) (*apis.CallResponse[ListFinancialEventsResponse], error) {
+func (a *API) ListFinancialEvents(ctx context.Context, filter *ListFinancialEventsFilter) (*apis.CallResponse[ListFinancialEventsResponse], error) {
 	if filter.MaxResultsPerPage != nil && (*filter.MaxResultsPerPage < 1 || *filter.MaxResultsPerPage > 100) {
 		return nil, errors.New("maxResultsPerPage must be between 1 and 100")
 	}
@@ -66,5 +82,6 @@ func (a *API) ListFinancialEvents(filter *ListFinancialEventsFilter) (*apis.Call
 	return apis.NewCall[ListFinancialEventsResponse](http.MethodGet, pathPrefix+"/financialEvents").
 		WithQueryParams(filter.GetQuery()).
 		WithRateLimit(0.5, time.Second).
-		Execute(a.httpClient)
+		WithBurst(30).
+		Execute(ctx, a.httpClient)
 }
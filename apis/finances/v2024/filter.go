@@ -0,0 +1,41 @@
+package finances
+
+import (
+	"net/url"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+// ListTransactionsFilter is used to filter transactions in the ListTransactions call.
+type ListTransactionsFilter struct {
+	// PostedAfter is required and must be more than two minutes before the request.
+	PostedAfter apis.JsonTimeISO8601
+	// PostedBefore defaults to two minutes before the request. If it is more than 180 days after
+	// PostedAfter, the response is empty.
+	PostedBefore  *apis.JsonTimeISO8601
+	MarketplaceID constants.MarketplaceID
+	// TransactionStatus is one of DEFERRED, RELEASED or DEFERRED_RELEASED.
+	TransactionStatus string
+	NextToken         string
+}
+
+// GetQuery returns the query parameters for ListTransactionsFilter.
+func (f *ListTransactionsFilter) GetQuery() url.Values {
+	q := url.Values{}
+	q.Add("postedAfter", f.PostedAfter.String())
+	if f.PostedBefore != nil {
+		q.Add("postedBefore", f.PostedBefore.String())
+	}
+	if f.MarketplaceID != "" {
+		q.Add("marketplaceId", string(f.MarketplaceID))
+	}
+	if f.TransactionStatus != "" {
+		q.Add("transactionStatus", f.TransactionStatus)
+	}
+	if f.NextToken != "" {
+		q.Add("nextToken", f.NextToken)
+	}
+
+	return q
+}
@@ -0,0 +1,65 @@
+// Package finances implements version 2024-06-19 of the Finances API, which lists transactions
+// instead of financial events. Version v0 stays in package apis/finances, so both can be used side
+// by side, e.g. with this package imported as financesv2024.
+package finances
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/finances/2024-06-19"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.FinancesV2024Client for tests.
+type Client interface {
+	ListTransactions(ctx context.Context, filter *ListTransactionsFilter) (*apis.CallResponse[ListTransactionsResponse], error)
+	TransactionsPaginator(filter *ListTransactionsFilter) *apis.Paginator[Transaction]
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// ListTransactions returns the transactions posted in the time range of the filter. Transactions
+// might not include orders of the last 48 hours.
+func (a *API) ListTransactions(ctx context.Context, filter *ListTransactionsFilter) (*apis.CallResponse[ListTransactionsResponse], error) {
+	if filter.PostedAfter.IsZero() {
+		return nil, errors.New("postedAfter is required")
+	}
+
+	return apis.NewCall[ListTransactionsResponse](http.MethodGet, pathPrefix+"/transactions").
+		WithQueryParams(filter.GetQuery()).
+		WithRateLimit(0.5, time.Second).
+		WithBurst(10).
+		WithParseErrorListOnError().
+		Execute(ctx, a.httpClient)
+}
+
+// TransactionsPaginator returns a Paginator over the transactions that match the filter. The
+// following pages are fetched with the filter and their NextToken.
+func (a *API) TransactionsPaginator(filter *ListTransactionsFilter) *apis.Paginator[Transaction] {
+	return apis.NewPaginator(func(ctx context.Context, nextToken string) ([]Transaction, string, error) {
+		pageFilter := *filter
+		if nextToken != "" {
+			pageFilter.NextToken = nextToken
+		}
+		resp, err := a.ListTransactions(ctx, &pageFilter)
+		if err != nil || resp.ResponseBody == nil || resp.ResponseBody.Payload == nil {
+			return nil, "", err
+		}
+		return resp.ResponseBody.Payload.Transactions, resp.ResponseBody.Payload.NextToken, nil
+	})
+}
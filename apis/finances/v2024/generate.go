@@ -0,0 +1,3 @@
+package finances
+
+//go:generate go run ../../../internal/modelgen -config modelgen.json -out model.go
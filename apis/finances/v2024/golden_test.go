@@ -0,0 +1,13 @@
+package finances
+
+import (
+	"testing"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/internal/golden"
+)
+
+func TestGoldenFixtures(t *testing.T) {
+	t.Run("listTransactions", func(t *testing.T) {
+		golden.RoundTrip[ListTransactionsResponse](t, "testdata/listTransactions.json")
+	})
+}
@@ -0,0 +1,161 @@
+// Code generated by internal/modelgen from finances.json. DO NOT EDIT.
+
+package finances
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+// ListTransactionsResponse The response schema for the `listTransactions` operation.
+type ListTransactionsResponse struct {
+	// The payload for the `listTransactions` operation.
+	Payload *TransactionsPayload `json:"payload,omitempty"`
+	// A list of error responses returned when a request is unsuccessful.
+	Errors []apis.Error `json:"errors,omitempty"`
+}
+
+// TransactionsPayload The payload for the `listTransactions` operation.
+type TransactionsPayload struct {
+	// The response includes `nextToken` when the number of results exceeds the specified `pageSize` value. To get the next page of results, call the operation with this token and include the same arguments as the call that produced the token. To get a complete list, call this operation until `nextToken` is null. Note that this operation can return empty pages.
+	NextToken    string        `json:"nextToken,omitempty"`
+	Transactions []Transaction `json:"transactions,omitempty"`
+}
+
+// Transaction All the information related to a transaction.
+type Transaction struct {
+	SellingPartnerMetadata *SellingPartnerMetadata `json:"sellingPartnerMetadata,omitempty"`
+	RelatedIdentifiers     []RelatedIdentifier     `json:"relatedIdentifiers,omitempty"`
+	// The type of transaction.
+	//
+	// **Possible value:** `Shipment`
+	TransactionType string `json:"transactionType,omitempty"`
+	// The unique identifier of the transaction.
+	TransactionID string `json:"transactionId,omitempty"`
+	// The status of the transaction.
+	//
+	// **Possible values:**
+	//
+	// * `DEFERRED`
+	// * `RELEASED`
+	TransactionStatus string `json:"transactionStatus,omitempty"`
+	// Describes the reasons for the transaction.
+	//
+	// **Example:** 'Order Payment', 'Refund Order'
+	Description string `json:"description,omitempty"`
+	// The date and time when the transaction was posted.
+	PostedDate *time.Time `json:"postedDate,omitempty"`
+	// The total amount of money in the transaction.
+	TotalAmount        *Currency           `json:"totalAmount,omitempty"`
+	MarketplaceDetails *MarketplaceDetails `json:"marketplaceDetails,omitempty"`
+	Items              []Item              `json:"items,omitempty"`
+	Contexts           []Context           `json:"contexts,omitempty"`
+	Breakdowns         []Breakdown         `json:"breakdowns,omitempty"`
+}
+
+// SellingPartnerMetadata Metadata that describes the seller.
+type SellingPartnerMetadata struct {
+	// A unique seller identifier.
+	SellingPartnerID string `json:"sellingPartnerId,omitempty"`
+	// The type of account in the transaction.
+	AccountType string `json:"accountType,omitempty"`
+	// The identifier of the marketplace.
+	MarketplaceID constants.MarketplaceID `json:"marketplaceId,omitempty"`
+}
+
+// RelatedIdentifier Related business identifier of the transaction.
+type RelatedIdentifier struct {
+	// An enumerated set of related business identifier names.
+	RelatedIdentifierName string `json:"relatedIdentifierName,omitempty"`
+	// Corresponding value of `RelatedIdentifierName`.
+	RelatedIdentifierValue string `json:"relatedIdentifierValue,omitempty"`
+}
+
+// MarketplaceDetails Information about the marketplace where the transaction occurred.
+type MarketplaceDetails struct {
+	// The identifier of the marketplace.
+	MarketplaceID constants.MarketplaceID `json:"marketplaceId,omitempty"`
+	// The name of the marketplace.
+	MarketplaceName string `json:"marketplaceName,omitempty"`
+}
+
+// Item Additional information about the items in a transaction.
+type Item struct {
+	// A description of the items in a transaction.
+	Description        string                  `json:"description,omitempty"`
+	RelatedIdentifiers []ItemRelatedIdentifier `json:"relatedIdentifiers,omitempty"`
+	// The total amount of money for the items in a transaction.
+	TotalAmount *Currency   `json:"totalAmount,omitempty"`
+	Breakdowns  []Breakdown `json:"breakdowns,omitempty"`
+	Contexts    []Context   `json:"contexts,omitempty"`
+}
+
+// ItemRelatedIdentifier Related business identifiers of the item.
+type ItemRelatedIdentifier struct {
+	// Enumerated set of related item identifier names for the item.
+	ItemRelatedIdentifierName string `json:"itemRelatedIdentifierName,omitempty"`
+	// Corresponding value to `ItemRelatedIdentifierName`.
+	ItemRelatedIdentifierValue string `json:"itemRelatedIdentifierValue,omitempty"`
+}
+
+// Breakdown Details about the movement of money in the financial transaction. Breakdowns are further categorized into breakdown types, breakdown amounts, and further breakdowns.
+type Breakdown struct {
+	// The type of charge.
+	BreakdownType string `json:"breakdownType,omitempty"`
+	// The amount of the charge.
+	BreakdownAmount *Currency   `json:"breakdownAmount,omitempty"`
+	Breakdowns      []Breakdown `json:"breakdowns,omitempty"`
+}
+
+// Context Additional information about the transaction. Amazon publishes one schema per context type, which are combined here since their properties do not overlap.
+type Context struct {
+	// The type of the context, e.g. `ProductContext`, `AmazonPayContext`, `PaymentsContext`, `DeferredContext` or `BusinessContext`.
+	ContextType string `json:"contextType,omitempty"`
+	// The store name related to the transaction.
+	StoreName string `json:"storeName,omitempty"`
+	// The transaction's order type.
+	OrderType string `json:"orderType,omitempty"`
+	// Channel details of related transaction.
+	Channel string `json:"channel,omitempty"`
+	// The Amazon Standard Identification Number (ASIN) of the item.
+	ASIN string `json:"asin,omitempty"`
+	// The Stock Keeping Unit (SKU) of the item.
+	SKU string `json:"sku,omitempty"`
+	// The quantity of the item shipped.
+	QuantityShipped *int `json:"quantityShipped,omitempty"`
+	// The fulfillment network of the item.
+	FulfillmentNetwork string `json:"fulfillmentNetwork,omitempty"`
+	// The type of payment.
+	PaymentType string `json:"paymentType,omitempty"`
+	// The method of payment.
+	PaymentMethod string `json:"paymentMethod,omitempty"`
+	// The reference number of the payment.
+	PaymentReference string `json:"paymentReference,omitempty"`
+	// The date of the payment.
+	PaymentDate *time.Time `json:"paymentDate,omitempty"`
+	// The deferral policy applied to the transaction.
+	//
+	// **Examples:** `B2B` (invoiced orders), `DD7` (delivery date policy)
+	DeferralReason string `json:"deferralReason,omitempty"`
+	// The release date of the transaction.
+	MaturityDate *time.Time `json:"maturityDate,omitempty"`
+	// The status of the transaction, e.g. `HOLD` or `RELEASE`.
+	DeferralStatus string `json:"deferralStatus,omitempty"`
+	// The store ID of the business.
+	StoreID string `json:"storeId,omitempty"`
+	// The start time of the transaction.
+	StartTime *time.Time `json:"startTime,omitempty"`
+	// The end time of the transaction.
+	EndTime *time.Time `json:"endTime,omitempty"`
+}
+
+// Currency A currency type and amount.
+type Currency struct {
+	// The three-digit currency code in ISO 4217 format.
+	CurrencyCode string `json:"currencyCode,omitempty"`
+	// The monetary value.
+	CurrencyAmount json.Number `json:"currencyAmount,omitempty"`
+}
@@ -0,0 +1,26 @@
+package apis
+
+import (
+	"context"
+	"net/http"
+)
+
+type headerContextKey struct{}
+
+// ContextWithHeader returns a context which adds the header to all calls executed with it, e.g.
+// x-amzn-shipping-business-id or x-amzn-idempotency-token, which some operations require.
+// Headers of the parent context are kept; calling it again with the same key adds another value.
+func ContextWithHeader(ctx context.Context, key, value string) context.Context {
+	header := HeaderFromContext(ctx).Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Add(key, value)
+	return context.WithValue(ctx, headerContextKey{}, header)
+}
+
+// HeaderFromContext returns the headers added by ContextWithHeader, or nil.
+func HeaderFromContext(ctx context.Context) http.Header {
+	header, _ := ctx.Value(headerContextKey{}).(http.Header)
+	return header
+}
@@ -0,0 +1,19 @@
+package apis
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// NewIdempotencyToken returns a random UUID to be sent as x-amzn-idempotency-token. Generate and
+// persist it before a mutating call, if the call may be repeated after a restart of the application,
+// and pass it with ContextWithHeader.
+func NewIdempotencyToken() string {
+	var uuid [16]byte
+	if _, err := rand.Read(uuid[:]); err != nil {
+		panic(fmt.Sprintf("reading random bytes for idempotency token failed: %v", err))
+	}
+	uuid[6] = uuid[6]&0x0f | 0x40
+	uuid[8] = uuid[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:])
+}
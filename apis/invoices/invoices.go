@@ -0,0 +1,144 @@
+package invoices
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/tax/invoices/2024-06-19"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.InvoicesClient for tests.
+type Client interface {
+	GetInvoicesAttributes(ctx context.Context, marketplaceID constants.MarketplaceID) (*apis.CallResponse[GetInvoicesAttributesResponse], error)
+	GetInvoicesDocument(ctx context.Context, invoicesDocumentID string) (*apis.CallResponse[GetInvoicesDocumentResponse], error)
+	OpenInvoicesDocument(ctx context.Context, invoicesDocumentID string) (io.ReadCloser, error)
+	CreateInvoicesExport(ctx context.Context, request *ExportInvoicesRequest) (*apis.CallResponse[ExportInvoicesResponse], error)
+	GetInvoicesExports(ctx context.Context, filter *GetInvoicesExportsFilter) (*apis.CallResponse[GetInvoicesExportsResponse], error)
+	GetInvoicesExport(ctx context.Context, exportID string) (*apis.CallResponse[GetInvoicesExportResponse], error)
+	GetInvoices(ctx context.Context, filter *GetInvoicesFilter) (*apis.CallResponse[GetInvoicesResponse], error)
+	GetInvoice(ctx context.Context, invoiceID string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[GetInvoiceResponse], error)
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// GetInvoicesAttributes returns the marketplace-specific invoice attribute values that can be used for filtering.
+func (a *API) GetInvoicesAttributes(ctx context.Context, marketplaceID constants.MarketplaceID) (*apis.CallResponse[GetInvoicesAttributesResponse], error) {
+	params := url.Values{}
+	params.Add("marketplaceId", string(marketplaceID))
+
+	return apis.NewCall[GetInvoicesAttributesResponse](http.MethodGet, pathPrefix+"/attributes").
+		WithQueryParams(params).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetInvoicesDocument returns the information required to download an invoices export document.
+func (a *API) GetInvoicesDocument(ctx context.Context, invoicesDocumentID string) (*apis.CallResponse[GetInvoicesDocumentResponse], error) {
+	return apis.NewCall[GetInvoicesDocumentResponse](http.MethodGet, pathPrefix+"/documents/{invoicesDocumentID}").
+		WithPathParam("invoicesDocumentID", invoicesDocumentID).
+		WithParseErrorListOnError().
+		WithRateLimit(0.0167, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// OpenInvoicesDocument downloads the invoices export document (a zip archive) with the given invoicesDocumentID.
+// The caller must close the returned reader.
+func (a *API) OpenInvoicesDocument(ctx context.Context, invoicesDocumentID string) (io.ReadCloser, error) {
+	resp, err := a.GetInvoicesDocument(ctx, invoicesDocumentID)
+	if err != nil {
+		return nil, err
+	}
+	if resp.ResponseBody.InvoicesDocument == nil || resp.ResponseBody.InvoicesDocument.InvoicesDocumentURL == nil {
+		return nil, errors.New("response did not contain an invoices document URL")
+	}
+	return apis.OpenDocument(ctx, a.httpClient, *resp.ResponseBody.InvoicesDocument.InvoicesDocumentURL)
+}
+
+// CreateInvoicesExport creates an invoice export request. The export is generated asynchronously,
+// poll GetInvoicesExport until the status is DONE.
+func (a *API) CreateInvoicesExport(ctx context.Context, request *ExportInvoicesRequest) (*apis.CallResponse[ExportInvoicesResponse], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[ExportInvoicesResponse](http.MethodPost, pathPrefix+"/exports").
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(0.167, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetInvoicesExports returns invoice exports that match the specified filter.
+func (a *API) GetInvoicesExports(ctx context.Context, filter *GetInvoicesExportsFilter) (*apis.CallResponse[GetInvoicesExportsResponse], error) {
+	if filter.MarketplaceID == "" {
+		return nil, errors.New("marketplaceID is required")
+	}
+	if filter.PageSize != 0 && (filter.PageSize < 1 || filter.PageSize > 100) {
+		return nil, errors.New("pageSize must be between 1 and 100")
+	}
+
+	return apis.NewCall[GetInvoicesExportsResponse](http.MethodGet, pathPrefix+"/exports").
+		WithQueryParams(filter.GetQuery()).
+		WithParseErrorListOnError().
+		WithRateLimit(0.1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetInvoicesExport returns invoice export details, including the IDs of the export documents once it is done.
+func (a *API) GetInvoicesExport(ctx context.Context, exportID string) (*apis.CallResponse[GetInvoicesExportResponse], error) {
+	return apis.NewCall[GetInvoicesExportResponse](http.MethodGet, pathPrefix+"/exports/{exportID}").
+		WithPathParam("exportID", exportID).
+		WithParseErrorListOnError().
+		WithRateLimit(2, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetInvoices returns invoice details for the invoices that match the filter.
+func (a *API) GetInvoices(ctx context.Context, filter *GetInvoicesFilter) (*apis.CallResponse[GetInvoicesResponse], error) {
+	if filter.MarketplaceID == "" {
+		return nil, errors.New("marketplaceID is required")
+	}
+	if filter.PageSize != 0 && (filter.PageSize < 1 || filter.PageSize > 200) {
+		return nil, errors.New("pageSize must be between 1 and 200")
+	}
+
+	return apis.NewCall[GetInvoicesResponse](http.MethodGet, pathPrefix+"/invoices").
+		WithQueryParams(filter.GetQuery()).
+		WithParseErrorListOnError().
+		WithRateLimit(0.1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetInvoice returns invoice data for the specified invoiceID.
+func (a *API) GetInvoice(ctx context.Context, invoiceID string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[GetInvoiceResponse], error) {
+	params := url.Values{}
+	params.Add("marketplaceId", string(marketplaceID))
+
+	return apis.NewCall[GetInvoiceResponse](http.MethodGet, pathPrefix+"/invoices/{invoiceID}").
+		WithPathParam("invoiceID", invoiceID).
+		WithQueryParams(params).
+		WithParseErrorListOnError().
+		WithRateLimit(2, time.Second).
+		Execute(ctx, a.httpClient)
+}
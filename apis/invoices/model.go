@@ -0,0 +1,254 @@
+package invoices
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/fond-of-vertigo/amazon-sp-api/internal/utils"
+)
+
+// ExportStatus The current status of an invoices export.
+type ExportStatus string
+
+const (
+	ExportStatusRequested  ExportStatus = "REQUESTED"
+	ExportStatusProcessing ExportStatus = "PROCESSING"
+	ExportStatusDone       ExportStatus = "DONE"
+	ExportStatusError      ExportStatus = "ERROR"
+)
+
+// FileFormat The file format of an invoices export.
+type FileFormat string
+
+const (
+	FileFormatXML FileFormat = "XML"
+)
+
+// SortOrder The order in which invoices are sorted.
+type SortOrder string
+
+const (
+	SortOrderDescending SortOrder = "DESC"
+	SortOrderAscending  SortOrder = "ASC"
+)
+
+// GetInvoicesFilter is used to filter the GetInvoices call. MarketplaceID is required.
+type GetInvoicesFilter struct {
+	MarketplaceID constants.MarketplaceID
+	// TransactionIdentifierName is the name of the transaction identifier filter, see GetInvoicesAttributes.
+	TransactionIdentifierName string
+	// TransactionIdentifierID is the ID of the transaction identifier filter.
+	TransactionIdentifierID string
+	// PageSize is the maximum number of invoices to return in a single call (1 to 200).
+	PageSize int
+	// DateStart is the start date for invoices, defaults to 24 hours before DateEnd.
+	DateStart apis.JsonTimeISO8601
+	// DateEnd is the end date for invoices, defaults to now.
+	DateEnd apis.JsonTimeISO8601
+	// TransactionType is the marketplace-specific classification of the transaction type, see GetInvoicesAttributes.
+	TransactionType string
+	// Series is the invoice series to filter for.
+	Series string
+	// InvoiceType is the marketplace-specific classification of the invoice type, see GetInvoicesAttributes.
+	InvoiceType string
+	// Statuses is a list of marketplace-specific invoice statuses, see GetInvoicesAttributes.
+	Statuses []string
+	// ExternalInvoiceID is the government-issued invoice ID.
+	ExternalInvoiceID string
+	// SortBy is the attribute by which you want to sort the invoices, e.g. START_DATE_TIME.
+	SortBy string
+	// SortOrder sorts the invoices in ascending or descending order.
+	SortOrder SortOrder
+	// NextToken is returned when the number of results exceeds pageSize.
+	NextToken string
+}
+
+func (f *GetInvoicesFilter) GetQuery() url.Values {
+	q := url.Values{}
+	utils.AddToQueryIfSet(q, "marketplaceId", string(f.MarketplaceID))
+	utils.AddToQueryIfSet(q, "transactionIdentifierName", f.TransactionIdentifierName)
+	utils.AddToQueryIfSet(q, "transactionIdentifierId", f.TransactionIdentifierID)
+	if f.PageSize != 0 {
+		q.Add("pageSize", strconv.Itoa(f.PageSize))
+	}
+	utils.AddToQueryIfSet(q, "dateStart", f.DateStart.String())
+	utils.AddToQueryIfSet(q, "dateEnd", f.DateEnd.String())
+	utils.AddToQueryIfSet(q, "transactionType", f.TransactionType)
+	utils.AddToQueryIfSet(q, "series", f.Series)
+	utils.AddToQueryIfSet(q, "invoiceType", f.InvoiceType)
+	utils.AddToQueryIfSet(q, "statuses", utils.MapToCommaString(f.Statuses))
+	utils.AddToQueryIfSet(q, "externalInvoiceId", f.ExternalInvoiceID)
+	utils.AddToQueryIfSet(q, "sortBy", f.SortBy)
+	utils.AddToQueryIfSet(q, "sortOrder", string(f.SortOrder))
+	utils.AddToQueryIfSet(q, "nextToken", f.NextToken)
+	return q
+}
+
+// GetInvoicesExportsFilter is used to filter the GetInvoicesExports call. MarketplaceID is required.
+type GetInvoicesExportsFilter struct {
+	MarketplaceID constants.MarketplaceID
+	// DateStart is the earliest export creation date, defaults to 30 days before DateEnd.
+	DateStart apis.JsonTimeISO8601
+	// DateEnd is the latest export creation date, defaults to now.
+	DateEnd apis.JsonTimeISO8601
+	// Status of the exports to return.
+	Status ExportStatus
+	// PageSize is the maximum number of exports to return in a single call (1 to 100).
+	PageSize int
+	// NextToken is returned when the number of results exceeds pageSize.
+	NextToken string
+}
+
+func (f *GetInvoicesExportsFilter) GetQuery() url.Values {
+	q := url.Values{}
+	utils.AddToQueryIfSet(q, "marketplaceId", string(f.MarketplaceID))
+	utils.AddToQueryIfSet(q, "dateStart", f.DateStart.String())
+	utils.AddToQueryIfSet(q, "dateEnd", f.DateEnd.String())
+	utils.AddToQueryIfSet(q, "status", string(f.Status))
+	if f.PageSize != 0 {
+		q.Add("pageSize", strconv.Itoa(f.PageSize))
+	}
+	utils.AddToQueryIfSet(q, "nextToken", f.NextToken)
+	return q
+}
+
+// AttributeOption The definition of a possible value of an invoice attribute.
+type AttributeOption struct {
+	// The description of the attribute value.
+	Description *string `json:"description,omitempty"`
+	// The possible values for the attribute option.
+	Value *string `json:"value,omitempty"`
+}
+
+// InvoicesAttributes An object that contains the invoice attributes definition.
+type InvoicesAttributes struct {
+	// A list of all the options that are available for the invoice status attribute.
+	InvoiceStatusOptions []AttributeOption `json:"invoiceStatusOptions,omitempty"`
+	// A list of all the options that are available for the invoice type attribute.
+	InvoiceTypeOptions []AttributeOption `json:"invoiceTypeOptions,omitempty"`
+	// A list of all the options that are available for the transaction identifier name attribute.
+	TransactionIdentifierNameOptions []AttributeOption `json:"transactionIdentifierNameOptions,omitempty"`
+	// A list of all the options that are available for the transaction type attribute.
+	TransactionTypeOptions []AttributeOption `json:"transactionTypeOptions,omitempty"`
+}
+
+// GetInvoicesAttributesResponse The response of the GetInvoicesAttributes operation.
+type GetInvoicesAttributesResponse struct {
+	InvoicesAttributes *InvoicesAttributes `json:"invoicesAttributes,omitempty"`
+}
+
+// InvoicesDocument An object that contains the invoicesDocumentId and a S3 pre-signed URL to download the document.
+type InvoicesDocument struct {
+	// The identifier of the export document.
+	InvoicesDocumentID *string `json:"invoicesDocumentId,omitempty"`
+	// A pre-signed URL that you can use to download the invoices document in zip format. This URL expires after 30 seconds.
+	InvoicesDocumentURL *string `json:"invoicesDocumentUrl,omitempty"`
+}
+
+// GetInvoicesDocumentResponse The response of the GetInvoicesDocument operation.
+type GetInvoicesDocumentResponse struct {
+	InvoicesDocument *InvoicesDocument `json:"invoicesDocument,omitempty"`
+}
+
+// TransactionIdentifier The transaction identifier.
+type TransactionIdentifier struct {
+	// The transaction identifier name. Use GetInvoicesAttributes to retrieve the possible values.
+	Name *string `json:"name,omitempty"`
+	// The transaction identifier.
+	ID *string `json:"id,omitempty"`
+}
+
+// ExportInvoicesRequest The request of the CreateInvoicesExport operation.
+type ExportInvoicesRequest struct {
+	// The latest invoice creation date for invoices that you want to include in the response.
+	DateEnd *apis.JsonTimeISO8601 `json:"dateEnd,omitempty"`
+	// The earliest invoice creation date for invoices that you want to include in the response.
+	DateStart *apis.JsonTimeISO8601 `json:"dateStart,omitempty"`
+	// The external ID of the invoices you want included in the response.
+	ExternalInvoiceID *string     `json:"externalInvoiceId,omitempty"`
+	FileFormat        *FileFormat `json:"fileFormat,omitempty"`
+	// The marketplace-specific classification of the invoice type.
+	InvoiceType *string `json:"invoiceType,omitempty"`
+	// The ID of the marketplace from which you want the invoices.
+	MarketplaceID constants.MarketplaceID `json:"marketplaceId"`
+	// The series number of the invoices you want included in the response.
+	Series *string `json:"series,omitempty"`
+	// A list of statuses that you can use to filter invoices.
+	Statuses              []string               `json:"statuses,omitempty"`
+	TransactionIdentifier *TransactionIdentifier `json:"transactionIdentifier,omitempty"`
+	// The marketplace-specific classification of the transaction type for which the invoice was created.
+	TransactionType *string `json:"transactionType,omitempty"`
+}
+
+// ExportInvoicesResponse The response of the CreateInvoicesExport operation.
+type ExportInvoicesResponse struct {
+	// The export identifier.
+	ExportID *string `json:"exportId,omitempty"`
+}
+
+// Export Detailed information about the export.
+type Export struct {
+	// When the export generation fails, this attribute contains a description of the error.
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+	// The export identifier.
+	ExportID *string `json:"exportId,omitempty"`
+	// The date and time when the export generation finished.
+	GenerateExportFinishedAt *time.Time `json:"generateExportFinishedAt,omitempty"`
+	// The date and time when the export generation started.
+	GenerateExportStartedAt *time.Time `json:"generateExportStartedAt,omitempty"`
+	// The identifier for the export documents. To get the information required to retrieve the export
+	// document's contents, pass each ID in the GetInvoicesDocument operation.
+	InvoicesDocumentIDs []string      `json:"invoicesDocumentIds,omitempty"`
+	Status              *ExportStatus `json:"status,omitempty"`
+}
+
+// GetInvoicesExportsResponse The response of the GetInvoicesExports operation.
+type GetInvoicesExportsResponse struct {
+	Exports []Export `json:"exports,omitempty"`
+	// This token is returned when the number of results exceeds the specified pageSize value.
+	NextToken *string `json:"nextToken,omitempty"`
+}
+
+// GetInvoicesExportResponse The response of the GetInvoicesExport operation.
+type GetInvoicesExportResponse struct {
+	Export *Export `json:"export,omitempty"`
+}
+
+// Invoice Provides invoicing data.
+type Invoice struct {
+	// The date and time the invoice is issued.
+	Date *time.Time `json:"date,omitempty"`
+	// If the invoice is in an error state, this attribute displays the error code.
+	ErrorCode *string `json:"errorCode,omitempty"`
+	// The invoice identifier that is used by an external party. This is typically the government agency that authorized the invoice.
+	ExternalInvoiceID *string `json:"externalInvoiceId,omitempty"`
+	// The response message from the government authority when there is an error during invoice issuance.
+	GovResponse *string `json:"govResponse,omitempty"`
+	// The invoice identifier.
+	ID *string `json:"id,omitempty"`
+	// The classification of the invoice type.
+	InvoiceType *string `json:"invoiceType,omitempty"`
+	// Use this identifier in conjunction with externalInvoiceId to identify invoices from the same seller.
+	Series *string `json:"series,omitempty"`
+	// The invoice status classification.
+	Status *string `json:"status,omitempty"`
+	// List with identifiers for the transactions associated to the invoice.
+	TransactionIDs []TransactionIdentifier `json:"transactionIds,omitempty"`
+	// Classification of the transaction that originated this invoice.
+	TransactionType *string `json:"transactionType,omitempty"`
+}
+
+// GetInvoicesResponse The response of the GetInvoices operation.
+type GetInvoicesResponse struct {
+	Invoices []Invoice `json:"invoices,omitempty"`
+	// This token is returned when the number of results exceeds the specified pageSize value.
+	NextToken *string `json:"nextToken,omitempty"`
+}
+
+// GetInvoiceResponse The response of the GetInvoice operation.
+type GetInvoiceResponse struct {
+	Invoice *Invoice `json:"invoice,omitempty"`
+}
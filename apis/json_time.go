@@ -9,6 +9,16 @@ type JsonTimeISO8601 struct {
 	time.Time
 }
 
+// NewTime returns t as JsonTimeISO8601, e.g. for the optional time fields of filters.
+func NewTime(t time.Time) *JsonTimeISO8601 {
+	return &JsonTimeISO8601{Time: t}
+}
+
+// Now returns the current time as JsonTimeISO8601.
+func Now() *JsonTimeISO8601 {
+	return NewTime(time.Now())
+}
+
 func (t JsonTimeISO8601) MarshalJSON() ([]byte, error) {
 	value := "\"" + t.UTC().Format(time.RFC3339) + "\""
 	return []byte(value), nil
@@ -20,3 +30,16 @@ func (t JsonTimeISO8601) String() string {
 	}
 	return t.UTC().Format(time.RFC3339)
 }
+
+// IsZero reports whether t is nil or the zero time.
+func (t *JsonTimeISO8601) IsZero() bool {
+	return t == nil || t.Time.IsZero()
+}
+
+// AsTime returns the time of t, or the zero time if t is nil.
+func (t *JsonTimeISO8601) AsTime() time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.Time
+}
@@ -65,3 +65,24 @@ func TestJsonTimeISO8601_MarshalJSON(t1 *testing.T) {
 		})
 	}
 }
+
+func TestJsonTimeISO8601_Helpers(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	jsonTime := NewTime(at)
+	if !jsonTime.AsTime().Equal(at) || jsonTime.IsZero() {
+		t.Errorf("NewTime(%v) = %v", at, jsonTime)
+	}
+
+	var unset *JsonTimeISO8601
+	if !unset.IsZero() || !unset.AsTime().IsZero() {
+		t.Error("a nil JsonTimeISO8601 should be zero")
+	}
+	if !(&JsonTimeISO8601{}).IsZero() {
+		t.Error("the zero JsonTimeISO8601 should be zero")
+	}
+
+	before := time.Now()
+	if now := Now(); now.AsTime().Before(before) || now.AsTime().After(time.Now()) {
+		t.Errorf("Now() = %v is not the current time", now)
+	}
+}
@@ -0,0 +1,180 @@
+package notifications
+
+import "github.com/fond-of-vertigo/amazon-sp-api/apis"
+
+// NotificationType The type of notification to subscribe to.
+type NotificationType string
+
+const (
+	AnyOfferChanged                    NotificationType = "ANY_OFFER_CHANGED"
+	B2BAnyOfferChanged                 NotificationType = "B2B_ANY_OFFER_CHANGED"
+	BrandedItemContentChange           NotificationType = "BRANDED_ITEM_CONTENT_CHANGE"
+	FBAInventoryAvailabilityChanges    NotificationType = "FBA_INVENTORY_AVAILABILITY_CHANGES"
+	FBAOutboundShipmentStatus          NotificationType = "FBA_OUTBOUND_SHIPMENT_STATUS"
+	FeePromotion                       NotificationType = "FEE_PROMOTION"
+	FeedProcessingFinished             NotificationType = "FEED_PROCESSING_FINISHED"
+	FulfillmentOrderStatus             NotificationType = "FULFILLMENT_ORDER_STATUS"
+	ItemProductTypeChange              NotificationType = "ITEM_PRODUCT_TYPE_CHANGE"
+	ListingsItemIssuesChange           NotificationType = "LISTINGS_ITEM_ISSUES_CHANGE"
+	ListingsItemMFNQuantityChange      NotificationType = "LISTINGS_ITEM_MFN_QUANTITY_CHANGE"
+	ListingsItemStatusChange           NotificationType = "LISTINGS_ITEM_STATUS_CHANGE"
+	OrderChange                        NotificationType = "ORDER_CHANGE"
+	PricingHealth                      NotificationType = "PRICING_HEALTH"
+	ProductTypeDefinitionsChange       NotificationType = "PRODUCT_TYPE_DEFINITIONS_CHANGE"
+	ReportProcessingFinished           NotificationType = "REPORT_PROCESSING_FINISHED"
+	DataKioskQueryProcessingFinished   NotificationType = "DATA_KIOSK_QUERY_PROCESSING_FINISHED"
+	AccountStatusChanged               NotificationType = "ACCOUNT_STATUS_CHANGED"
+	ExternalFulfillmentShipmentStatus  NotificationType = "EXTERNAL_FULFILLMENT_SHIPMENT_STATUS_CHANGE"
+	ApplicationOAuthClientNewSecret    NotificationType = "APPLICATION_OAUTH_CLIENT_NEW_SECRET"
+	ApplicationOAuthClientSecretExpiry NotificationType = "APPLICATION_OAUTH_CLIENT_SECRET_EXPIRY"
+)
+
+// Subscription Information about the subscription.
+type Subscription struct {
+	// The subscription identifier generated when the subscription is created.
+	SubscriptionID string `json:"subscriptionId"`
+	// The version of the payload object to be used in the notification.
+	PayloadVersion string `json:"payloadVersion"`
+	// The identifier for the destination where notifications will be delivered.
+	DestinationID string `json:"destinationId"`
+	// Additional information passed to the subscription to control the processing of notifications.
+	ProcessingDirective *ProcessingDirective `json:"processingDirective,omitempty"`
+}
+
+// ProcessingDirective Additional information passed to the subscription to control the processing of notifications.
+type ProcessingDirective struct {
+	// A notificationType specific filter.
+	EventFilter *EventFilter `json:"eventFilter,omitempty"`
+}
+
+// EventFilter A notificationType specific filter, e.g. to aggregate or filter ANY_OFFER_CHANGED or ORDER_CHANGE notifications.
+type EventFilter struct {
+	// The type of event filter, e.g. ANY_OFFER_CHANGED or ORDER_CHANGE.
+	EventFilterType string `json:"eventFilterType"`
+	// A list of marketplace identifiers to subscribe to.
+	MarketplaceIDs []string `json:"marketplaceIds,omitempty"`
+	// Settings to aggregate notifications over a time period.
+	AggregationSettings *AggregationSettings `json:"aggregationSettings,omitempty"`
+	// Order change types to subscribe to, e.g. OrderStatusChange or BuyerRequestedChange.
+	OrderChangeTypes []string `json:"orderChangeTypes,omitempty"`
+}
+
+// AggregationSettings A container that holds all of the necessary properties to configure the aggregation of notifications.
+type AggregationSettings struct {
+	// The supported time periods for aggregation, FiveMinutes or TenMinutes.
+	AggregationTimePeriod string `json:"aggregationTimePeriod"`
+}
+
+// CreateSubscriptionRequest The request schema for the createSubscription operation.
+type CreateSubscriptionRequest struct {
+	// The version of the payload object to be used in the notification.
+	PayloadVersion string `json:"payloadVersion"`
+	// The identifier for the destination where notifications will be delivered.
+	DestinationID string `json:"destinationId"`
+	// Additional information passed to the subscription to control the processing of notifications.
+	ProcessingDirective *ProcessingDirective `json:"processingDirective,omitempty"`
+}
+
+// GetSubscriptionResponse The response schema for the getSubscription operation.
+type GetSubscriptionResponse struct {
+	Payload *Subscription `json:"payload,omitempty"`
+	Errors  []apis.Error  `json:"errors,omitempty"`
+}
+
+// CreateSubscriptionResponse The response schema for the createSubscription operation.
+type CreateSubscriptionResponse struct {
+	Payload *Subscription `json:"payload,omitempty"`
+	Errors  []apis.Error  `json:"errors,omitempty"`
+}
+
+// GetSubscriptionByIDResponse The response schema for the getSubscriptionById operation.
+type GetSubscriptionByIDResponse struct {
+	Payload *Subscription `json:"payload,omitempty"`
+	Errors  []apis.Error  `json:"errors,omitempty"`
+}
+
+// DeleteSubscriptionByIDResponse The response schema for the deleteSubscriptionById operation.
+type DeleteSubscriptionByIDResponse struct {
+	Errors []apis.Error `json:"errors,omitempty"`
+}
+
+// Destination Information about the destination created when you call the createDestination operation.
+type Destination struct {
+	// The developer-defined name for this destination.
+	Name string `json:"name"`
+	// The destination identifier generated when you created the destination.
+	DestinationID string `json:"destinationId"`
+	// The destination resource types.
+	Resource DestinationResource `json:"resource"`
+}
+
+// DestinationResource The destination resource types.
+type DestinationResource struct {
+	// The information required to create an Amazon Simple Queue Service (SQS) queue destination.
+	SQS *SQSResource `json:"sqs,omitempty"`
+	// Represents an Amazon EventBridge destination.
+	EventBridge *EventBridgeResource `json:"eventBridge,omitempty"`
+}
+
+// SQSResource The information required to create an Amazon Simple Queue Service (SQS) queue destination.
+type SQSResource struct {
+	// The Amazon Resource Name (ARN) associated with the SQS queue.
+	ARN string `json:"arn"`
+}
+
+// EventBridgeResource Represents an Amazon EventBridge destination.
+type EventBridgeResource struct {
+	// The name of the partner event source associated with the destination.
+	Name string `json:"name"`
+	// The AWS region in which you receive the notifications.
+	Region string `json:"region"`
+	// The identifier for the AWS account that is responsible for charges related to receiving notifications.
+	AccountID string `json:"accountId"`
+}
+
+// DestinationResourceSpecification The information required to create a destination resource. Applications should use one resource type (sqs or eventBridge) per destination.
+type DestinationResourceSpecification struct {
+	// The information required to create an Amazon Simple Queue Service (SQS) queue destination.
+	SQS *SQSResource `json:"sqs,omitempty"`
+	// The information required to create an Amazon EventBridge destination.
+	EventBridge *EventBridgeResourceSpecification `json:"eventBridge,omitempty"`
+}
+
+// EventBridgeResourceSpecification The information required to create an Amazon EventBridge destination.
+type EventBridgeResourceSpecification struct {
+	// The AWS region in which you will be receiving the notifications.
+	Region string `json:"region"`
+	// The identifier for the AWS account that is responsible for charges related to receiving notifications.
+	AccountID string `json:"accountId"`
+}
+
+// CreateDestinationRequest The request schema for the createDestination operation.
+type CreateDestinationRequest struct {
+	// The information required to create a destination resource.
+	ResourceSpecification DestinationResourceSpecification `json:"resourceSpecification"`
+	// A developer-defined name to help identify this destination.
+	Name string `json:"name"`
+}
+
+// GetDestinationsResponse The response schema for the getDestinations operation.
+type GetDestinationsResponse struct {
+	Payload []Destination `json:"payload,omitempty"`
+	Errors  []apis.Error  `json:"errors,omitempty"`
+}
+
+// CreateDestinationResponse The response schema for the createDestination operation.
+type CreateDestinationResponse struct {
+	Payload *Destination `json:"payload,omitempty"`
+	Errors  []apis.Error `json:"errors,omitempty"`
+}
+
+// GetDestinationResponse The response schema for the getDestination operation.
+type GetDestinationResponse struct {
+	Payload *Destination `json:"payload,omitempty"`
+	Errors  []apis.Error `json:"errors,omitempty"`
+}
+
+// DeleteDestinationResponse The response schema for the deleteDestination operation.
+type DeleteDestinationResponse struct {
+	Errors []apis.Error `json:"errors,omitempty"`
+}
@@ -0,0 +1,133 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/notifications/v1"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.NotificationsClient for tests.
+type Client interface {
+	GetSubscription(ctx context.Context, notificationType NotificationType, payloadVersion string) (*apis.CallResponse[GetSubscriptionResponse], error)
+	CreateSubscription(ctx context.Context, notificationType NotificationType, request *CreateSubscriptionRequest) (*apis.CallResponse[CreateSubscriptionResponse], error)
+	GetSubscriptionByID(ctx context.Context, notificationType NotificationType, subscriptionID string) (*apis.CallResponse[GetSubscriptionByIDResponse], error)
+	DeleteSubscriptionByID(ctx context.Context, notificationType NotificationType, subscriptionID string) (*apis.CallResponse[DeleteSubscriptionByIDResponse], error)
+	GetDestinations(ctx context.Context) (*apis.CallResponse[GetDestinationsResponse], error)
+	CreateDestination(ctx context.Context, request *CreateDestinationRequest) (*apis.CallResponse[CreateDestinationResponse], error)
+	GetDestination(ctx context.Context, destinationID string) (*apis.CallResponse[GetDestinationResponse], error)
+	DeleteDestination(ctx context.Context, destinationID string) (*apis.CallResponse[DeleteDestinationResponse], error)
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// GetSubscription returns information about the subscription of the selling partner for the given notification type.
+// payloadVersion is optional and can be empty.
+func (a *API) GetSubscription(ctx context.Context, notificationType NotificationType, payloadVersion string) (*apis.CallResponse[GetSubscriptionResponse], error) {
+	params := url.Values{}
+	if payloadVersion != "" {
+		params.Add("payloadVersion", payloadVersion)
+	}
+	return apis.NewCall[GetSubscriptionResponse](http.MethodGet, pathPrefix+"/subscriptions/{notificationType}").
+		WithPathParam("notificationType", string(notificationType)).
+		WithQueryParams(params).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// CreateSubscription subscribes the selling partner to the given notification type.
+func (a *API) CreateSubscription(ctx context.Context, notificationType NotificationType, request *CreateSubscriptionRequest) (*apis.CallResponse[CreateSubscriptionResponse], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	return apis.NewCall[CreateSubscriptionResponse](http.MethodPost, pathPrefix+"/subscriptions/{notificationType}").
+		WithPathParam("notificationType", string(notificationType)).
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetSubscriptionByID returns the subscription with the given subscriptionID. This is a grantless operation.
+func (a *API) GetSubscriptionByID(ctx context.Context, notificationType NotificationType, subscriptionID string) (*apis.CallResponse[GetSubscriptionByIDResponse], error) {
+	return apis.NewCall[GetSubscriptionByIDResponse](http.MethodGet, pathPrefix+"/subscriptions/{notificationType}/{subscriptionID}").
+		WithPathParam("notificationType", string(notificationType)).
+		WithPathParam("subscriptionID", subscriptionID).
+		WithGrantlessScope(constants.ScopeNotifications).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// DeleteSubscriptionByID deletes the subscription with the given subscriptionID. This is a grantless operation.
+func (a *API) DeleteSubscriptionByID(ctx context.Context, notificationType NotificationType, subscriptionID string) (*apis.CallResponse[DeleteSubscriptionByIDResponse], error) {
+	return apis.NewCall[DeleteSubscriptionByIDResponse](http.MethodDelete, pathPrefix+"/subscriptions/{notificationType}/{subscriptionID}").
+		WithPathParam("notificationType", string(notificationType)).
+		WithPathParam("subscriptionID", subscriptionID).
+		WithGrantlessScope(constants.ScopeNotifications).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetDestinations returns all destinations of the application. This is a grantless operation.
+func (a *API) GetDestinations(ctx context.Context) (*apis.CallResponse[GetDestinationsResponse], error) {
+	return apis.NewCall[GetDestinationsResponse](http.MethodGet, pathPrefix+"/destinations").
+		WithGrantlessScope(constants.ScopeNotifications).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// CreateDestination creates an Amazon SQS or EventBridge destination for notifications. This is a grantless operation.
+func (a *API) CreateDestination(ctx context.Context, request *CreateDestinationRequest) (*apis.CallResponse[CreateDestinationResponse], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	return apis.NewCall[CreateDestinationResponse](http.MethodPost, pathPrefix+"/destinations").
+		WithBody(body).
+		WithGrantlessScope(constants.ScopeNotifications).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetDestination returns the destination with the given destinationID. This is a grantless operation.
+func (a *API) GetDestination(ctx context.Context, destinationID string) (*apis.CallResponse[GetDestinationResponse], error) {
+	return apis.NewCall[GetDestinationResponse](http.MethodGet, pathPrefix+"/destinations/{destinationID}").
+		WithPathParam("destinationID", destinationID).
+		WithGrantlessScope(constants.ScopeNotifications).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// DeleteDestination deletes the destination with the given destinationID. This is a grantless operation.
+func (a *API) DeleteDestination(ctx context.Context, destinationID string) (*apis.CallResponse[DeleteDestinationResponse], error) {
+	return apis.NewCall[DeleteDestinationResponse](http.MethodDelete, pathPrefix+"/destinations/{destinationID}").
+		WithPathParam("destinationID", destinationID).
+		WithGrantlessScope(constants.ScopeNotifications).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
@@ -0,0 +1,16 @@
+package orders
+
+import (
+	"testing"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/internal/golden"
+)
+
+func TestGoldenFixtures(t *testing.T) {
+	t.Run("getOrder", func(t *testing.T) {
+		golden.RoundTrip[GetOrderResponse](t, "testdata/getOrder.json")
+	})
+	t.Run("getOrderItems", func(t *testing.T) {
+		golden.RoundTrip[GetOrderItemsResponse](t, "testdata/getOrderItems.json")
+	})
+}
@@ -744,7 +744,7 @@ type GetOrdersFilter struct {
 	// LastUpdatedBefore, and BuyerEmail cannot be specified.
 	SellerOrderID string
 	// MaxResultsPerPage a number that indicates the maximum number of orders that can be returned per page.
-	// Value must be 1 - 100. Default 100.
+	// Value must be 1 - 100, larger values are sent as 100. Amazon's default of 100 is used if unset.
 	MaxResultsPerPage int
 	// EasyShipShipmentStatuses a list of EasyShipShipmentStatus values.
 	// Used to select Easy Ship orders with statuses that match the specified values.
@@ -779,10 +779,9 @@ func (f *GetOrdersFilter) GetQuery() url.Values {
 	utils.AddToQueryIfSet(q, "PaymentMethods", utils.MapToCommaString(f.PaymentMethods))
 	utils.AddToQueryIfSet(q, "BuyerEmail", f.BuyerEmail)
 	utils.AddToQueryIfSet(q, "SellerOrderId", f.SellerOrderID)
-	if f.MaxResultsPerPage < 1 || f.MaxResultsPerPage > 100 {
-		f.MaxResultsPerPage = 100
+	if f.MaxResultsPerPage > 0 {
+		utils.AddToQueryIfSet(q, "MaxResultsPerPage", strconv.Itoa(min(f.MaxResultsPerPage, 100)))
 	}
-	utils.AddToQueryIfSet(q, "MaxResultsPerPage", strconv.Itoa(f.MaxResultsPerPage))
 	utils.AddToQueryIfSet(q, "EasyShipShipmentStatuses", utils.MapToCommaString(f.EasyShipShipmentStatuses))
 	utils.AddToQueryIfSet(q, "ElectronicInvoiceStatuses", utils.MapToCommaString(f.ElectronicInvoiceStatuses))
 	utils.AddToQueryIfSet(q, "NextToken", f.NextToken)
@@ -0,0 +1,21 @@
+package orders
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOrdersFilter_GetQuery(t *testing.T) {
+	filter := &GetOrdersFilter{SellerOrderID: "123"}
+	assert.Equal(t, url.Values{"SellerOrderId": {"123"}}, filter.GetQuery(), "MaxResultsPerPage must not be sent if unset")
+	assert.Zero(t, filter.MaxResultsPerPage, "GetQuery must not change the filter")
+
+	filter.MaxResultsPerPage = 20
+	assert.Equal(t, "20", filter.GetQuery().Get("MaxResultsPerPage"))
+
+	filter.MaxResultsPerPage = 500
+	assert.Equal(t, "100", filter.GetQuery().Get("MaxResultsPerPage"))
+	assert.Equal(t, 500, filter.MaxResultsPerPage)
+}
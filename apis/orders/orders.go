@@ -1,6 +1,7 @@
 package orders
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"go/types"
@@ -18,6 +19,26 @@ type API struct {
 	httpClient *httpx.Client
 }
 
+// Client is the interface of API, implemented by *API and by mocks.OrdersClient for tests.
+type Client interface {
+	GetOrders(ctx context.Context, filter *GetOrdersFilter, restrictedDataToken *string) (*apis.CallResponse[GetOrdersResponse], error)
+	OrdersPaginator(filter *GetOrdersFilter, restrictedDataToken *string) *apis.Paginator[Order]
+	GetOrder(ctx context.Context, orderID string, restrictedDataToken *string) (*apis.CallResponse[GetOrderResponse], error)
+	GetOrderBuyerInfo(ctx context.Context, orderID string) (*apis.CallResponse[GetOrderBuyerInfoResponse], error)
+	GetOrderAddress(ctx context.Context, orderID string, restrictedDataToken *string) (*apis.CallResponse[GetOrderAddressResponse], error)
+	GetOrderItems(ctx context.Context, orderID string, nextToken *string, restrictedDataToken *string) (*apis.CallResponse[GetOrderItemsResponse], error)
+	OrderItemsPaginator(orderID string, restrictedDataToken *string) *apis.Paginator[OrderItem]
+	GetOrderItemsBuyerInfo(ctx context.Context, orderID string, nextToken *string, restrictedDataToken *string) (*apis.CallResponse[GetOrderItemsBuyerInfoResponse], error)
+	UpdateShipmentStatus(ctx context.Context, orderID string, payload *UpdateShipmentStatusRequest) (*apis.CallResponse[UpdateShipmentStatusErrorResponse], error)
+	GetOrderRegulatedInfo(ctx context.Context, orderID string) (*apis.CallResponse[GetOrderRegulatedInfoResponse], error)
+	UpdateVerificationStatus(ctx context.Context, orderID string, payload *UpdateVerificationStatusRequest) (*apis.CallResponse[UpdateVerificationStatusErrorResponse], error)
+	GetOrderItemsApprovals(ctx context.Context, orderID string, filter GetOrderItemsApprovalsFilter) (*apis.CallResponse[GetOrderApprovalsResponse], error)
+	UpdateOrderItemsApprovals(ctx context.Context, orderID string, payload *UpdateOrderApprovalsRequest) (*apis.CallResponse[types.Nil], error)
+	ConfirmShipment(ctx context.Context, orderID string, payload *ConfirmShipmentRequest) (*apis.CallResponse[types.Nil], error)
+}
+
+var _ Client = (*API)(nil)
+
 func NewAPI(httpClient *httpx.Client) *API {
 	return &API{
 		httpClient: httpClient,
@@ -28,7 +49,7 @@ func NewAPI(httpClient *httpx.Client) *API {
 // You can also apply a range of filtering criteria to narrow the list of orders returned. If NextToken is present,
 // that will be used to retrieve the orders instead of other criteria.
 // A restrictedDataToken is optional and may be passed to receive Personally Identifiable Information (PII).
-func (a *API) GetOrders(filter *GetOrdersFilter, restrictedDataToken *string) (*apis.CallResponse[GetOrdersResponse], error) {
+func (a *API) GetOrders(ctx context.Context, filter *GetOrdersFilter, restrictedDataToken *string) (*apis.CallResponse[GetOrdersResponse], error) {
 	if len(filter.MarketplaceIDs) > 50 {
 		return nil, errors.New("marketplaceIDs must not contain more than 50 elements")
 	}
@@ -39,103 +60,151 @@ func (a *API) GetOrders(filter *GetOrdersFilter, restrictedDataToken *string) (*
 	return apis.NewCall[GetOrdersResponse](http.MethodGet, pathPrefix+"/orders").
 		WithQueryParams(filter.GetQuery()).
 		WithRateLimit(0.0167, time.Second).
+		WithBurst(20).
 		WithRestrictedDataToken(restrictedDataToken).
 		WithParseErrorListOnError().
-		Execute(a.httpClient)
+		Execute(ctx, a.httpClient)
+}
+
+// OrdersPaginator returns a Paginator over the orders that match the filter. The following pages
+// are fetched with the filter and their NextToken, which takes precedence over the other criteria.
+func (a *API) OrdersPaginator(filter *GetOrdersFilter, restrictedDataToken *string) *apis.Paginator[Order] {
+	return apis.NewPaginator(func(ctx context.Context, nextToken string) ([]Order, string, error) {
+		pageFilter := *filter
+		if nextToken != "" {
+			pageFilter.NextToken = nextToken
+		}
+		resp, err := a.GetOrders(ctx, &pageFilter, restrictedDataToken)
+		if err != nil || resp.ResponseBody == nil || resp.ResponseBody.Payload == nil {
+			return nil, "", err
+		}
+		return resp.ResponseBody.Payload.Orders, apis.Deref(resp.ResponseBody.Payload.NextToken), nil
+	})
 }
 
 // GetOrder Returns the order that you specify.
 // A restrictedDataToken is optional and may be passed to receive Personally Identifiable Information (PII).
-func (a *API) GetOrder(orderID string, restrictedDataToken *string) (*apis.CallResponse[GetOrderResponse], error) {
-	return apis.NewCall[GetOrderResponse](http.MethodGet, pathPrefix+"/orders/"+orderID).
+func (a *API) GetOrder(ctx context.Context, orderID string, restrictedDataToken *string) (*apis.CallResponse[GetOrderResponse], error) {
+	return apis.NewCall[GetOrderResponse](http.MethodGet, pathPrefix+"/orders/{orderID}").
+		WithPathParam("orderID", orderID).
 		WithRateLimit(0.0167, time.Second).
+		WithBurst(30).
 		WithRestrictedDataToken(restrictedDataToken).
-		Execute(a.httpClient)
+		Execute(ctx, a.httpClient)
 }
 
 // GetOrderBuyerInfo returns buyer information for the order that you specify.
-func (a *API) GetOrderBuyerInfo(orderID string) (*apis.CallResponse[GetOrderBuyerInfoResponse], error) {
-	return apis.NewCall[GetOrderBuyerInfoResponse](http.MethodGet, pathPrefix+"/orders/"+orderID+"/buyerInfo").
+func (a *API) GetOrderBuyerInfo(ctx context.Context, orderID string) (*apis.CallResponse[GetOrderBuyerInfoResponse], error) {
+	return apis.NewCall[GetOrderBuyerInfoResponse](http.MethodGet, pathPrefix+"/orders/{orderID}/buyerInfo").
+		WithPathParam("orderID", orderID).
 		WithRateLimit(0.0167, time.Second).
-		Execute(a.httpClient)
+		WithBurst(30).
+		Execute(ctx, a.httpClient)
 }
 
 // GetOrderAddress returns the shipping address for the order that you specify.
 // A restrictedDataToken is optional and may be passed to receive Personally Identifiable Information (PII).
-func (a *API) GetOrderAddress(orderID string, restrictedDataToken *string) (*apis.CallResponse[GetOrderAddressResponse], error) {
-	return apis.NewCall[GetOrderAddressResponse](http.MethodGet, pathPrefix+"/orders/"+orderID+"/address").
+func (a *API) GetOrderAddress(ctx context.Context, orderID string, restrictedDataToken *string) (*apis.CallResponse[GetOrderAddressResponse], error) {
+	return apis.NewCall[GetOrderAddressResponse](http.MethodGet, pathPrefix+"/orders/{orderID}/address").
+		WithPathParam("orderID", orderID).
 		WithRateLimit(0.0167, time.Second).
+		WithBurst(30).
 		WithRestrictedDataToken(restrictedDataToken).
-		Execute(a.httpClient)
+		Execute(ctx, a.httpClient)
 }
 
 // GetOrderItems returns detailed order item information for the order that you specify.
 // If NextToken is provided, it's used to retrieve the next page of order items.
 // A restrictedDataToken is optional and may be passed to receive Personally Identifiable Information (PII).
-func (a *API) GetOrderItems(orderID string, nextToken *string, restrictedDataToken *string) (*apis.CallResponse[GetOrderItemsResponse], error) {
+func (a *API) GetOrderItems(ctx context.Context, orderID string, nextToken *string, restrictedDataToken *string) (*apis.CallResponse[GetOrderItemsResponse], error) {
 	params := url.Values{}
 	if nextToken != nil && *nextToken != "" {
 		params.Add("NextToken", *nextToken)
 	}
 
-	return apis.NewCall[GetOrderItemsResponse](http.MethodGet, pathPrefix+"/orders/"+orderID+"/orderItems").
+	return apis.NewCall[GetOrderItemsResponse](http.MethodGet, pathPrefix+"/orders/{orderID}/orderItems").
+		WithPathParam("orderID", orderID).
 		WithQueryParams(params).
 		WithRateLimit(0.5, time.Second).
+		WithBurst(30).
 		WithRestrictedDataToken(restrictedDataToken).
-		Execute(a.httpClient)
+		Execute(ctx, a.httpClient)
+}
+
+// OrderItemsPaginator returns a Paginator over the items of the order.
+func (a *API) OrderItemsPaginator(orderID string, restrictedDataToken *string) *apis.Paginator[OrderItem] {
+	return apis.NewPaginator(func(ctx context.Context, nextToken string) ([]OrderItem, string, error) {
+		var pageToken *string
+		if nextToken != "" {
+			pageToken = &nextToken
+		}
+		resp, err := a.GetOrderItems(ctx, orderID, pageToken, restrictedDataToken)
+		if err != nil || resp.ResponseBody == nil || resp.ResponseBody.Payload == nil {
+			return nil, "", err
+		}
+		return resp.ResponseBody.Payload.OrderItems, apis.Deref(resp.ResponseBody.Payload.NextToken), nil
+	})
 }
 
 // GetOrderItemsBuyerInfo returns buyer information for the order items in the order that you specify.
 // A restrictedDataToken is optional and may be passed to receive Personally Identifiable Information (PII).
-func (a *API) GetOrderItemsBuyerInfo(orderID string, nextToken *string, restrictedDataToken *string) (*apis.CallResponse[GetOrderItemsBuyerInfoResponse], error) {
+func (a *API) GetOrderItemsBuyerInfo(ctx context.Context, orderID string, nextToken *string, restrictedDataToken *string) (*apis.CallResponse[GetOrderItemsBuyerInfoResponse], error) {
 	params := url.Values{}
 	if nextToken != nil && *nextToken != "" {
 		params.Add("NextToken", *nextToken)
 	}
 
-	return apis.NewCall[GetOrderItemsBuyerInfoResponse](http.MethodGet, pathPrefix+"/orders/"+orderID+"/orderItems/buyerInfo").
+	return apis.NewCall[GetOrderItemsBuyerInfoResponse](http.MethodGet, pathPrefix+"/orders/{orderID}/orderItems/buyerInfo").
+		WithPathParam("orderID", orderID).
 		WithQueryParams(params).
 		WithRateLimit(0.5, time.Second).
+		WithBurst(30).
 		WithRestrictedDataToken(restrictedDataToken).
-		Execute(a.httpClient)
+		Execute(ctx, a.httpClient)
 }
 
 // UpdateShipmentStatus update the shipment status for an order that you specify.
-func (a *API) UpdateShipmentStatus(orderID string, payload *UpdateShipmentStatusRequest) (*apis.CallResponse[UpdateShipmentStatusErrorResponse], error) {
+func (a *API) UpdateShipmentStatus(ctx context.Context, orderID string, payload *UpdateShipmentStatusRequest) (*apis.CallResponse[UpdateShipmentStatusErrorResponse], error) {
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
 
-	return apis.NewCall[UpdateShipmentStatusErrorResponse](http.MethodPost, pathPrefix+"/orders/"+orderID+"/shipment").
+	return apis.NewCall[UpdateShipmentStatusErrorResponse](http.MethodPost, pathPrefix+"/orders/{orderID}/shipment").
+		WithPathParam("orderID", orderID).
 		WithBody(body).
 		WithRateLimit(5, time.Second).
-		Execute(a.httpClient)
+		WithBurst(15).
+		Execute(ctx, a.httpClient)
 }
 
 // GetOrderRegulatedInfo returns regulated information for the order that you specify.
-func (a *API) GetOrderRegulatedInfo(orderID string) (*apis.CallResponse[GetOrderRegulatedInfoResponse], error) {
-	return apis.NewCall[GetOrderRegulatedInfoResponse](http.MethodGet, pathPrefix+"/orders/"+orderID+"/regulatedInfo").
+func (a *API) GetOrderRegulatedInfo(ctx context.Context, orderID string) (*apis.CallResponse[GetOrderRegulatedInfoResponse], error) {
+	return apis.NewCall[GetOrderRegulatedInfoResponse](http.MethodGet, pathPrefix+"/orders/{orderID}/regulatedInfo").
+		WithPathParam("orderID", orderID).
 		WithRateLimit(0.5, time.Second).
-		Execute(a.httpClient)
+		WithBurst(30).
+		Execute(ctx, a.httpClient)
 }
 
 // UpdateVerificationStatus Updates (approves or rejects) the verification status of an order containing regulated products.
-func (a *API) UpdateVerificationStatus(orderID string, payload *UpdateVerificationStatusRequest) (*apis.CallResponse[UpdateVerificationStatusErrorResponse], error) {
+func (a *API) UpdateVerificationStatus(ctx context.Context, orderID string, payload *UpdateVerificationStatusRequest) (*apis.CallResponse[UpdateVerificationStatusErrorResponse], error) {
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
 
-	return apis.NewCall[UpdateVerificationStatusErrorResponse](http.MethodPatch, pathPrefix+"/orders/"+orderID+"/regulatedInfo").
+	return apis.NewCall[UpdateVerificationStatusErrorResponse](http.MethodPatch, pathPrefix+"/orders/{orderID}/regulatedInfo").
+		WithPathParam("orderID", orderID).
 		WithBody(body).
 		WithRateLimit(0.5, time.Second).
-		Execute(a.httpClient)
+		WithBurst(30).
+		Execute(ctx, a.httpClient)
 }
 
 // GetOrderItemsApprovals returns detailed order items approvals information for the order specified.
 // If NextToken is provided, it's used to retrieve the next page of order items approvals.
-func (a *API) GetOrderItemsApprovals(orderID string, filter GetOrderItemsApprovalsFilter) (*apis.CallResponse[GetOrderApprovalsResponse], error) {
+func (a *API) GetOrderItemsApprovals(ctx context.Context, orderID string, filter GetOrderItemsApprovalsFilter) (*apis.CallResponse[GetOrderApprovalsResponse], error) {
 	if len(filter.ItemApprovalTypes) > 1 {
 		return nil, errors.New("itemApprovalTypes must not contain more than 1 element")
 	}
@@ -144,36 +213,40 @@ func (a *API) GetOrderItemsApprovals(orderID string, filter GetOrderItemsApprova
 		return nil, errors.New("itemApprovalStatus must not contain more than 6 elements")
 	}
 
-	return apis.NewCall[GetOrderApprovalsResponse](http.MethodGet, pathPrefix+"/orders/"+orderID+"/orderItems/approvals").
+	return apis.NewCall[GetOrderApprovalsResponse](http.MethodGet, pathPrefix+"/orders/{orderID}/orderItems/approvals").
+		WithPathParam("orderID", orderID).
 		WithQueryParams(filter.GetQuery()).
 		WithRateLimit(0.5, time.Second).
-		Execute(a.httpClient)
+		Execute(ctx, a.httpClient)
 }
 
 // UpdateOrderItemsApprovals updates the oder items approvals for the specified order.
-func (a *API) UpdateOrderItemsApprovals(orderID string, payload *UpdateOrderApprovalsRequest) (*apis.CallResponse[types.Nil], error) {
+func (a *API) UpdateOrderItemsApprovals(ctx context.Context, orderID string, payload *UpdateOrderApprovalsRequest) (*apis.CallResponse[types.Nil], error) {
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
 
-	return apis.NewCall[types.Nil](http.MethodPost, pathPrefix+"/orders/"+orderID+"/orderItems/approvals").
+	return apis.NewCall[types.Nil](http.MethodPost, pathPrefix+"/orders/{orderID}/orderItems/approvals").
+		WithPathParam("orderID", orderID).
 		WithBody(body).
 		WithParseErrorListOnError().
 		WithRateLimit(5, time.Second).
-		Execute(a.httpClient)
+		Execute(ctx, a.httpClient)
 }
 
 // ConfirmShipment updates the shipment status for the specified order.
-func (a *API) ConfirmShipment(orderID string, payload *ConfirmShipmentRequest) (*apis.CallResponse[types.Nil], error) {
+func (a *API) ConfirmShipment(ctx context.Context, orderID string, payload *ConfirmShipmentRequest) (*apis.CallResponse[types.Nil], error) {
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
 
-	return apis.NewCall[types.Nil](http.MethodPost, pathPrefix+"/orders/"+orderID+"/shipmentConfirmation").
+	return apis.NewCall[types.Nil](http.MethodPost, pathPrefix+"/orders/{orderID}/shipmentConfirmation").
+		WithPathParam("orderID", orderID).
 		WithBody(body).
 		WithParseErrorListOnError().
 		WithRateLimit(2, time.Second).
-		Execute(a.httpClient)
+		WithBurst(10).
+		Execute(ctx, a.httpClient)
 }
@@ -0,0 +1,102 @@
+package apis
+
+import (
+	"context"
+)
+
+// PageFunc fetches the page of nextToken and returns its items and the token of the following page.
+// An empty nextToken requests the first page, an empty next marks the last page.
+type PageFunc[T any] func(ctx context.Context, nextToken string) (items []T, next string, err error)
+
+// Paginator follows the nextToken of paginated operations. Use NextPage to fetch page by page,
+// Next and Item to iterate over the items, or All to collect them:
+//
+//	p := apis.NewPaginator(fetchPage)
+//	for p.Next(ctx) {
+//		process(p.Item())
+//	}
+//	if err := p.Err(); err != nil {
+//		...
+//	}
+//
+// A Paginator is not safe for concurrent use.
+type Paginator[T any] struct {
+	fetch     PageFunc[T]
+	nextToken string
+	done      bool
+	page      []T
+	item      T
+	err       error
+}
+
+// NewPaginator returns a Paginator starting with the first page.
+func NewPaginator[T any](fetch PageFunc[T]) *Paginator[T] {
+	return &Paginator[T]{fetch: fetch}
+}
+
+// NewPaginatorFrom returns a Paginator resuming at the page of nextToken, e.g. a NextToken of an
+// earlier run.
+func NewPaginatorFrom[T any](fetch PageFunc[T], nextToken string) *Paginator[T] {
+	return &Paginator[T]{fetch: fetch, nextToken: nextToken}
+}
+
+// HasMorePages reports whether NextPage fetches another page.
+func (p *Paginator[T]) HasMorePages() bool {
+	return !p.done && p.err == nil
+}
+
+// NextToken returns the token of the next page, empty before the first and after the last page.
+func (p *Paginator[T]) NextToken() string {
+	return p.nextToken
+}
+
+// NextPage fetches the next page. It returns nil after the last page.
+func (p *Paginator[T]) NextPage(ctx context.Context) ([]T, error) {
+	if !p.HasMorePages() {
+		return nil, p.err
+	}
+	items, next, err := p.fetch(ctx, p.nextToken)
+	if err != nil {
+		p.err = err
+		return nil, err
+	}
+	p.nextToken = next
+	p.done = next == ""
+	return items, nil
+}
+
+// Next advances to the next item, fetching pages as needed. It returns false after the last item
+// or if a page could not be fetched, see Err.
+func (p *Paginator[T]) Next(ctx context.Context) bool {
+	for len(p.page) == 0 {
+		if !p.HasMorePages() {
+			return false
+		}
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			return false
+		}
+		p.page = page
+	}
+	p.item, p.page = p.page[0], p.page[1:]
+	return true
+}
+
+// Item returns the current item of Next.
+func (p *Paginator[T]) Item() T {
+	return p.item
+}
+
+// Err returns the error which stopped the pagination.
+func (p *Paginator[T]) Err() error {
+	return p.err
+}
+
+// All returns the items of all remaining pages. On error, it returns the items fetched so far.
+func (p *Paginator[T]) All(ctx context.Context) ([]T, error) {
+	var items []T
+	for p.Next(ctx) {
+		items = append(items, p.item)
+	}
+	return items, p.err
+}
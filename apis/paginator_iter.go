@@ -0,0 +1,45 @@
+//go:build go1.23
+
+package apis
+
+import (
+	"context"
+	"iter"
+)
+
+// Items returns an iterator over the items of all remaining pages. Pages are fetched while the
+// loop runs, breaking the loop stops fetching. An error ends the iteration with a zero item and
+// the error:
+//
+//	for order, err := range client.OrdersAPI.OrdersPaginator(filter, nil).Items(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		process(order)
+//	}
+func (p *Paginator[T]) Items(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.Next(ctx) {
+			if !yield(p.item, nil) {
+				return
+			}
+		}
+		if p.err != nil {
+			var zero T
+			yield(zero, p.err)
+		}
+	}
+}
+
+// Pages returns an iterator over the remaining pages. An error ends the iteration with a nil page
+// and the error.
+func (p *Paginator[T]) Pages(ctx context.Context) iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		for p.HasMorePages() {
+			page, err := p.NextPage(ctx)
+			if !yield(page, err) || err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+//go:build go1.23
+
+package apis
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPaginator_Items(t *testing.T) {
+	fetch, tokens := pages(t, []int{1, 2}, []int{3}, []int{4})
+
+	var items []int
+	for item, err := range NewPaginator(fetch).Items(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		items = append(items, item)
+		if item == 3 {
+			break
+		}
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(items, want) {
+		t.Errorf("items = %v, want %v", items, want)
+	}
+	if len(*tokens) != 2 {
+		t.Errorf("%d pages were fetched, breaking the loop should stop fetching", len(*tokens))
+	}
+}
+
+func TestPaginator_ItemsError(t *testing.T) {
+	failure := errors.New("throttled")
+	p := NewPaginator(func(context.Context, string) ([]int, string, error) {
+		return nil, "", failure
+	})
+
+	var errs []error
+	for _, err := range p.Items(context.Background()) {
+		errs = append(errs, err)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], failure) {
+		t.Errorf("errors = %v, want the error of the page", errs)
+	}
+}
+
+func TestPaginator_Pages(t *testing.T) {
+	fetch, _ := pages(t, []int{1}, []int{2, 3})
+
+	var got [][]int
+	for page, err := range NewPaginator(fetch).Pages(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, page)
+	}
+	if want := [][]int{{1}, {2, 3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("pages = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,78 @@
+package apis
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// pages returns a PageFunc serving the pages, which are requested with the tokens "", "1", "2", ...
+func pages(t *testing.T, pages ...[]int) (PageFunc[int], *[]string) {
+	var tokens []string
+	return func(_ context.Context, nextToken string) ([]int, string, error) {
+		tokens = append(tokens, nextToken)
+		index := len(tokens) - 1
+		if index >= len(pages) {
+			t.Fatalf("page %d was requested after the last page", index)
+		}
+		if index == len(pages)-1 {
+			return pages[index], "", nil
+		}
+		return pages[index], string(rune('1' + index)), nil
+	}, &tokens
+}
+
+func TestPaginator_All(t *testing.T) {
+	fetch, tokens := pages(t, []int{1, 2}, nil, []int{3})
+
+	items, err := NewPaginator(fetch).All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(items, want) {
+		t.Errorf("All() = %v, want %v", items, want)
+	}
+	if want := []string{"", "1", "2"}; !reflect.DeepEqual(*tokens, want) {
+		t.Errorf("requested tokens %v, want %v", *tokens, want)
+	}
+}
+
+func TestPaginator_NextPage(t *testing.T) {
+	fetch, _ := pages(t, []int{1}, []int{2})
+	p := NewPaginator(fetch)
+	ctx := context.Background()
+
+	var got [][]int
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, page)
+	}
+	if want := [][]int{{1}, {2}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("pages = %v, want %v", got, want)
+	}
+	if page, err := p.NextPage(ctx); page != nil || err != nil {
+		t.Errorf("NextPage() after the last page = %v, %v", page, err)
+	}
+}
+
+func TestPaginator_Error(t *testing.T) {
+	failure := errors.New("throttled")
+	p := NewPaginatorFrom(func(_ context.Context, nextToken string) ([]int, string, error) {
+		if nextToken == "resume" {
+			return []int{1}, "next", nil
+		}
+		return nil, "", failure
+	}, "resume")
+
+	items, err := p.All(context.Background())
+	if !errors.Is(err, failure) || !reflect.DeepEqual(items, []int{1}) {
+		t.Errorf("All() = %v, %v, want the items before the error", items, err)
+	}
+	if p.NextToken() != "next" || p.HasMorePages() {
+		t.Errorf("NextToken() = %q, HasMorePages() = %v after the error", p.NextToken(), p.HasMorePages())
+	}
+}
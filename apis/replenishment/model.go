@@ -0,0 +1,301 @@
+package replenishment
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+// AggregationFrequency The time period used to group data in the response.
+type AggregationFrequency string
+
+const (
+	AggregationFrequencyWeek    AggregationFrequency = "WEEK"
+	AggregationFrequencyMonth   AggregationFrequency = "MONTH"
+	AggregationFrequencyQuarter AggregationFrequency = "QUARTER"
+	AggregationFrequencyYear    AggregationFrequency = "YEAR"
+)
+
+// TimePeriodType The time period type that determines whether the metrics requested are backward-looking
+// (performance) or forward-looking (forecast).
+type TimePeriodType string
+
+const (
+	TimePeriodTypePerformance TimePeriodType = "PERFORMANCE"
+	TimePeriodTypeForecast    TimePeriodType = "FORECAST"
+)
+
+// ProgramType The replenishment program type.
+type ProgramType string
+
+const ProgramTypeSubscribeAndSave ProgramType = "SUBSCRIBE_AND_SAVE"
+
+// Metric The metric name and description.
+type Metric string
+
+const (
+	MetricShippedSubscriptionUnits               Metric = "SHIPPED_SUBSCRIPTION_UNITS"
+	MetricTotalSubscriptionsRevenue              Metric = "TOTAL_SUBSCRIPTIONS_REVENUE"
+	MetricActiveSubscriptions                    Metric = "ACTIVE_SUBSCRIPTIONS"
+	MetricNotDeliveredDueToOOS                   Metric = "NOT_DELIVERED_DUE_TO_OOS"
+	MetricSubscriberNonSubscriberAverageRevenue  Metric = "SUBSCRIBER_NON_SUBSCRIBER_AVERAGE_REVENUE"
+	MetricLostRevenueDueToOOS                    Metric = "LOST_REVENUE_DUE_TO_OOS"
+	MetricSubscriberNonSubscriberAverageReorders Metric = "SUBSCRIBER_NON_SUBSCRIBER_AVERAGE_REORDERS"
+	MetricCouponsRevenuePenetration              Metric = "COUPONS_REVENUE_PENETRATION"
+)
+
+// SortOrder The sort order.
+type SortOrder string
+
+const (
+	SortOrderAscending  SortOrder = "ASC"
+	SortOrderDescending SortOrder = "DESC"
+)
+
+// EligibilityStatus The current eligibility status of an offer.
+type EligibilityStatus string
+
+const (
+	EligibilityStatusEligible          EligibilityStatus = "ELIGIBLE"
+	EligibilityStatusIneligible        EligibilityStatus = "INELIGIBLE"
+	EligibilityStatusSuspended         EligibilityStatus = "SUSPENDED"
+	EligibilityStatusReplenishmentOnly EligibilityStatus = "REPLENISHMENT_ONLY_ORDERING"
+)
+
+// FulfillmentChannelType The fulfillment channel type.
+type FulfillmentChannelType string
+
+const (
+	FulfillmentChannelTypeAFN FulfillmentChannelType = "AFN"
+	FulfillmentChannelTypeMFN FulfillmentChannelType = "MFN"
+)
+
+// TimeInterval A date-time interval in ISO 8601 format which is used to compute metrics. Only the date is
+// required, but you must pass the complete date and time value.
+type TimeInterval struct {
+	// When this object is used as a request parameter, the specified startDate is adjusted based on the
+	// aggregation frequency.
+	StartDate apis.JsonTimeISO8601 `json:"startDate"`
+	// When this object is used as a request parameter, the specified endDate is adjusted based on the
+	// aggregation frequency.
+	EndDate apis.JsonTimeISO8601 `json:"endDate"`
+}
+
+// ResponseTimeInterval The date-time interval of returned metrics.
+type ResponseTimeInterval struct {
+	StartDate time.Time `json:"startDate"`
+	EndDate   time.Time `json:"endDate"`
+}
+
+// GetSellingPartnerMetricsRequest The request body for the getSellingPartnerMetrics operation.
+type GetSellingPartnerMetricsRequest struct {
+	// The time period used to group data in the response. Note that this is only valid for the performance time period type.
+	AggregationFrequency *AggregationFrequency `json:"aggregationFrequency,omitempty"`
+	TimeInterval         TimeInterval          `json:"timeInterval"`
+	// The list of metrics requested. If no metric value is provided, data for all of the metrics will be returned.
+	Metrics        []Metric                `json:"metrics,omitempty"`
+	TimePeriodType TimePeriodType          `json:"timePeriodType"`
+	MarketplaceID  constants.MarketplaceID `json:"marketplaceId"`
+	// A list of replenishment program types.
+	ProgramTypes []ProgramType `json:"programTypes"`
+}
+
+// SellingPartnerMetrics Contains the selling partner metrics for a time interval.
+type SellingPartnerMetrics struct {
+	// The percentage of items that were not shipped out of the total shipped units over a period of time due to being out of stock.
+	NotDeliveredDueToOOS *float64 `json:"notDeliveredDueToOOS,omitempty"`
+	// The revenue generated from subscriptions over a period of time.
+	TotalSubscriptionsRevenue *json.Number `json:"totalSubscriptionsRevenue,omitempty"`
+	// The number of units shipped to the subscribers over a period of time.
+	ShippedSubscriptionUnits *int64 `json:"shippedSubscriptionUnits,omitempty"`
+	// The number of active subscriptions present at the end of the period.
+	ActiveSubscriptions *int64 `json:"activeSubscriptions,omitempty"`
+	// The average revenue per subscriber of the program over a period of past 12 months for sellers and 6 months for vendors.
+	SubscriberAverageRevenue *json.Number `json:"subscriberAverageRevenue,omitempty"`
+	// The average revenue per non-subscriber of the program over a period of past 12 months for sellers and 6 months for vendors.
+	NonSubscriberAverageRevenue *json.Number `json:"nonSubscriberAverageRevenue,omitempty"`
+	// The revenue that would have been generated had there not been out of stock.
+	LostRevenueDueToOOS *json.Number `json:"lostRevenueDueToOOS,omitempty"`
+	// The average reorders per subscriber of the program over a period of 12 months.
+	SubscriberAverageReorders *float64 `json:"subscriberAverageReorders,omitempty"`
+	// The average reorders per non-subscriber of the program over a period of past 12 months.
+	NonSubscriberAverageReorders *float64 `json:"nonSubscriberAverageReorders,omitempty"`
+	// The percentage of revenue from ASINs with coupons out of total revenue from all ASINs.
+	CouponsRevenuePenetration *float64 `json:"couponsRevenuePenetration,omitempty"`
+	// The percentage of total program revenue out of total product revenue.
+	RevenuePenetration *float64              `json:"revenuePenetration,omitempty"`
+	TimeInterval       *ResponseTimeInterval `json:"timeInterval,omitempty"`
+	// The currency code in ISO 4217 format.
+	CurrencyCode *string `json:"currencyCode,omitempty"`
+}
+
+// GetSellingPartnerMetricsResponse The response schema for the getSellingPartnerMetrics operation.
+type GetSellingPartnerMetricsResponse struct {
+	// A list of metrics data for the selling partner.
+	Metrics []SellingPartnerMetrics `json:"metrics,omitempty"`
+}
+
+// PaginationRequest Use these parameters to paginate through the response.
+type PaginationRequest struct {
+	// The maximum number of results to return in the response. Min 1, max 500.
+	Limit int `json:"limit"`
+	// The offset from which to retrieve the number of results specified by the limit value. The first result is at offset 0.
+	Offset int `json:"offset"`
+}
+
+// PaginationResponse Use these parameters to paginate through the response.
+type PaginationResponse struct {
+	// Total number of results matching the given filter criteria.
+	TotalResults *int64 `json:"totalResults,omitempty"`
+}
+
+// ListOfferMetricsRequestSort Use these parameters to sort the response.
+type ListOfferMetricsRequestSort struct {
+	Order SortOrder `json:"order"`
+	// The attribute to use to sort the results, e.g. SHIPPED_SUBSCRIPTION_UNITS or TOTAL_SUBSCRIPTIONS_REVENUE.
+	Key string `json:"key"`
+}
+
+// ListOfferMetricsRequestFilters Use these parameters to filter results. Any result must match all provided parameters.
+type ListOfferMetricsRequestFilters struct {
+	// The time period used to group data in the response. Note that this is only valid for the performance time period type.
+	AggregationFrequency *AggregationFrequency   `json:"aggregationFrequency,omitempty"`
+	TimeInterval         TimeInterval            `json:"timeInterval"`
+	TimePeriodType       TimePeriodType          `json:"timePeriodType"`
+	MarketplaceID        constants.MarketplaceID `json:"marketplaceId"`
+	// A list of replenishment program types.
+	ProgramTypes []ProgramType `json:"programTypes"`
+	// A list of Amazon Standard Identification Numbers (ASINs).
+	Asins []string `json:"asins,omitempty"`
+}
+
+// ListOfferMetricsRequest The request body for the listOfferMetrics operation.
+type ListOfferMetricsRequest struct {
+	Pagination PaginationRequest              `json:"pagination"`
+	Sort       *ListOfferMetricsRequestSort   `json:"sort,omitempty"`
+	Filters    ListOfferMetricsRequestFilters `json:"filters"`
+}
+
+// OfferMetrics An object which contains offer metrics.
+type OfferMetrics struct {
+	// The Amazon Standard Identification Number (ASIN).
+	Asin *string `json:"asin,omitempty"`
+	// The percentage of items that were not shipped out of the total shipped units over a period of time due to being out of stock.
+	NotDeliveredDueToOOS *float64 `json:"notDeliveredDueToOOS,omitempty"`
+	// The revenue generated from subscriptions over a period of time.
+	TotalSubscriptionsRevenue *json.Number `json:"totalSubscriptionsRevenue,omitempty"`
+	// The number of units shipped to the subscribers over a period of time.
+	ShippedSubscriptionUnits *int64 `json:"shippedSubscriptionUnits,omitempty"`
+	// The number of active subscriptions present at the end of the period.
+	ActiveSubscriptions *int64 `json:"activeSubscriptions,omitempty"`
+	// The percentage of total program revenue out of total product revenue.
+	RevenuePenetration *float64 `json:"revenuePenetration,omitempty"`
+	// The revenue that would have been generated had there not been out of stock.
+	LostRevenueDueToOOS *json.Number `json:"lostRevenueDueToOOS,omitempty"`
+	// The percentage of revenue from ASINs with coupons out of total revenue from all ASINs.
+	CouponsRevenuePenetration *float64 `json:"couponsRevenuePenetration,omitempty"`
+	// The forecasted total subscription revenue for the next 30 days.
+	Next30DayTotalSubscriptionsRevenue *json.Number `json:"next30DayTotalSubscriptionsRevenue,omitempty"`
+	// The forecasted total subscription revenue for the next 60 days.
+	Next60DayTotalSubscriptionsRevenue *json.Number `json:"next60DayTotalSubscriptionsRevenue,omitempty"`
+	// The forecasted total subscription revenue for the next 90 days.
+	Next90DayTotalSubscriptionsRevenue *json.Number `json:"next90DayTotalSubscriptionsRevenue,omitempty"`
+	// The forecasted shipped subscription units for the next 30 days.
+	Next30DayShippedSubscriptionUnits *int64 `json:"next30DayShippedSubscriptionUnits,omitempty"`
+	// The forecasted shipped subscription units for the next 60 days.
+	Next60DayShippedSubscriptionUnits *int64 `json:"next60DayShippedSubscriptionUnits,omitempty"`
+	// The forecasted shipped subscription units for the next 90 days.
+	Next90DayShippedSubscriptionUnits *int64                `json:"next90DayShippedSubscriptionUnits,omitempty"`
+	TimeInterval                      *ResponseTimeInterval `json:"timeInterval,omitempty"`
+	// The currency code in ISO 4217 format.
+	CurrencyCode *string `json:"currencyCode,omitempty"`
+}
+
+// ListOfferMetricsResponse The response schema for the listOfferMetrics operation.
+type ListOfferMetricsResponse struct {
+	// A list of offer metrics.
+	Offers     []OfferMetrics      `json:"offers,omitempty"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+}
+
+// ListOffersRequestSort Use these parameters to sort the response.
+type ListOffersRequestSort struct {
+	Order SortOrder `json:"order"`
+	// The attribute to use to sort the results, e.g. ASIN, SELLING_PARTNER_FUNDED_BASE_DISCOUNT or ELIGIBILITY.
+	Key string `json:"key"`
+}
+
+// ListOffersRequestFilters Use these parameters to filter results. Any result must match all of the provided parameters.
+type ListOffersRequestFilters struct {
+	MarketplaceID constants.MarketplaceID `json:"marketplaceId"`
+	// A list of SKUs to filter. This filter is only supported for sellers and not for vendors.
+	Skus []string `json:"skus,omitempty"`
+	// A list of Amazon Standard Identification Numbers (ASINs).
+	Asins []string `json:"asins,omitempty"`
+	// A list of eligibilities associated with an offer.
+	Eligibilities []EligibilityStatus `json:"eligibilities,omitempty"`
+	// A list of replenishment program types.
+	ProgramTypes []ProgramType `json:"programTypes"`
+}
+
+// ListOffersRequest The request body for the listOffers operation.
+type ListOffersRequest struct {
+	Pagination PaginationRequest        `json:"pagination"`
+	Filters    ListOffersRequestFilters `json:"filters"`
+	Sort       *ListOffersRequestSort   `json:"sort,omitempty"`
+}
+
+// DiscountFunding The discount funding on the offer.
+type DiscountFunding struct {
+	// Filters the results to only include offers with the percentage specified.
+	Percentage []int64 `json:"percentage,omitempty"`
+}
+
+// OfferProgramConfigurationPreferences An object which contains the preferences applied to the offer.
+type OfferProgramConfigurationPreferences struct {
+	// The auto-enrollment preference indicates whether the offer is opted-in to be automatically enrolled in
+	// any new promotions. Possible values: OPTED_IN, OPTED_OUT.
+	AutoEnrollment *string `json:"autoEnrollment,omitempty"`
+}
+
+// OfferProgramConfigurationPromotions An object which represents all promotions applied to an offer.
+type OfferProgramConfigurationPromotions struct {
+	SellingPartnerFundedBaseDiscount   *DiscountFunding `json:"sellingPartnerFundedBaseDiscount,omitempty"`
+	SellingPartnerFundedTieredDiscount *DiscountFunding `json:"sellingPartnerFundedTieredDiscount,omitempty"`
+	AmazonFundedBaseDiscount           *DiscountFunding `json:"amazonFundedBaseDiscount,omitempty"`
+	AmazonFundedTieredDiscount         *DiscountFunding `json:"amazonFundedTieredDiscount,omitempty"`
+}
+
+// OfferProgramConfiguration The offer program configuration contains a set of program properties for an offer.
+type OfferProgramConfiguration struct {
+	Preferences *OfferProgramConfigurationPreferences `json:"preferences,omitempty"`
+	Promotions  *OfferProgramConfigurationPromotions  `json:"promotions,omitempty"`
+	// Determines whether the offer was automatically or manually enrolled in the program. Possible values: AUTOMATIC, MANUAL.
+	EnrollmentMethod *string `json:"enrollmentMethod,omitempty"`
+}
+
+// Offer An object which contains details about an offer.
+type Offer struct {
+	// The SKU. This property is only supported for sellers and not for vendors.
+	Sku *string `json:"sku,omitempty"`
+	// The Amazon Standard Identification Number (ASIN).
+	Asin *string `json:"asin,omitempty"`
+	// The marketplace ID.
+	MarketplaceID *constants.MarketplaceID `json:"marketplaceId,omitempty"`
+	// The fulfillment channel type.
+	FulfillmentChannelType *FulfillmentChannelType `json:"fulfillmentChannelType,omitempty"`
+	// The replenishment program type.
+	ProgramType *ProgramType `json:"programType,omitempty"`
+	// The current eligibility status of an offer.
+	Eligibility               *EligibilityStatus         `json:"eligibility,omitempty"`
+	OfferProgramConfiguration *OfferProgramConfiguration `json:"offerProgramConfiguration,omitempty"`
+}
+
+// ListOffersResponse The response schema for the listOffers operation.
+type ListOffersResponse struct {
+	// A list of offers and associated program properties.
+	Offers     []Offer             `json:"offers,omitempty"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+}
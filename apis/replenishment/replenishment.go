@@ -0,0 +1,75 @@
+package replenishment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/replenishment/2022-11-07"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.ReplenishmentClient for tests.
+type Client interface {
+	GetSellingPartnerMetrics(ctx context.Context, request *GetSellingPartnerMetricsRequest) (*apis.CallResponse[GetSellingPartnerMetricsResponse], error)
+	ListOfferMetrics(ctx context.Context, request *ListOfferMetricsRequest) (*apis.CallResponse[ListOfferMetricsResponse], error)
+	ListOffers(ctx context.Context, request *ListOffersRequest) (*apis.CallResponse[ListOffersResponse], error)
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// GetSellingPartnerMetrics returns aggregated replenishment program metrics for a selling partner.
+func (a *API) GetSellingPartnerMetrics(ctx context.Context, request *GetSellingPartnerMetricsRequest) (*apis.CallResponse[GetSellingPartnerMetricsResponse], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[GetSellingPartnerMetricsResponse](http.MethodPost, pathPrefix+"/sellingPartners/metrics/search").
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// ListOfferMetrics returns aggregated replenishment program metrics for a selling partner's offers.
+func (a *API) ListOfferMetrics(ctx context.Context, request *ListOfferMetricsRequest) (*apis.CallResponse[ListOfferMetricsResponse], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[ListOfferMetricsResponse](http.MethodPost, pathPrefix+"/offers/metrics/search").
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// ListOffers returns the details of a selling partner's replenishment program offers.
+// Note that this operation only supports sellers at this time.
+func (a *API) ListOffers(ctx context.Context, request *ListOffersRequest) (*apis.CallResponse[ListOffersResponse], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[ListOffersResponse](http.MethodPost, pathPrefix+"/offers/search").
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
@@ -0,0 +1,104 @@
+package reports
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+// BrowseNode is a <Node> element of the browse tree report (BrowseTreeReport).
+type BrowseNode struct {
+	BrowseNodeID               string                 `xml:"browseNodeId"`
+	BrowseNodeName             string                 `xml:"browseNodeName"`
+	BrowseNodeStoreContextName string                 `xml:"browseNodeStoreContextName"`
+	BrowseNodeAttributes       []BrowseNodeAttribute  `xml:"browseNodeAttributes>attribute"`
+	BrowsePathByID             string                 `xml:"browsePathById"`
+	BrowsePathByName           string                 `xml:"browsePathByName"`
+	HasChildren                bool                   `xml:"hasChildren"`
+	ChildNodeIDs               []string               `xml:"childNodes>id"`
+	ProductTypeDefinitions     []string               `xml:"productTypeDefinitions>productTypeDefinition"`
+	Refinements                []BrowseNodeRefinement `xml:"refinementsInformation>refinementName"`
+}
+
+// BrowseNodeAttribute is a named attribute of a browse node, e.g. the item_type_keyword.
+type BrowseNodeAttribute struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:",chardata"`
+}
+
+// BrowseNodeRefinement is a refinement customers can use to narrow down the products of a browse node.
+type BrowseNodeRefinement struct {
+	Name   string                      `xml:"refinementName"`
+	Fields []BrowseNodeRefinementField `xml:"refinementField"`
+}
+
+// BrowseNodeRefinementField describes the product attribute behind a refinement.
+type BrowseNodeRefinementField struct {
+	AcceptedValues         string `xml:"acceptedValues"`
+	HasModifier            bool   `xml:"hasModifier"`
+	Modifiers              string `xml:"modifiers"`
+	ProductTypeDefinitions string `xml:"productTypeDefinitions"`
+	RefinementAttribute    string `xml:"refinementAttribute"`
+}
+
+// PathIDs returns the browse node IDs from the root to this node.
+func (n *BrowseNode) PathIDs() []string {
+	if n.BrowsePathByID == "" {
+		return nil
+	}
+	return strings.Split(n.BrowsePathByID, ",")
+}
+
+// IsRoot reports whether the node is the root of its browse tree.
+func (n *BrowseNode) IsRoot() bool {
+	return !strings.Contains(n.BrowsePathByID, ",")
+}
+
+// BrowseTreeDecoder streams the nodes of a browse tree report one <Node> element at a time.
+// Browse tree reports can be very large, so prefer it over ParseBrowseTreeReport.
+type BrowseTreeDecoder struct {
+	dec *xml.Decoder
+}
+
+// NewBrowseTreeDecoder returns a BrowseTreeDecoder reading from r.
+func NewBrowseTreeDecoder(r io.Reader) *BrowseTreeDecoder {
+	return &BrowseTreeDecoder{dec: xml.NewDecoder(r)}
+}
+
+// Next decodes the next browse node. It returns io.EOF when there are no more nodes.
+func (d *BrowseTreeDecoder) Next() (*BrowseNode, error) {
+	for {
+		token, err := d.dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "Node" {
+			continue
+		}
+
+		node := &BrowseNode{}
+		if err = d.dec.DecodeElement(node, &start); err != nil {
+			return nil, err
+		}
+		return node, nil
+	}
+}
+
+// ParseBrowseTreeReport decodes all nodes of a browse tree report.
+func ParseBrowseTreeReport(r io.Reader) ([]BrowseNode, error) {
+	dec := NewBrowseTreeDecoder(r)
+	var nodes []BrowseNode
+	for {
+		node, err := dec.Next()
+		if errors.Is(err, io.EOF) {
+			return nodes, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, *node)
+	}
+}
@@ -0,0 +1,72 @@
+package reports
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBrowseTreeReport(t *testing.T) {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<Result>
+  <Node>
+    <browseNodeId>340843031</browseNodeId>
+    <browseNodeAttributes count="0"/>
+    <browseNodeName>Lebensmittel &amp; Getränke</browseNodeName>
+    <browseNodeStoreContextName>Lebensmittel &amp; Getränke</browseNodeStoreContextName>
+    <browsePathById>340843031</browsePathById>
+    <browsePathByName>Lebensmittel &amp; Getränke</browsePathByName>
+    <hasChildren>true</hasChildren>
+    <childNodes count="2">
+      <id>358556031</id>
+      <id>364600031</id>
+    </childNodes>
+    <productTypeDefinitions count="0"/>
+    <refinementsInformation count="0"/>
+  </Node>
+  <Node>
+    <browseNodeId>358556031</browseNodeId>
+    <browseNodeAttributes count="1">
+      <attribute name="item_type_keyword">coffee</attribute>
+    </browseNodeAttributes>
+    <browseNodeName>Kaffee</browseNodeName>
+    <browsePathById>340843031,358556031</browsePathById>
+    <browsePathByName>Lebensmittel &amp; Getränke,Kaffee</browsePathByName>
+    <hasChildren>false</hasChildren>
+    <childNodes count="0"/>
+    <productTypeDefinitions count="1">
+      <productTypeDefinition>COFFEE</productTypeDefinition>
+    </productTypeDefinitions>
+    <refinementsInformation count="1">
+      <refinementName>
+        <refinementName>Marke</refinementName>
+        <refinementField>
+          <acceptedValues>Brand name</acceptedValues>
+          <hasModifier>false</hasModifier>
+          <productTypeDefinitions>COFFEE</productTypeDefinitions>
+          <refinementAttribute>brand</refinementAttribute>
+        </refinementField>
+      </refinementName>
+    </refinementsInformation>
+  </Node>
+</Result>`
+
+	nodes, err := ParseBrowseTreeReport(strings.NewReader(doc))
+	assert.NoError(t, err)
+	assert.Len(t, nodes, 2)
+
+	root := nodes[0]
+	assert.Equal(t, "Lebensmittel & Getränke", root.BrowseNodeName)
+	assert.True(t, root.IsRoot())
+	assert.True(t, root.HasChildren)
+	assert.Equal(t, []string{"358556031", "364600031"}, root.ChildNodeIDs)
+
+	coffee := nodes[1]
+	assert.False(t, coffee.IsRoot())
+	assert.Equal(t, []string{"340843031", "358556031"}, coffee.PathIDs())
+	assert.Equal(t, []BrowseNodeAttribute{{Name: "item_type_keyword", Value: "coffee"}}, coffee.BrowseNodeAttributes)
+	assert.Equal(t, []string{"COFFEE"}, coffee.ProductTypeDefinitions)
+	assert.Equal(t, "Marke", coffee.Refinements[0].Name)
+	assert.Equal(t, "brand", coffee.Refinements[0].Fields[0].RefinementAttribute)
+}
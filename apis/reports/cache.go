@@ -0,0 +1,93 @@
+package reports
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// ErrDocumentNotCached is returned by DocumentCache.Open if the document is not in the cache.
+var ErrDocumentNotCached = errors.New("report document is not cached")
+
+// DocumentCache stores decompressed report documents keyed by their reportDocumentID.
+type DocumentCache interface {
+	// Open returns the cached document or ErrDocumentNotCached.
+	Open(reportDocumentID string) (io.ReadCloser, error)
+	// Put stores the content read from r. Nothing must be stored if r returns an error.
+	Put(reportDocumentID string, r io.Reader) error
+}
+
+// FileDocumentCache is a DocumentCache storing every document as file in Dir.
+type FileDocumentCache struct {
+	Dir string
+}
+
+// NewFileDocumentCache returns a FileDocumentCache storing the documents in dir, which is created if necessary.
+func NewFileDocumentCache(dir string) (*FileDocumentCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileDocumentCache{Dir: dir}, nil
+}
+
+func (c *FileDocumentCache) Open(reportDocumentID string) (io.ReadCloser, error) {
+	f, err := os.Open(c.path(reportDocumentID))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrDocumentNotCached
+	}
+	return f, err
+}
+
+// Put writes the document to a temporary file first, so that concurrent readers never see partial documents.
+func (c *FileDocumentCache) Put(reportDocumentID string, r io.Reader) error {
+	tmp, err := os.CreateTemp(c.Dir, ".download-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = io.Copy(tmp, r); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), c.path(reportDocumentID))
+}
+
+func (c *FileDocumentCache) path(reportDocumentID string) string {
+	return filepath.Join(c.Dir, url.PathEscape(reportDocumentID))
+}
+
+// WithDocumentCache returns a copy of the API that serves DownloadReportDocument and WriteReportDocument
+// from the given cache and stores newly downloaded documents in it. Cached documents are stored after
+// decompression and charset conversion, the options of later calls don't apply to cache hits.
+func (r *API) WithDocumentCache(cache DocumentCache) *API {
+	cp := *r
+	cp.documentCache = cache
+	return &cp
+}
+
+func (r *API) openCachedReportDocument(reportDocumentID string, download func() (io.ReadCloser, error)) (io.ReadCloser, error) {
+	cached, err := r.documentCache.Open(reportDocumentID)
+	if !errors.Is(err, ErrDocumentNotCached) {
+		return cached, err
+	}
+
+	doc, err := download()
+	if err != nil {
+		return nil, err
+	}
+	err = r.documentCache.Put(reportDocumentID, doc)
+	if closeErr := doc.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("caching report document %s failed: %w", reportDocumentID, err)
+	}
+	return r.documentCache.Open(reportDocumentID)
+}
@@ -0,0 +1,32 @@
+package reports
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileDocumentCache(t *testing.T) {
+	cache, err := NewFileDocumentCache(t.TempDir())
+	assert.NoError(t, err)
+
+	_, err = cache.Open("amzn1.spdoc.1.4.eu.1234")
+	assert.True(t, errors.Is(err, ErrDocumentNotCached))
+
+	assert.NoError(t, cache.Put("amzn1.spdoc.1.4.eu.1234", strings.NewReader("sku\tquantity\n")))
+	doc, err := cache.Open("amzn1.spdoc.1.4.eu.1234")
+	assert.NoError(t, err)
+	content, err := io.ReadAll(doc)
+	assert.NoError(t, err)
+	assert.NoError(t, doc.Close())
+	assert.Equal(t, "sku\tquantity\n", string(content))
+
+	err = cache.Put("amzn1.spdoc.1.4.eu.5678", iotest.ErrReader(errors.New("connection reset")))
+	assert.Error(t, err)
+	_, err = cache.Open("amzn1.spdoc.1.4.eu.5678")
+	assert.True(t, errors.Is(err, ErrDocumentNotCached), "failed downloads must not be cached")
+}
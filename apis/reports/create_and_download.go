@@ -0,0 +1,86 @@
+package reports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+var (
+	// ErrReportCancelled is returned when a report was cancelled, either by the caller or by Amazon
+	// because there was no data to report.
+	ErrReportCancelled = errors.New("report was cancelled")
+	// ErrReportFatal is returned when the report processing was aborted due to a fatal error.
+	ErrReportFatal = errors.New("report processing failed")
+)
+
+// WaitForReport polls GetReport every constants.DefaultReportPollInterval until the report
+// reached a terminal processing status and returns the final report details.
+func (r *API) WaitForReport(ctx context.Context, reportID string) (*ReportModel, error) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		resp, err := r.GetReport(ctx, reportID)
+		if err != nil {
+			return nil, err
+		}
+
+		report := &resp.ResponseBody.ReportModel
+		switch report.ProcessingStatus {
+		case constants.Done:
+			return report, nil
+		case constants.Cancelled:
+			return report, fmt.Errorf("report %s: %w", reportID, ErrReportCancelled)
+		case constants.Fatal:
+			return report, fmt.Errorf("report %s: %w", reportID, ErrReportFatal)
+		}
+		timer.Reset(constants.DefaultReportPollInterval)
+	}
+}
+
+// CreateAndDownloadReport creates a report, waits until it is done and returns the decompressed report document.
+// Documents of restricted report types are fetched with a restrictedDataToken.
+func (r *API) CreateAndDownloadReport(ctx context.Context, specification *CreateReportSpecification, opts ...apis.DocumentOption) ([]byte, error) {
+	report, err := r.createAndWaitForReport(ctx, specification)
+	if err != nil {
+		return nil, err
+	}
+	return r.DownloadReport(ctx, report, opts...)
+}
+
+// CreateAndWriteReport creates a report, waits until it is done and streams the decompressed report document to w.
+func (r *API) CreateAndWriteReport(ctx context.Context, specification *CreateReportSpecification, w io.Writer, opts ...apis.DocumentOption) error {
+	report, err := r.createAndWaitForReport(ctx, specification)
+	if err != nil {
+		return err
+	}
+	return r.WriteReport(ctx, report, w, opts...)
+}
+
+func (r *API) createAndWaitForReport(ctx context.Context, specification *CreateReportSpecification) (*ReportModel, error) {
+	resp, err := r.CreateReport(ctx, specification)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := r.WaitForReport(ctx, resp.ResponseBody.ReportID)
+	if err != nil {
+		return nil, err
+	}
+	if report.ReportDocumentID == nil {
+		return nil, fmt.Errorf("report %s is done but has no reportDocumentId", report.ReportID)
+	}
+	return report, nil
+}
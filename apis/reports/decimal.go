@@ -0,0 +1,85 @@
+package reports
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Decimal is an exact decimal number as found in the amount columns of reports. It keeps the
+// normalized textual representation to avoid floating point rounding errors.
+type Decimal struct {
+	value string
+}
+
+// ParseDecimal parses a decimal number with either '.' or ',' as decimal separator. If both
+// characters are present, the last one is treated as decimal separator and the other as thousands
+// separator. A single ',' is always treated as decimal separator, since Amazon reports don't use
+// thousands separators without decimals.
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Decimal{}, nil
+	}
+
+	lastDot, lastComma := strings.LastIndex(s, "."), strings.LastIndex(s, ",")
+	switch {
+	case lastComma > lastDot:
+		s = strings.ReplaceAll(s, ".", "")
+		s = strings.Replace(s, ",", ".", 1)
+	case lastDot > lastComma:
+		s = strings.ReplaceAll(s, ",", "")
+	}
+
+	if _, ok := new(big.Rat).SetString(s); !ok || strings.ContainsAny(s, "/eE") {
+		return Decimal{}, fmt.Errorf("cannot parse %q as decimal", s)
+	}
+	return Decimal{value: s}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics on invalid input. Use it for constants in tests.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// String returns the decimal with '.' as decimal separator, or "0" for the zero value.
+func (d Decimal) String() string {
+	if d.value == "" {
+		return "0"
+	}
+	return d.value
+}
+
+// IsZero reports whether the decimal is zero.
+func (d Decimal) IsZero() bool {
+	return d.Rat().Sign() == 0
+}
+
+// Rat returns the exact value as big.Rat, e.g. to sum up amounts.
+func (d Decimal) Rat() *big.Rat {
+	r, _ := new(big.Rat).SetString(d.String())
+	return r
+}
+
+// Float64 returns the nearest float64 value.
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,133 @@
+package reports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/tokens"
+)
+
+// CompressionAlgorithmGZIP is the only compressionAlgorithm Amazon uses for report documents.
+const CompressionAlgorithmGZIP = "GZIP"
+
+// DownloadReportDocument fetches the report document with the given reportDocumentID and returns
+// its decompressed content, converted to UTF-8 unless apis.WithoutCharsetConversion is passed.
+// Pass apis.WithReportType for documents of restricted report types to attach a restrictedDataToken.
+func (r *API) DownloadReportDocument(ctx context.Context, reportDocumentID string, opts ...apis.DocumentOption) ([]byte, error) {
+	doc, err := r.openReportDocumentByID(ctx, reportDocumentID, "", opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer doc.Close()
+
+	return io.ReadAll(doc)
+}
+
+// DownloadReport returns the decompressed document of a done report. For restricted report types
+// a restrictedDataToken is created via the Tokens API and attached automatically. The whole document
+// is held in memory, use WriteReport to stream large reports.
+func (r *API) DownloadReport(ctx context.Context, report *ReportModel, opts ...apis.DocumentOption) ([]byte, error) {
+	if report.ReportDocumentID == nil {
+		return nil, fmt.Errorf("report %s has no reportDocumentId", report.ReportID)
+	}
+	doc, err := r.openReportDocumentByID(ctx, *report.ReportDocumentID, report.ReportType, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer doc.Close()
+
+	return io.ReadAll(doc)
+}
+
+// WriteReport streams the decompressed document of a done report to w. For restricted report types
+// a restrictedDataToken is created via the Tokens API and attached automatically.
+func (r *API) WriteReport(ctx context.Context, report *ReportModel, w io.Writer, opts ...apis.DocumentOption) (err error) {
+	if report.ReportDocumentID == nil {
+		return fmt.Errorf("report %s has no reportDocumentId", report.ReportID)
+	}
+	doc, err := r.openReportDocumentByID(ctx, *report.ReportDocumentID, report.ReportType, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, doc.Close())
+	}()
+
+	_, err = io.Copy(w, doc)
+	return err
+}
+
+// WriteReportDocument fetches the report document with the given reportDocumentID and streams
+// its decompressed content to w. Pass apis.WithReportType for documents of restricted report types
+// to attach a restrictedDataToken.
+func (r *API) WriteReportDocument(ctx context.Context, reportDocumentID string, w io.Writer, opts ...apis.DocumentOption) (err error) {
+	doc, err := r.openReportDocumentByID(ctx, reportDocumentID, "", opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, doc.Close())
+	}()
+
+	_, err = io.Copy(w, doc)
+	return err
+}
+
+func (r *API) openReportDocumentByID(ctx context.Context, reportDocumentID string, reportType Type, opts ...apis.DocumentOption) (io.ReadCloser, error) {
+	if reportType == "" {
+		reportType = Type(apis.DocumentReportType(opts...))
+	}
+	download := func() (io.ReadCloser, error) {
+		var restrictedDataToken *string
+		if reportType.IsRestricted() {
+			token, err := r.createDocumentRestrictedDataToken(ctx, reportDocumentID)
+			if err != nil {
+				return nil, err
+			}
+			restrictedDataToken = token
+		}
+
+		resp, err := r.GetReportDocument(ctx, reportDocumentID, restrictedDataToken)
+		if err != nil {
+			return nil, err
+		}
+		if resp.ResponseBody == nil {
+			return nil, fmt.Errorf("no url returned for report document %s", reportDocumentID)
+		}
+		return r.openReportDocument(ctx, &resp.ResponseBody.ReportDocument, opts...)
+	}
+
+	if r.documentCache != nil {
+		return r.openCachedReportDocument(reportDocumentID, download)
+	}
+	return download()
+}
+
+func (r *API) openReportDocument(ctx context.Context, document *ReportDocument, opts ...apis.DocumentOption) (io.ReadCloser, error) {
+	if document.CompressionAlgorithm != nil && *document.CompressionAlgorithm != CompressionAlgorithmGZIP {
+		return nil, fmt.Errorf("compressionAlgorithm %s is not supported", *document.CompressionAlgorithm)
+	}
+	return apis.OpenDocument(ctx, r.httpClient, document.Url, opts...)
+}
+
+func (r *API) createDocumentRestrictedDataToken(ctx context.Context, reportDocumentID string) (*string, error) {
+	resp, err := r.tokensAPI.CreateRestrictedDataTokenRequest(ctx, &tokens.CreateRestrictedDataTokenRequest{
+		RestrictedResources: []tokens.RestrictedResource{
+			{
+				Method: http.MethodGet,
+				Path:   pathPrefix + "/documents/" + reportDocumentID,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating restrictedDataToken for report document %s failed: %w", reportDocumentID, err)
+	}
+	if resp.ResponseBody == nil || resp.ResponseBody.RestrictedDataToken == nil {
+		return nil, fmt.Errorf("no restrictedDataToken returned for report document %s", reportDocumentID)
+	}
+	return resp.ResponseBody.RestrictedDataToken, nil
+}
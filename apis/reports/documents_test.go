@@ -0,0 +1,50 @@
+package reports
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPI_DownloadReportDocument(t *testing.T) {
+	const documentID = "amzn1.spdoc.1.4.eu.1234"
+	tests := []struct {
+		name      string
+		opts      []apis.DocumentOption
+		wantToken string
+	}{
+		{name: "Unrestricted", wantToken: "ACCESS-TOKEN"},
+		{name: "Restricted report type", opts: []apis.DocumentOption{apis.WithReportType(string(FBAAmazonFulfilledShipmentsReport))}, wantToken: "RESTRICTED-TOKEN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var documentToken string
+			mux := http.NewServeMux()
+			srv := httptest.NewServer(mux)
+			defer srv.Close()
+			mux.HandleFunc("/tokens/2021-03-01/restrictedDataToken", func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(map[string]any{"restrictedDataToken": "RESTRICTED-TOKEN", "expiresIn": 3600})
+			})
+			mux.HandleFunc(pathPrefix+"/documents/"+documentID, func(w http.ResponseWriter, r *http.Request) {
+				documentToken = r.Header.Get(constants.AccessTokenHeader)
+				_ = json.NewEncoder(w).Encode(ReportDocument{ReportDocumentID: documentID, Url: srv.URL + "/document"})
+			})
+			mux.HandleFunc("/document", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte("sku\tquantity\n"))
+			})
+
+			content, err := NewAPI(newTestClient(t, srv)).DownloadReportDocument(context.Background(), documentID, tt.opts...)
+
+			require.NoError(t, err)
+			assert.Equal(t, "sku\tquantity\n", string(content))
+			assert.Equal(t, tt.wantToken, documentToken)
+		})
+	}
+}
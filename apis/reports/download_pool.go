@@ -0,0 +1,63 @@
+package reports
+
+import (
+	"context"
+	"sync"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+)
+
+// DownloadResult is the outcome of downloading the document of a report with DownloadReports.
+type DownloadResult struct {
+	Report *ReportModel
+	// Content is the decompressed document, nil if Err is set.
+	Content []byte
+	Err     error
+}
+
+// DownloadReports downloads the documents of the done reports with up to parallelism downloads at a
+// time and sends the results to the returned channel as they complete, e.g. for nightly backfills of
+// hundreds of reports. The channel is closed after the last result. Once ctx is done, the reports not
+// downloaded yet are skipped, so read the channel until it is closed or cancel ctx.
+//
+// The getReportDocument calls are rate limited like all calls, the downloads from the presigned
+// URLs aren't. Set sp_api.Config.MaxIdleConnsPerHost to at least parallelism, so that the downloads
+// reuse their connections.
+func (r *API) DownloadReports(ctx context.Context, reports []ReportModel, parallelism int, opts ...apis.DocumentOption) <-chan DownloadResult {
+	parallelism = max(1, min(parallelism, len(reports)))
+	queue := make(chan *ReportModel)
+	results := make(chan DownloadResult)
+
+	go func() {
+		defer close(queue)
+		for i := range reports {
+			select {
+			case queue <- &reports[i]:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var workers sync.WaitGroup
+	workers.Add(parallelism)
+	for i := 0; i < parallelism; i++ {
+		go func() {
+			defer workers.Done()
+			for report := range queue {
+				content, err := r.DownloadReport(ctx, report, opts...)
+				select {
+				case results <- DownloadResult{Report: report, Content: content, Err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		workers.Wait()
+		close(results)
+	}()
+	return results
+}
@@ -0,0 +1,46 @@
+package reports
+
+import "io"
+
+// EstimatedFeesRow is a row of the FBAFeePreviewReport (GET_FBA_ESTIMATED_FBA_FEES_TXT_DATA) report.
+// All money fields are exact decimals in the row's Currency. They are nil if the cell is empty or "--",
+// which the report writes for values that are not available, so that a missing fee can't be mistaken
+// for a fee of 0.00.
+type EstimatedFeesRow struct {
+	SKU                               string   `tsv:"sku"`
+	FNSKU                             string   `tsv:"fnsku"`
+	ASIN                              string   `tsv:"asin"`
+	AmazonStore                       string   `tsv:"amazon-store"`
+	ProductName                       string   `tsv:"product-name"`
+	ProductGroup                      string   `tsv:"product-group"`
+	Brand                             string   `tsv:"brand"`
+	FulfilledBy                       string   `tsv:"fulfilled-by"`
+	YourPrice                         *Decimal `tsv:"your-price"`
+	SalesPrice                        *Decimal `tsv:"sales-price"`
+	LongestSide                       *float64 `tsv:"longest-side"`
+	MedianSide                        *float64 `tsv:"median-side"`
+	ShortestSide                      *float64 `tsv:"shortest-side"`
+	LengthAndGirth                    *float64 `tsv:"length-and-girth"`
+	UnitOfDimension                   string   `tsv:"unit-of-dimension"`
+	ItemPackageWeight                 *float64 `tsv:"item-package-weight"`
+	UnitOfWeight                      string   `tsv:"unit-of-weight"`
+	ProductSizeTier                   string   `tsv:"product-size-tier"`
+	Currency                          string   `tsv:"currency"`
+	EstimatedFeeTotal                 *Decimal `tsv:"estimated-fee-total"`
+	EstimatedReferralFeePerUnit       *Decimal `tsv:"estimated-referral-fee-per-unit"`
+	EstimatedVariableClosingFee       *Decimal `tsv:"estimated-variable-closing-fee"`
+	EstimatedOrderHandlingFeePerOrder *Decimal `tsv:"estimated-order-handling-fee-per-order"`
+	EstimatedPickPackFeePerUnit       *Decimal `tsv:"estimated-pick-pack-fee-per-unit"`
+	EstimatedWeightHandlingFeePerUnit *Decimal `tsv:"estimated-weight-handling-fee-per-unit"`
+	ExpectedFulfillmentFeePerUnit     *Decimal `tsv:"expected-fulfillment-fee-per-unit"`
+}
+
+// NewEstimatedFeesDecoder returns a decoder streaming the rows of a FBA estimated fees report.
+func NewEstimatedFeesDecoder(r io.Reader) *TSVDecoder[EstimatedFeesRow] {
+	return NewTSVDecoder[EstimatedFeesRow](r)
+}
+
+// ParseEstimatedFeesReport decodes all rows of a FBA estimated fees report.
+func ParseEstimatedFeesReport(r io.Reader) ([]EstimatedFeesRow, error) {
+	return NewEstimatedFeesDecoder(r).ReadAll()
+}
@@ -0,0 +1,36 @@
+package reports
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEstimatedFeesReport(t *testing.T) {
+	doc := "sku\tfnsku\tasin\tamazon-store\tproduct-name\tproduct-group\tbrand\tfulfilled-by\tyour-price\tsales-price\tlongest-side\tmedian-side\tshortest-side\tlength-and-girth\tunit-of-dimension\titem-package-weight\tunit-of-weight\tproduct-size-tier\tcurrency\testimated-fee-total\testimated-referral-fee-per-unit\testimated-variable-closing-fee\testimated-order-handling-fee-per-order\testimated-pick-pack-fee-per-unit\testimated-weight-handling-fee-per-unit\texpected-fulfillment-fee-per-unit\n" +
+		"SKU-1\tX000001\tB000000001\tDE\tProduct\tHome\tBrand\tAmazon\t19.99\t\t30.5\t20\t10\t90.5\tcentimeters\t0.45\tkilograms\tStandard\tEUR\t6.32\t3.00\t0.00\t\t\t\t3.32\n" +
+		"SKU-2\tX000002\tB000000002\tDE\tGift card\tHome\tBrand\tAmazon\t25.00\t--\t--\t--\t--\t--\t--\t--\t--\t--\tEUR\t--\t--\t--\t--\t--\t--\t--\n"
+
+	rows, err := ParseEstimatedFeesReport(strings.NewReader(doc))
+
+	assert.NoError(t, err)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, "19.99", rows[0].YourPrice.String())
+	assert.Nil(t, rows[0].SalesPrice)
+	assert.True(t, rows[0].EstimatedVariableClosingFee.IsZero(), "a fee of 0.00 is not missing")
+	assert.Equal(t, "EUR", rows[0].Currency)
+	assert.Equal(t, 30.5, *rows[0].LongestSide)
+
+	total := rows[0].EstimatedReferralFeePerUnit.Rat()
+	total.Add(total, rows[0].ExpectedFulfillmentFeePerUnit.Rat())
+	assert.Equal(t, 0, total.Cmp(rows[0].EstimatedFeeTotal.Rat()))
+
+	// "--" marks values that are not available
+	assert.Equal(t, "25.00", rows[1].YourPrice.String())
+	assert.Nil(t, rows[1].SalesPrice)
+	assert.Nil(t, rows[1].EstimatedFeeTotal)
+	assert.Nil(t, rows[1].ExpectedFulfillmentFeePerUnit)
+	assert.Nil(t, rows[1].LongestSide)
+	assert.Nil(t, rows[1].ItemPackageWeight)
+}
@@ -0,0 +1,128 @@
+package reports
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// FlatFileReader streams the rows of a tab-delimited flat-file report line by line, so that reports
+// of several GB like inventory ledgers can be processed with constant memory. Unlike TSVDecoder it
+// doesn't interpret quotes, so every line is one row and Offset can be used to resume an interrupted
+// run with ResumeFlatFileReader:
+//
+//	rows := reports.NewFlatFileReader(file)
+//	for {
+//		row, err := rows.Next()
+//		if errors.Is(err, io.EOF) {
+//			break
+//		}
+//		...
+//		checkpoint(rows.Offset())
+//	}
+type FlatFileReader struct {
+	r      *bufio.Reader
+	header []string
+	offset int64
+}
+
+// NewFlatFileReader returns a FlatFileReader reading from r. The first line must contain the column names.
+func NewFlatFileReader(r io.Reader) *FlatFileReader {
+	return &FlatFileReader{r: bufio.NewReader(r)}
+}
+
+// ResumeFlatFileReader returns a FlatFileReader continuing at offset, a value of Offset of an earlier
+// reader of the same content, e.g. a report document saved with WriteReport. The header is read from
+// the start of r first.
+func ResumeFlatFileReader(r io.ReadSeeker, offset int64) (*FlatFileReader, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	f := NewFlatFileReader(r)
+	if err := f.readHeader(); err != nil {
+		return nil, err
+	}
+	if offset <= f.offset {
+		return f, nil
+	}
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	f.r.Reset(r)
+	f.offset = offset
+	return f, nil
+}
+
+// Header returns the column names of the report. It is available after the first call of Next.
+func (f *FlatFileReader) Header() []string {
+	return f.header
+}
+
+// Offset returns the byte offset after the last row returned by Next.
+func (f *FlatFileReader) Offset() int64 {
+	return f.offset
+}
+
+// Next returns the cells of the next row. Empty lines are skipped. It returns io.EOF when there are
+// no more rows.
+func (f *FlatFileReader) Next() ([]string, error) {
+	if f.header == nil {
+		if err := f.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+	for {
+		line, err := f.readLine()
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(line) != "" {
+			return strings.Split(line, "\t"), nil
+		}
+	}
+}
+
+// NextMap returns the next row as map of column name to cell. Cells without a column are dropped.
+func (f *FlatFileReader) NextMap() (map[string]string, error) {
+	row, err := f.Next()
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(f.header))
+	for i, name := range f.header {
+		if i < len(row) {
+			values[name] = row[i]
+		}
+	}
+	return values, nil
+}
+
+func (f *FlatFileReader) readHeader() error {
+	line, err := f.readLine()
+	if errors.Is(err, io.EOF) {
+		return fmt.Errorf("flat file has no header: %w", io.EOF)
+	}
+	if err != nil {
+		return err
+	}
+	f.header = strings.Split(strings.TrimPrefix(line, "\uFEFF"), "\t")
+	for i, name := range f.header {
+		f.header[i] = strings.TrimSpace(name)
+	}
+	return nil
+}
+
+// readLine returns the next line without its line break. The last line may end without one.
+func (f *FlatFileReader) readLine() (string, error) {
+	line, err := f.r.ReadBytes('\n')
+	if err != nil && (!errors.Is(err, io.EOF) || len(line) == 0) {
+		return "", err
+	}
+	f.offset += int64(len(line))
+	line = bytes.TrimSuffix(line, []byte("\n"))
+	line = bytes.TrimSuffix(line, []byte("\r"))
+	return string(line), nil
+}
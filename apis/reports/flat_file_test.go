@@ -0,0 +1,60 @@
+package reports
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlatFileReader_Next(t *testing.T) {
+	doc := "\uFEFFseller-sku\tquantity\r\nSKU-1\t3\r\n\r\nSKU-2\t0"
+	rows := NewFlatFileReader(strings.NewReader(doc))
+
+	first, err := rows.Next()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"SKU-1", "3"}, first)
+	assert.Equal(t, []string{"seller-sku", "quantity"}, rows.Header())
+
+	second, err := rows.NextMap()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"seller-sku": "SKU-2", "quantity": "0"}, second)
+	assert.Equal(t, int64(len(doc)), rows.Offset())
+
+	_, err = rows.Next()
+	assert.True(t, errors.Is(err, io.EOF))
+	_, err = NewFlatFileReader(strings.NewReader("")).Next()
+	assert.True(t, errors.Is(err, io.EOF))
+}
+
+func TestResumeFlatFileReader(t *testing.T) {
+	doc := "seller-sku\tquantity\nSKU-1\t3\nSKU-2\t0\nSKU-3\t7\n"
+	rows := NewFlatFileReader(strings.NewReader(doc))
+	_, err := rows.Next()
+	require.NoError(t, err)
+	checkpoint := rows.Offset()
+
+	resumed, err := ResumeFlatFileReader(strings.NewReader(doc), checkpoint)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"seller-sku", "quantity"}, resumed.Header())
+	var skus []string
+	for {
+		row, err := resumed.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		skus = append(skus, row[0])
+	}
+	assert.Equal(t, []string{"SKU-2", "SKU-3"}, skus)
+	assert.Equal(t, int64(len(doc)), resumed.Offset())
+
+	fromStart, err := ResumeFlatFileReader(strings.NewReader(doc), 0)
+	require.NoError(t, err)
+	row, err := fromStart.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "SKU-1", row[0])
+}
@@ -0,0 +1,19 @@
+package reports
+
+import (
+	"testing"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/internal/golden"
+)
+
+func TestGoldenFixtures(t *testing.T) {
+	t.Run("getReport", func(t *testing.T) {
+		golden.RoundTrip[GetReportResponse](t, "testdata/getReport.json")
+	})
+	t.Run("getReports", func(t *testing.T) {
+		golden.RoundTrip[GetReportsResponse](t, "testdata/getReports.json")
+	})
+	t.Run("getReportDocument", func(t *testing.T) {
+		golden.RoundTrip[GetReportDocumentResponse](t, "testdata/getReportDocument.json")
+	})
+}
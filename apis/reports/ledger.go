@@ -0,0 +1,74 @@
+package reports
+
+import (
+	"io"
+	"time"
+)
+
+// ledgerTimeLayouts adds the US date formats used by the inventory ledger reports.
+var ledgerTimeLayouts = append([]string{"01/02/2006", "01/2006"}, DefaultTimeLayouts...)
+
+// LedgerDetailRow is a row of the FBAInventoryLedgerReportDetailedView (GET_LEDGER_DETAIL_VIEW_DATA) report.
+type LedgerDetailRow struct {
+	Date                 time.Time  `tsv:"Date"`
+	FNSKU                string     `tsv:"FNSKU"`
+	ASIN                 string     `tsv:"ASIN"`
+	MSKU                 string     `tsv:"MSKU"`
+	Title                string     `tsv:"Title"`
+	EventType            string     `tsv:"Event Type"`
+	ReferenceID          string     `tsv:"Reference ID"`
+	Quantity             int        `tsv:"Quantity"`
+	FulfillmentCenter    string     `tsv:"Fulfillment Center"`
+	Disposition          string     `tsv:"Disposition"`
+	Reason               string     `tsv:"Reason"`
+	Country              string     `tsv:"Country"`
+	ReconciledQuantity   *int       `tsv:"Reconciled Quantity"`
+	UnreconciledQuantity *int       `tsv:"Unreconciled Quantity"`
+	DateAndTime          *time.Time `tsv:"Date and Time"`
+}
+
+// LedgerSummaryRow is a row of the FBAInventoryLedgerReportSummaryView (GET_LEDGER_SUMMARY_VIEW_DATA) report.
+// Date is the first day of the month for reports with monthly aggregation.
+type LedgerSummaryRow struct {
+	Date                       time.Time `tsv:"Date"`
+	FNSKU                      string    `tsv:"FNSKU"`
+	ASIN                       string    `tsv:"ASIN"`
+	MSKU                       string    `tsv:"MSKU"`
+	Title                      string    `tsv:"Title"`
+	Disposition                string    `tsv:"Disposition"`
+	StartingWarehouseBalance   int       `tsv:"Starting Warehouse Balance"`
+	InTransitBetweenWarehouses int       `tsv:"In Transit Between Warehouses"`
+	Receipts                   int       `tsv:"Receipts"`
+	CustomerShipments          int       `tsv:"Customer Shipments"`
+	CustomerReturns            int       `tsv:"Customer Returns"`
+	VendorReturns              int       `tsv:"Vendor Returns"`
+	WarehouseTransferInOut     int       `tsv:"Warehouse Transfer In/Out"`
+	Found                      int       `tsv:"Found"`
+	Lost                       int       `tsv:"Lost"`
+	Damaged                    int       `tsv:"Damaged"`
+	Disposed                   int       `tsv:"Disposed"`
+	OtherEvents                int       `tsv:"Other Events"`
+	EndingWarehouseBalance     int       `tsv:"Ending Warehouse Balance"`
+	UnknownEvents              int       `tsv:"Unknown Events"`
+	Location                   string    `tsv:"Location"`
+}
+
+// NewLedgerDetailDecoder returns a decoder streaming the rows of a detailed view inventory ledger report.
+func NewLedgerDetailDecoder(r io.Reader) *TSVDecoder[LedgerDetailRow] {
+	return NewTSVDecoder[LedgerDetailRow](r).WithTimeLayouts(ledgerTimeLayouts...)
+}
+
+// NewLedgerSummaryDecoder returns a decoder streaming the rows of a summary view inventory ledger report.
+func NewLedgerSummaryDecoder(r io.Reader) *TSVDecoder[LedgerSummaryRow] {
+	return NewTSVDecoder[LedgerSummaryRow](r).WithTimeLayouts(ledgerTimeLayouts...)
+}
+
+// ParseLedgerDetailReport decodes all rows of a detailed view inventory ledger report.
+func ParseLedgerDetailReport(r io.Reader) ([]LedgerDetailRow, error) {
+	return NewLedgerDetailDecoder(r).ReadAll()
+}
+
+// ParseLedgerSummaryReport decodes all rows of a summary view inventory ledger report.
+func ParseLedgerSummaryReport(r io.Reader) ([]LedgerSummaryRow, error) {
+	return NewLedgerSummaryDecoder(r).ReadAll()
+}
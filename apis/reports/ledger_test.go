@@ -0,0 +1,37 @@
+package reports
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLedgerDetailReport(t *testing.T) {
+	doc := "\"Date\"\t\"FNSKU\"\t\"ASIN\"\t\"MSKU\"\t\"Title\"\t\"Event Type\"\t\"Reference ID\"\t\"Quantity\"\t\"Fulfillment Center\"\t\"Disposition\"\t\"Reason\"\t\"Country\"\t\"Reconciled Quantity\"\t\"Unreconciled Quantity\"\t\"Date and Time\"\n" +
+		"\"01/15/2024\"\t\"X000001\"\t\"B000000001\"\t\"SKU-1\"\t\"Product\"\t\"Shipments\"\t\"S1\"\t\"-2\"\t\"LEJ1\"\t\"SELLABLE\"\t\"\"\t\"DE\"\t\"\"\t\"\"\t\"2024-01-15T10:00:00-08:00\"\n"
+
+	rows, err := ParseLedgerDetailReport(strings.NewReader(doc))
+
+	assert.NoError(t, err)
+	assert.Len(t, rows, 1)
+	assert.Equal(t, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), rows[0].Date)
+	assert.Equal(t, "Shipments", rows[0].EventType)
+	assert.Equal(t, -2, rows[0].Quantity)
+	assert.Nil(t, rows[0].ReconciledQuantity)
+	assert.Equal(t, time.Date(2024, 1, 15, 18, 0, 0, 0, time.UTC), rows[0].DateAndTime.UTC())
+}
+
+func TestParseLedgerSummaryReport(t *testing.T) {
+	doc := "Date\tFNSKU\tASIN\tMSKU\tTitle\tDisposition\tStarting Warehouse Balance\tIn Transit Between Warehouses\tReceipts\tCustomer Shipments\tCustomer Returns\tVendor Returns\tWarehouse Transfer In/Out\tFound\tLost\tDamaged\tDisposed\tOther Events\tEnding Warehouse Balance\tUnknown Events\tLocation\n" +
+		"01/2024\tX000001\tB000000001\tSKU-1\tProduct\tSELLABLE\t10\t0\t5\t-3\t1\t0\t0\t0\t0\t0\t0\t0\t13\t0\tDE\n"
+
+	rows, err := ParseLedgerSummaryReport(strings.NewReader(doc))
+
+	assert.NoError(t, err)
+	assert.Len(t, rows, 1)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), rows[0].Date)
+	assert.Equal(t, -3, rows[0].CustomerShipments)
+	assert.Equal(t, 13, rows[0].EndingWarehouseBalance)
+}
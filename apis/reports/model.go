@@ -1,9 +1,11 @@
 package reports
 
 import (
+	"errors"
 	"fmt"
 	"github.com/fond-of-vertigo/amazon-sp-api/internal/utils"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/fond-of-vertigo/amazon-sp-api/apis"
@@ -73,8 +75,61 @@ const (
 	//FBA Subscribe and Save reports
 	FBASubscribeAndSaveForecastReport    Type = "GET_FBA_SNS_FORECAST_DATA"
 	FBASubscribeAndSavePerformanceReport Type = "GET_FBA_SNS_PERFORMANCE_DATA"
+
+	// Settlement Reports
+	SettlementReportV2FlatFile Type = "GET_V2_SETTLEMENT_REPORT_DATA_FLAT_FILE_V2"
+
+	// Seller Retail Analytics Reports
+	SalesAndTrafficReport Type = "GET_SALES_AND_TRAFFIC_REPORT"
+
+	// Browse Tree Reports
+	BrowseTreeReport Type = "GET_XML_BROWSE_TREE_DATA"
+
+	// Order Reports
+	FlatFileActionableOrderReportShipping      Type = "GET_FLAT_FILE_ACTIONABLE_ORDER_DATA_SHIPPING"
+	FlatFileOrderReportInvoicing               Type = "GET_FLAT_FILE_ORDER_REPORT_DATA_INVOICING"
+	FlatFileOrderReportTax                     Type = "GET_FLAT_FILE_ORDER_REPORT_DATA_TAX"
+	FlatFileOrderReportShipping                Type = "GET_FLAT_FILE_ORDER_REPORT_DATA_SHIPPING"
+	FlatFileOrdersReconciliationReportTax      Type = "GET_FLAT_FILE_ORDERS_RECONCILIATION_DATA_TAX"
+	FlatFileOrdersReconciliationReportInvoice  Type = "GET_FLAT_FILE_ORDERS_RECONCILIATION_DATA_INVOICING"
+	FlatFileOrdersReconciliationReportShipping Type = "GET_FLAT_FILE_ORDERS_RECONCILIATION_DATA_SHIPPING"
+	XMLOrderReportInvoicing                    Type = "GET_ORDER_REPORT_DATA_INVOICING"
+	XMLOrderReportTax                          Type = "GET_ORDER_REPORT_DATA_TAX"
+	XMLOrderReportShipping                     Type = "GET_ORDER_REPORT_DATA_SHIPPING"
+	EasyShipDocuments                          Type = "GET_EASYSHIP_DOCUMENTS"
+
+	// Tax Reports
+	GSTMerchantTaxReportB2BCustom Type = "GET_GST_MTR_B2B_CUSTOM"
+	VATTransactionsReport         Type = "GET_VAT_TRANSACTION_DATA"
+	VATTaxReport                  Type = "SC_VAT_TAX_REPORT"
+)
+
+// RestrictedTypes are the report types containing Personally Identifiable Information (PII).
+// Their documents can only be retrieved with a restrictedDataToken.
+var RestrictedTypes = utils.NewSet[Type](
+	FBAAmazonFulfilledShipmentsReport,
+	FBAAmazonFulfilledShipmentsInvoicing,
+	FBAAmazonFulfilledShipmentsReportTax,
+	FlatFileActionableOrderReportShipping,
+	FlatFileOrderReportInvoicing,
+	FlatFileOrderReportTax,
+	FlatFileOrdersReconciliationReportTax,
+	FlatFileOrdersReconciliationReportInvoice,
+	FlatFileOrdersReconciliationReportShipping,
+	XMLOrderReportInvoicing,
+	XMLOrderReportTax,
+	XMLOrderReportShipping,
+	EasyShipDocuments,
+	GSTMerchantTaxReportB2BCustom,
+	VATTransactionsReport,
+	VATTaxReport,
 )
 
+// IsRestricted reports whether a restrictedDataToken is required to get the report document.
+func (t Type) IsRestricted() bool {
+	return RestrictedTypes.Has(t)
+}
+
 // ReportModel Detailed information about the report.
 type ReportModel struct {
 	// A list of marketplace identifiers for the report.
@@ -135,20 +190,94 @@ type GetReportsFilter struct {
 	CreatedUntil apis.JsonTimeISO8601
 	// nextToken is a string token returned in the response to your previous request.
 	// nextToken is returned when the number of results exceeds the specified pageSize value.
-	//To get the next page of results, call the getReports operation and include this token as the only parameter.
-	// Specifying nextToken with any other parameters will cause the request to fail.
+	// SP-API rejects nextToken combined with other parameters, so if it is set, it is sent as the only
+	// parameter and the other filters are ignored. Set it on the filter of the first page to get the next one.
 	NextToken string
 }
 
+// NewGetReportsFilter returns an empty filter to be configured with the With* setters.
+func NewGetReportsFilter() *GetReportsFilter {
+	return &GetReportsFilter{}
+}
+
+// WithReportTypes sets the report types to filter by.
+func (f *GetReportsFilter) WithReportTypes(reportTypes ...Type) *GetReportsFilter {
+	f.ReportTypes = reportTypes
+	return f
+}
+
+// WithProcessingStatuses sets the processing statuses to filter by.
+func (f *GetReportsFilter) WithProcessingStatuses(statuses ...constants.ProcessingStatus) *GetReportsFilter {
+	f.ProcessingStatuses = statuses
+	return f
+}
+
+// WithMarketplaceIDs sets the marketplaces to filter by.
+func (f *GetReportsFilter) WithMarketplaceIDs(marketplaceIDs ...constants.MarketplaceID) *GetReportsFilter {
+	f.MarketplaceIDs = marketplaceIDs
+	return f
+}
+
+// WithPageSize sets the maximum number of reports returned per page.
+func (f *GetReportsFilter) WithPageSize(pageSize int) *GetReportsFilter {
+	f.PageSize = pageSize
+	return f
+}
+
+// WithCreatedSince sets the earliest report creation time.
+func (f *GetReportsFilter) WithCreatedSince(createdSince time.Time) *GetReportsFilter {
+	f.CreatedSince = apis.JsonTimeISO8601{Time: createdSince}
+	return f
+}
+
+// WithCreatedUntil sets the latest report creation time.
+func (f *GetReportsFilter) WithCreatedUntil(createdUntil time.Time) *GetReportsFilter {
+	f.CreatedUntil = apis.JsonTimeISO8601{Time: createdUntil}
+	return f
+}
+
+// WithNextToken sets the token of the page to request.
+func (f *GetReportsFilter) WithNextToken(nextToken string) *GetReportsFilter {
+	f.NextToken = nextToken
+	return f
+}
+
+// Validate checks the filter against the constraints of the getReports operation. The other filters
+// aren't checked if NextToken is set, because they aren't sent then.
+func (f *GetReportsFilter) Validate() error {
+	if f.NextToken != "" {
+		return nil
+	}
+	if len(f.ReportTypes) < 1 || len(f.ReportTypes) > 10 {
+		return errors.New("reportTypes must contain between 1 and 10 report types")
+	}
+	if len(f.MarketplaceIDs) > 10 {
+		return errors.New("marketplaceIDs cannot contain more than 10 marketplaces")
+	}
+	if f.PageSize < 0 || f.PageSize > 100 {
+		return errors.New("pageSize must be between 1 and 100")
+	}
+	if !f.CreatedSince.IsZero() && !f.CreatedUntil.IsZero() && f.CreatedUntil.Before(f.CreatedSince.Time) {
+		return errors.New("createdUntil must not be before createdSince")
+	}
+	return nil
+}
+
+// GetQuery returns the query parameters of the filter, only nextToken if NextToken is set.
 func (f *GetReportsFilter) GetQuery() url.Values {
 	q := url.Values{}
-	q.Add("reportTypes", utils.MapToCommaString(f.ReportTypes))
-	q.Add("processingStatuses", utils.MapToCommaString(f.ProcessingStatuses))
-	q.Add("marketplaceIds", utils.MapToCommaString(f.MarketplaceIDs))
-	q.Add("pageSize", fmt.Sprint(f.PageSize))
-	q.Add("createdSince", f.CreatedSince.String())
-	q.Add("createdUntil", f.CreatedUntil.String())
-	q.Add("nextToken", f.NextToken)
+	if f.NextToken != "" {
+		q.Set("nextToken", f.NextToken)
+		return q
+	}
+	utils.AddToQueryIfSet(q, "reportTypes", utils.MapToCommaString(f.ReportTypes))
+	utils.AddToQueryIfSet(q, "processingStatuses", utils.MapToCommaString(f.ProcessingStatuses))
+	utils.AddToQueryIfSet(q, "marketplaceIds", utils.MapToCommaString(f.MarketplaceIDs))
+	if f.PageSize > 0 {
+		q.Set("pageSize", strconv.Itoa(f.PageSize))
+	}
+	utils.AddToQueryIfSet(q, "createdSince", f.CreatedSince.String())
+	utils.AddToQueryIfSet(q, "createdUntil", f.CreatedUntil.String())
 	return q
 }
 
@@ -211,7 +340,7 @@ type ReportSchedule struct {
 	// Additional information passed to reports. This varies by report type.
 	ReportOptions *map[string]string `json:"reportOptions,omitempty"`
 	// An ISO 8601 period value that indicates how often a report should be created.
-	Period string `json:"period"`
+	Period Period `json:"period"`
 	// The date and time when the schedule will create its next report, in ISO 8601 date time format.
 	NextReportCreationTime apis.JsonTimeISO8601 `json:"nextReportCreationTime,omitempty"`
 }
@@ -232,6 +361,56 @@ type CreateReportScheduleResponse struct {
 	ReportScheduleID string `json:"reportScheduleId"`
 }
 
+// Period is one of the predefined ISO 8601 periods accepted for report schedules.
+type Period string
+
+const (
+	PeriodFiveMinutes     Period = "PT5M"
+	PeriodFifteenMinutes  Period = "PT15M"
+	PeriodThirtyMinutes   Period = "PT30M"
+	PeriodOneHour         Period = "PT1H"
+	PeriodTwoHours        Period = "PT2H"
+	PeriodFourHours       Period = "PT4H"
+	PeriodEightHours      Period = "PT8H"
+	PeriodTwelveHours     Period = "PT12H"
+	PeriodOneDay          Period = "P1D"
+	PeriodTwoDays         Period = "P2D"
+	PeriodThreeDays       Period = "P3D"
+	PeriodEightyFourHours Period = "PT84H"
+	PeriodSevenDays       Period = "P7D"
+	PeriodFourteenDays    Period = "P14D"
+	PeriodFifteenDays     Period = "P15D"
+	PeriodEighteenDays    Period = "P18D"
+	PeriodThirtyDays      Period = "P30D"
+	PeriodOneMonth        Period = "P1M"
+)
+
+var AllowedPeriods = utils.NewSet[Period](
+	PeriodFiveMinutes,
+	PeriodFifteenMinutes,
+	PeriodThirtyMinutes,
+	PeriodOneHour,
+	PeriodTwoHours,
+	PeriodFourHours,
+	PeriodEightHours,
+	PeriodTwelveHours,
+	PeriodOneDay,
+	PeriodTwoDays,
+	PeriodThreeDays,
+	PeriodEightyFourHours,
+	PeriodSevenDays,
+	PeriodFourteenDays,
+	PeriodFifteenDays,
+	PeriodEighteenDays,
+	PeriodThirtyDays,
+	PeriodOneMonth,
+)
+
+// IsValid reports whether Amazon accepts the period for report schedules.
+func (p Period) IsValid() bool {
+	return AllowedPeriods.Has(p)
+}
+
 // CreateReportScheduleSpecification struct for CreateReportScheduleSpecification
 type CreateReportScheduleSpecification struct {
 	// The report type.
@@ -241,7 +420,21 @@ type CreateReportScheduleSpecification struct {
 	// Additional information passed to reports. This varies by report type.
 	ReportOptions *map[string]string `json:"reportOptions,omitempty"`
 	// One of a set of predefined ISO 8601 periods that specifies how often a report should be created.
-	Period string `json:"period"`
+	Period Period `json:"period"`
 	// The date and time when the schedule will create its next report, in ISO 8601 date time format.
 	NextReportCreationTime apis.JsonTimeISO8601 `json:"nextReportCreationTime,omitempty"`
 }
+
+// Validate checks the specification before the schedule is created.
+func (s *CreateReportScheduleSpecification) Validate() error {
+	if s.ReportType == "" {
+		return errors.New("reportType is required")
+	}
+	if len(s.MarketplaceIDs) < 1 || len(s.MarketplaceIDs) > 25 {
+		return errors.New("marketplaceIDs must contain between 1 and 25 marketplaces")
+	}
+	if !s.Period.IsValid() {
+		return fmt.Errorf("period %q is not supported for report schedules", s.Period)
+	}
+	return nil
+}
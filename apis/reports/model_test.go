@@ -0,0 +1,55 @@
+package reports
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetReportsFilter_GetQuery(t *testing.T) {
+	filter := NewGetReportsFilter().WithReportTypes(SettlementReportV2FlatFile, FBAReturnsReport)
+	assert.Equal(t, url.Values{"reportTypes": {"GET_V2_SETTLEMENT_REPORT_DATA_FLAT_FILE_V2,GET_FBA_FULFILLMENT_CUSTOMER_RETURNS_DATA"}}, filter.GetQuery())
+
+	assert.Equal(t, url.Values{"nextToken": {"abc"}}, NewGetReportsFilter().WithNextToken("abc").GetQuery())
+	assert.Equal(t, url.Values{"nextToken": {"abc"}}, filter.WithPageSize(10).WithNextToken("abc").GetQuery(),
+		"the other filters must not be sent with nextToken")
+}
+
+func TestGetReportsFilter_Validate(t *testing.T) {
+	valid := NewGetReportsFilter().
+		WithReportTypes(SettlementReportV2FlatFile).
+		WithPageSize(50).
+		WithCreatedSince(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, valid.Validate())
+
+	assert.Error(t, NewGetReportsFilter().Validate(), "reportTypes or nextToken is required")
+	assert.NoError(t, NewGetReportsFilter().WithNextToken("abc").Validate())
+	assert.NoError(t, NewGetReportsFilter().WithNextToken("abc").WithPageSize(101).Validate(), "the other filters are not sent with nextToken")
+	assert.Error(t, NewGetReportsFilter().WithReportTypes(SettlementReportV2FlatFile).WithPageSize(101).Validate())
+	assert.Error(t, NewGetReportsFilter().
+		WithReportTypes(SettlementReportV2FlatFile).
+		WithCreatedSince(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)).
+		WithCreatedUntil(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+		Validate())
+}
+
+func TestCreateReportScheduleSpecification_Validate(t *testing.T) {
+	spec := CreateReportScheduleSpecification{
+		ReportType:     SettlementReportV2FlatFile,
+		MarketplaceIDs: []constants.MarketplaceID{constants.Germany},
+		Period:         PeriodOneDay,
+	}
+	assert.NoError(t, spec.Validate())
+
+	spec.Period = "P1W"
+	assert.ErrorContains(t, spec.Validate(), `period "P1W" is not supported`)
+}
+
+func TestType_IsRestricted(t *testing.T) {
+	assert.True(t, FBAAmazonFulfilledShipmentsReport.IsRestricted())
+	assert.True(t, FlatFileOrderReportTax.IsRestricted())
+	assert.False(t, SettlementReportV2FlatFile.IsRestricted())
+}
@@ -1,45 +1,107 @@
 package reports
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"go/types"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
 	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/tokens"
 	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+	"github.com/fond-of-vertigo/amazon-sp-api/internal/utils"
 )
 
 const pathPrefix = "/reports/2021-06-30"
 
 type API struct {
-	httpClient *httpx.Client
+	httpClient    *httpx.Client
+	tokensAPI     *tokens.API
+	documentCache DocumentCache
 }
 
+// Client is the interface of API, implemented by *API and by mocks.ReportsClient for tests.
+type Client interface {
+	GetReports(ctx context.Context, filter *GetReportsFilter) (*apis.CallResponse[GetReportsResponse], error)
+	GetReportsAll(ctx context.Context, filter *GetReportsFilter) ([]ReportModel, error)
+	ReportsPaginator(filter *GetReportsFilter) *apis.Paginator[ReportModel]
+	CreateReport(ctx context.Context, specification *CreateReportSpecification) (*apis.CallResponse[CreateReportResponse], error)
+	GetReport(ctx context.Context, reportID string) (*apis.CallResponse[GetReportResponse], error)
+	CancelReport(ctx context.Context, reportID string) error
+	GetReportSchedules(ctx context.Context, reportTypes []string) (*apis.CallResponse[GetReportsResponse], error)
+	CreateReportSchedule(ctx context.Context, specification *CreateReportScheduleSpecification) (*apis.CallResponse[CreateReportScheduleResponse], error)
+	GetReportSchedule(ctx context.Context, reportScheduleID string) (*apis.CallResponse[GetReportScheduleResponse], error)
+	CancelReportSchedule(ctx context.Context, reportScheduleID string) error
+	GetReportDocument(ctx context.Context, reportDocumentID string, restrictedDataToken *string) (*apis.CallResponse[GetReportDocumentResponse], error)
+	WaitForReport(ctx context.Context, reportID string) (*ReportModel, error)
+	CreateAndDownloadReport(ctx context.Context, specification *CreateReportSpecification, opts ...apis.DocumentOption) ([]byte, error)
+	CreateAndWriteReport(ctx context.Context, specification *CreateReportSpecification, w io.Writer, opts ...apis.DocumentOption) error
+	DownloadReportDocument(ctx context.Context, reportDocumentID string, opts ...apis.DocumentOption) ([]byte, error)
+	DownloadReport(ctx context.Context, report *ReportModel, opts ...apis.DocumentOption) ([]byte, error)
+	DownloadReports(ctx context.Context, reports []ReportModel, parallelism int, opts ...apis.DocumentOption) <-chan DownloadResult
+	WriteReport(ctx context.Context, report *ReportModel, w io.Writer, opts ...apis.DocumentOption) error
+	WriteReportDocument(ctx context.Context, reportDocumentID string, w io.Writer, opts ...apis.DocumentOption) error
+}
+
+var _ Client = (*API)(nil)
+
 func NewAPI(httpClient *httpx.Client) *API {
 	return &API{
 		httpClient: httpClient,
+		tokensAPI:  tokens.NewAPI(httpClient),
 	}
 }
 
 // GetReports returns report details for the reports that match the filters that you specify.
 // filter are optional and can be set to nil
-func (r *API) GetReports(filter *GetReportsFilter) (*apis.CallResponse[GetReportsResponse], error) {
-	if filter.PageSize < 1 {
-		filter.PageSize = 10
+func (r *API) GetReports(ctx context.Context, filter *GetReportsFilter) (*apis.CallResponse[GetReportsResponse], error) {
+	f := GetReportsFilter{}
+	if filter != nil {
+		f = *filter
+	}
+	if f.PageSize < 1 && f.NextToken == "" {
+		f.PageSize = 10
+	}
+	if err := f.Validate(); err != nil {
+		return nil, err
 	}
 	return apis.NewCall[GetReportsResponse](http.MethodGet, pathPrefix+"/reports").
-		WithQueryParams(filter.GetQuery()).
+		WithQueryParams(f.GetQuery()).
 		WithParseErrorListOnError().
 		WithRateLimit(0.0222, time.Second).
-		Execute(r.httpClient)
+		WithBurst(10).
+		Execute(ctx, r.httpClient)
+}
+
+// GetReportsAll returns the reports of all pages that match the filters that you specify.
+// The nextToken of each page is followed automatically and sent as the only parameter, as required by the API.
+func (r *API) GetReportsAll(ctx context.Context, filter *GetReportsFilter) ([]ReportModel, error) {
+	return r.ReportsPaginator(filter).All(ctx)
+}
+
+// ReportsPaginator returns a Paginator over the reports that match the filters that you specify.
+// The first page is fetched with the filter, the following pages with their nextToken only.
+func (r *API) ReportsPaginator(filter *GetReportsFilter) *apis.Paginator[ReportModel] {
+	return apis.NewPaginator(func(ctx context.Context, nextToken string) ([]ReportModel, string, error) {
+		pageFilter := filter
+		if nextToken != "" {
+			pageFilter = &GetReportsFilter{NextToken: nextToken}
+		}
+		resp, err := r.GetReports(ctx, pageFilter)
+		if err != nil || resp.ResponseBody == nil {
+			return nil, "", err
+		}
+		return resp.ResponseBody.Reports, apis.Deref(resp.ResponseBody.NextToken), nil
+	})
 }
 
 // CreateReport creates a report and returns the reportID.
-func (r *API) CreateReport(specification *CreateReportSpecification) (*apis.CallResponse[CreateReportResponse], error) {
+func (r *API) CreateReport(ctx context.Context, specification *CreateReportSpecification) (*apis.CallResponse[CreateReportResponse], error) {
 	body, err := json.Marshal(specification)
 	if err != nil {
 		return nil, err
@@ -48,45 +110,54 @@ func (r *API) CreateReport(specification *CreateReportSpecification) (*apis.Call
 		WithBody(body).
 		WithParseErrorListOnError().
 		WithRateLimit(0.0167, time.Second).
-		Execute(r.httpClient)
+		WithBurst(15).
+		Execute(ctx, r.httpClient)
 }
 
 // GetReport returns report details (including the reportDocumentID, if available) for the report that you specify.
-func (r *API) GetReport(reportID string) (*apis.CallResponse[GetReportResponse], error) {
-	return apis.NewCall[GetReportResponse](http.MethodGet, pathPrefix+"/reports/"+reportID).
+func (r *API) GetReport(ctx context.Context, reportID string) (*apis.CallResponse[GetReportResponse], error) {
+	return apis.NewCall[GetReportResponse](http.MethodGet, pathPrefix+"/reports/{reportID}").
+		WithPathParam("reportID", reportID).
 		WithParseErrorListOnError().
 		WithRateLimit(2.0, time.Second).
-		Execute(r.httpClient)
+		WithBurst(15).
+		Execute(ctx, r.httpClient)
 }
 
 // CancelReport returns report schedule details that match the filters that you specify.
 // reportTypes is list of report types used to filter report schedules. This is optional can can be nil.
-func (r *API) CancelReport(reportID string) error {
-	_, err := apis.NewCall[types.Nil](http.MethodDelete, pathPrefix+"/reports/"+reportID).
+func (r *API) CancelReport(ctx context.Context, reportID string) error {
+	_, err := apis.NewCall[types.Nil](http.MethodDelete, pathPrefix+"/reports/{reportID}").
+		WithPathParam("reportID", reportID).
 		WithRateLimit(0.0222, time.Second).
-		Execute(r.httpClient)
+		WithBurst(10).
+		Execute(ctx, r.httpClient)
 	return err
 }
 
 // GetReportSchedules returns report schedule details that match the filters that you specify.
 // reportTypes is list of report types used to filter report schedules. This is optional can can be nil.
-func (r *API) GetReportSchedules(reportTypes []string) (*apis.CallResponse[GetReportsResponse], error) {
+func (r *API) GetReportSchedules(ctx context.Context, reportTypes []string) (*apis.CallResponse[GetReportsResponse], error) {
 	if len(reportTypes) > 10 {
 		return nil, fmt.Errorf("reportTypes cannot contain more than 10 reportTypes")
 	}
 	params := url.Values{}
-	params.Add("reportTypes", strings.Join(reportTypes, ","))
+	utils.AddToQueryIfSet(params, "reportTypes", strings.Join(reportTypes, ","))
 	return apis.NewCall[GetReportsResponse](http.MethodGet, pathPrefix+"/schedules").
 		WithQueryParams(params).
 		WithParseErrorListOnError().
 		WithRateLimit(0.0222, time.Second).
-		Execute(r.httpClient)
+		WithBurst(10).
+		Execute(ctx, r.httpClient)
 }
 
 // CreateReportSchedule creates a report schedule.
 // If a report schedule with the same report type and marketplace IDs already exists,
 // it will be cancelled and replaced with this one.
-func (r *API) CreateReportSchedule(specification *CreateReportScheduleSpecification) (*apis.CallResponse[CreateReportScheduleResponse], error) {
+func (r *API) CreateReportSchedule(ctx context.Context, specification *CreateReportScheduleSpecification) (*apis.CallResponse[CreateReportScheduleResponse], error) {
+	if err := specification.Validate(); err != nil {
+		return nil, err
+	}
 	body, err := json.Marshal(specification)
 	if err != nil {
 		return nil, err
@@ -95,31 +166,38 @@ func (r *API) CreateReportSchedule(specification *CreateReportScheduleSpecificat
 		WithBody(body).
 		WithParseErrorListOnError().
 		WithRateLimit(0.0222, time.Second).
-		Execute(r.httpClient)
+		WithBurst(10).
+		Execute(ctx, r.httpClient)
 }
 
 // GetReportSchedule returns report schedule details for the report schedule that you specify.
-func (r *API) GetReportSchedule(reportScheduleID string) (*apis.CallResponse[GetReportScheduleResponse], error) {
-	return apis.NewCall[GetReportScheduleResponse](http.MethodGet, pathPrefix+"/schedules/"+reportScheduleID).
+func (r *API) GetReportSchedule(ctx context.Context, reportScheduleID string) (*apis.CallResponse[GetReportScheduleResponse], error) {
+	return apis.NewCall[GetReportScheduleResponse](http.MethodGet, pathPrefix+"/schedules/{reportScheduleID}").
+		WithPathParam("reportScheduleID", reportScheduleID).
 		WithParseErrorListOnError().
 		WithRateLimit(0.0222, time.Second).
-		Execute(r.httpClient)
+		WithBurst(10).
+		Execute(ctx, r.httpClient)
 }
 
 // CancelReportSchedule cancels the report schedule that you specify.
-func (r *API) CancelReportSchedule(reportScheduleID string) error {
-	_, err := apis.NewCall[types.Nil](http.MethodDelete, pathPrefix+"/schedules/"+reportScheduleID).
+func (r *API) CancelReportSchedule(ctx context.Context, reportScheduleID string) error {
+	_, err := apis.NewCall[types.Nil](http.MethodDelete, pathPrefix+"/schedules/{reportScheduleID}").
+		WithPathParam("reportScheduleID", reportScheduleID).
 		WithRateLimit(0.0222, time.Second).
-		Execute(r.httpClient)
+		WithBurst(10).
+		Execute(ctx, r.httpClient)
 	return err
 }
 
 // GetReportDocument returns the information required for retrieving a report document's contents.
 // a restrictedDataToken is optional and may be passed to receive Personally Identifiable Information (PII).
-func (r *API) GetReportDocument(reportDocumentID string, restrictedDataToken *string) (*apis.CallResponse[GetReportDocumentResponse], error) {
-	return apis.NewCall[GetReportDocumentResponse](http.MethodGet, pathPrefix+"/documents/"+reportDocumentID).
+func (r *API) GetReportDocument(ctx context.Context, reportDocumentID string, restrictedDataToken *string) (*apis.CallResponse[GetReportDocumentResponse], error) {
+	return apis.NewCall[GetReportDocumentResponse](http.MethodGet, pathPrefix+"/documents/{reportDocumentID}").
+		WithPathParam("reportDocumentID", reportDocumentID).
 		WithRestrictedDataToken(restrictedDataToken).
 		WithParseErrorListOnError().
 		WithRateLimit(0.0167, time.Second).
-		Execute(r.httpClient)
+		WithBurst(15).
+		Execute(ctx, r.httpClient)
 }
@@ -0,0 +1,45 @@
+package reports
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient returns a client that sends all calls to srv with the access token "ACCESS-TOKEN".
+func newTestClient(t *testing.T, srv *httptest.Server) *httpx.Client {
+	t.Helper()
+	client, err := httpx.NewClient(httpx.ClientConfig{
+		TokenProvider: httpx.TokenProviderFunc(func(ctx context.Context) (string, error) {
+			return "ACCESS-TOKEN", nil
+		}),
+		HTTPClient: srv.Client(),
+		Endpoint:   constants.Endpoint(srv.URL),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close(context.Background()) })
+	return client
+}
+
+func TestAPI_GetReports(t *testing.T) {
+	var pageSize string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pageSize = r.URL.Query().Get("pageSize")
+		_ = json.NewEncoder(w).Encode(GetReportsResponse{})
+	}))
+	defer srv.Close()
+	filter := NewGetReportsFilter().WithReportTypes(FBAInventoryLedgerReportSummaryView)
+
+	_, err := NewAPI(newTestClient(t, srv)).GetReports(context.Background(), filter)
+
+	require.NoError(t, err)
+	assert.Equal(t, "10", pageSize)
+	assert.Zero(t, filter.PageSize, "the default pageSize must not be written to the filter")
+}
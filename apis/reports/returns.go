@@ -0,0 +1,33 @@
+package reports
+
+import (
+	"io"
+	"time"
+)
+
+// CustomerReturnRow is a row of the FBAReturnsReport (GET_FBA_FULFILLMENT_CUSTOMER_RETURNS_DATA) report.
+type CustomerReturnRow struct {
+	ReturnDate          time.Time `tsv:"return-date"`
+	OrderID             string    `tsv:"order-id"`
+	SKU                 string    `tsv:"sku"`
+	ASIN                string    `tsv:"asin"`
+	FNSKU               string    `tsv:"fnsku"`
+	ProductName         string    `tsv:"product-name"`
+	Quantity            int       `tsv:"quantity"`
+	FulfillmentCenterID string    `tsv:"fulfillment-center-id"`
+	DetailedDisposition string    `tsv:"detailed-disposition"`
+	Reason              string    `tsv:"reason"`
+	Status              string    `tsv:"status"`
+	LicensePlateNumber  string    `tsv:"license-plate-number"`
+	CustomerComments    string    `tsv:"customer-comments"`
+}
+
+// NewCustomerReturnsDecoder returns a decoder streaming the rows of a FBA customer returns report.
+func NewCustomerReturnsDecoder(r io.Reader) *TSVDecoder[CustomerReturnRow] {
+	return NewTSVDecoder[CustomerReturnRow](r)
+}
+
+// ParseCustomerReturnsReport decodes all rows of a FBA customer returns report.
+func ParseCustomerReturnsReport(r io.Reader) ([]CustomerReturnRow, error) {
+	return NewCustomerReturnsDecoder(r).ReadAll()
+}
@@ -0,0 +1,23 @@
+package reports
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCustomerReturnsReport(t *testing.T) {
+	doc := "return-date\torder-id\tsku\tasin\tfnsku\tproduct-name\tquantity\tfulfillment-center-id\tdetailed-disposition\treason\tstatus\tlicense-plate-number\tcustomer-comments\n" +
+		"2024-01-15T10:00:00+00:00\t028-1234567-1234567\tSKU-1\tB000000001\tX000001\tProduct\t1\tLEJ1\tSELLABLE\tUNWANTED_ITEM\tUnit returned to inventory\tLPN123\t\"Too \"\"small\"\"\"\n"
+
+	rows, err := ParseCustomerReturnsReport(strings.NewReader(doc))
+
+	assert.NoError(t, err)
+	assert.Len(t, rows, 1)
+	assert.Equal(t, time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), rows[0].ReturnDate.UTC())
+	assert.Equal(t, "SELLABLE", rows[0].DetailedDisposition)
+	assert.Equal(t, "UNWANTED_ITEM", rows[0].Reason)
+	assert.Equal(t, `Too "small"`, rows[0].CustomerComments)
+}
@@ -0,0 +1,170 @@
+package reports
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+// DateGranularity is the reportOption dateGranularity of the SalesAndTrafficReport.
+type DateGranularity string
+
+const (
+	DateGranularityDay   DateGranularity = "DAY"
+	DateGranularityWeek  DateGranularity = "WEEK"
+	DateGranularityMonth DateGranularity = "MONTH"
+)
+
+// ASINGranularity is the reportOption asinGranularity of the SalesAndTrafficReport.
+type ASINGranularity string
+
+const (
+	ASINGranularityParent ASINGranularity = "PARENT"
+	ASINGranularityChild  ASINGranularity = "CHILD"
+	ASINGranularitySKU    ASINGranularity = "SKU"
+)
+
+// SalesAndTrafficReportDocument is the JSON document of the GET_SALES_AND_TRAFFIC_REPORT report type.
+type SalesAndTrafficReportDocument struct {
+	ReportSpecification   SalesAndTrafficReportSpecification `json:"reportSpecification"`
+	SalesAndTrafficByDate []SalesAndTrafficByDate            `json:"salesAndTrafficByDate"`
+	SalesAndTrafficByASIN []SalesAndTrafficByASIN            `json:"salesAndTrafficByAsin"`
+}
+
+// SalesAndTrafficReportSpecification Summarizes the original report request.
+type SalesAndTrafficReportSpecification struct {
+	ReportType    Type `json:"reportType"`
+	ReportOptions struct {
+		DateGranularity DateGranularity `json:"dateGranularity"`
+		ASINGranularity ASINGranularity `json:"asinGranularity"`
+	} `json:"reportOptions"`
+	// The start date of the report data in YYYY-MM-DD format.
+	DataStartTime  string                    `json:"dataStartTime"`
+	DataEndTime    string                    `json:"dataEndTime"`
+	MarketplaceIDs []constants.MarketplaceID `json:"marketplaceIds"`
+}
+
+// Amount A currency type and amount.
+type Amount struct {
+	Amount       json.Number `json:"amount"`
+	CurrencyCode string      `json:"currencyCode"`
+}
+
+// SalesAndTrafficByDate Sales and traffic data for a single date (or week or month, depending on the DateGranularity).
+type SalesAndTrafficByDate struct {
+	// The date in YYYY-MM-DD format.
+	Date          string        `json:"date"`
+	SalesByDate   SalesByDate   `json:"salesByDate"`
+	TrafficByDate TrafficByDate `json:"trafficByDate"`
+}
+
+// SalesByDate Sales data by date.
+type SalesByDate struct {
+	OrderedProductSales         Amount   `json:"orderedProductSales"`
+	OrderedProductSalesB2B      *Amount  `json:"orderedProductSalesB2B,omitempty"`
+	UnitsOrdered                int      `json:"unitsOrdered"`
+	UnitsOrderedB2B             *int     `json:"unitsOrderedB2B,omitempty"`
+	TotalOrderItems             int      `json:"totalOrderItems"`
+	TotalOrderItemsB2B          *int     `json:"totalOrderItemsB2B,omitempty"`
+	AverageSalesPerOrderItem    Amount   `json:"averageSalesPerOrderItem"`
+	AverageSalesPerOrderItemB2B *Amount  `json:"averageSalesPerOrderItemB2B,omitempty"`
+	AverageUnitsPerOrderItem    float64  `json:"averageUnitsPerOrderItem"`
+	AverageUnitsPerOrderItemB2B *float64 `json:"averageUnitsPerOrderItemB2B,omitempty"`
+	AverageSellingPrice         Amount   `json:"averageSellingPrice"`
+	AverageSellingPriceB2B      *Amount  `json:"averageSellingPriceB2B,omitempty"`
+	UnitsRefunded               int      `json:"unitsRefunded"`
+	RefundRate                  float64  `json:"refundRate"`
+	ClaimsGranted               int      `json:"claimsGranted"`
+	ClaimsAmount                Amount   `json:"claimsAmount"`
+	ShippedProductSales         Amount   `json:"shippedProductSales"`
+	UnitsShipped                int      `json:"unitsShipped"`
+	OrdersShipped               int      `json:"ordersShipped"`
+}
+
+// TrafficByDate Traffic data by date.
+type TrafficByDate struct {
+	BrowserPageViews              int      `json:"browserPageViews"`
+	BrowserPageViewsB2B           *int     `json:"browserPageViewsB2B,omitempty"`
+	MobileAppPageViews            int      `json:"mobileAppPageViews"`
+	MobileAppPageViewsB2B         *int     `json:"mobileAppPageViewsB2B,omitempty"`
+	PageViews                     int      `json:"pageViews"`
+	PageViewsB2B                  *int     `json:"pageViewsB2B,omitempty"`
+	BrowserSessions               int      `json:"browserSessions"`
+	BrowserSessionsB2B            *int     `json:"browserSessionsB2B,omitempty"`
+	MobileAppSessions             int      `json:"mobileAppSessions"`
+	MobileAppSessionsB2B          *int     `json:"mobileAppSessionsB2B,omitempty"`
+	Sessions                      int      `json:"sessions"`
+	SessionsB2B                   *int     `json:"sessionsB2B,omitempty"`
+	BuyBoxPercentage              float64  `json:"buyBoxPercentage"`
+	BuyBoxPercentageB2B           *float64 `json:"buyBoxPercentageB2B,omitempty"`
+	OrderItemSessionPercentage    float64  `json:"orderItemSessionPercentage"`
+	OrderItemSessionPercentageB2B *float64 `json:"orderItemSessionPercentageB2B,omitempty"`
+	UnitSessionPercentage         float64  `json:"unitSessionPercentage"`
+	UnitSessionPercentageB2B      *float64 `json:"unitSessionPercentageB2B,omitempty"`
+	AverageOfferCount             int      `json:"averageOfferCount"`
+	AverageParentItems            int      `json:"averageParentItems"`
+	FeedbackReceived              int      `json:"feedbackReceived"`
+	NegativeFeedbackReceived      int      `json:"negativeFeedbackReceived"`
+	ReceivedNegativeFeedbackRate  float64  `json:"receivedNegativeFeedbackRate"`
+}
+
+// SalesAndTrafficByASIN Sales and traffic data for a single ASIN or SKU, depending on the ASINGranularity.
+type SalesAndTrafficByASIN struct {
+	ParentASIN    string        `json:"parentAsin"`
+	ChildASIN     *string       `json:"childAsin,omitempty"`
+	SKU           *string       `json:"sku,omitempty"`
+	SalesByASIN   SalesByASIN   `json:"salesByAsin"`
+	TrafficByASIN TrafficByASIN `json:"trafficByAsin"`
+}
+
+// SalesByASIN Sales data by ASIN.
+type SalesByASIN struct {
+	UnitsOrdered           int     `json:"unitsOrdered"`
+	UnitsOrderedB2B        *int    `json:"unitsOrderedB2B,omitempty"`
+	OrderedProductSales    Amount  `json:"orderedProductSales"`
+	OrderedProductSalesB2B *Amount `json:"orderedProductSalesB2B,omitempty"`
+	TotalOrderItems        int     `json:"totalOrderItems"`
+	TotalOrderItemsB2B     *int    `json:"totalOrderItemsB2B,omitempty"`
+}
+
+// TrafficByASIN Traffic data by ASIN.
+type TrafficByASIN struct {
+	BrowserSessions                 int      `json:"browserSessions"`
+	BrowserSessionsB2B              *int     `json:"browserSessionsB2B,omitempty"`
+	MobileAppSessions               int      `json:"mobileAppSessions"`
+	MobileAppSessionsB2B            *int     `json:"mobileAppSessionsB2B,omitempty"`
+	Sessions                        int      `json:"sessions"`
+	SessionsB2B                     *int     `json:"sessionsB2B,omitempty"`
+	BrowserSessionPercentage        float64  `json:"browserSessionPercentage"`
+	BrowserSessionPercentageB2B     *float64 `json:"browserSessionPercentageB2B,omitempty"`
+	MobileAppSessionPercentage      float64  `json:"mobileAppSessionPercentage"`
+	MobileAppSessionPercentageB2B   *float64 `json:"mobileAppSessionPercentageB2B,omitempty"`
+	SessionPercentage               float64  `json:"sessionPercentage"`
+	SessionPercentageB2B            *float64 `json:"sessionPercentageB2B,omitempty"`
+	BrowserPageViews                int      `json:"browserPageViews"`
+	BrowserPageViewsB2B             *int     `json:"browserPageViewsB2B,omitempty"`
+	MobileAppPageViews              int      `json:"mobileAppPageViews"`
+	MobileAppPageViewsB2B           *int     `json:"mobileAppPageViewsB2B,omitempty"`
+	PageViews                       int      `json:"pageViews"`
+	PageViewsB2B                    *int     `json:"pageViewsB2B,omitempty"`
+	BrowserPageViewsPercentage      float64  `json:"browserPageViewsPercentage"`
+	BrowserPageViewsPercentageB2B   *float64 `json:"browserPageViewsPercentageB2B,omitempty"`
+	MobileAppPageViewsPercentage    float64  `json:"mobileAppPageViewsPercentage"`
+	MobileAppPageViewsPercentageB2B *float64 `json:"mobileAppPageViewsPercentageB2B,omitempty"`
+	PageViewsPercentage             float64  `json:"pageViewsPercentage"`
+	PageViewsPercentageB2B          *float64 `json:"pageViewsPercentageB2B,omitempty"`
+	BuyBoxPercentage                float64  `json:"buyBoxPercentage"`
+	BuyBoxPercentageB2B             *float64 `json:"buyBoxPercentageB2B,omitempty"`
+	UnitSessionPercentage           float64  `json:"unitSessionPercentage"`
+	UnitSessionPercentageB2B        *float64 `json:"unitSessionPercentageB2B,omitempty"`
+}
+
+// DecodeSalesAndTrafficReport decodes the (already decompressed) document of a SalesAndTrafficReport.
+func DecodeSalesAndTrafficReport(r io.Reader) (*SalesAndTrafficReportDocument, error) {
+	doc := &SalesAndTrafficReportDocument{}
+	if err := json.NewDecoder(r).Decode(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
@@ -0,0 +1,44 @@
+package reports
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSalesAndTrafficReport(t *testing.T) {
+	doc := `{
+  "reportSpecification": {
+    "reportType": "GET_SALES_AND_TRAFFIC_REPORT",
+    "reportOptions": {"dateGranularity": "DAY", "asinGranularity": "CHILD"},
+    "dataStartTime": "2024-01-01",
+    "dataEndTime": "2024-01-01",
+    "marketplaceIds": ["A1PA6795UKMFR9"]
+  },
+  "salesAndTrafficByDate": [{
+    "date": "2024-01-01",
+    "salesByDate": {"orderedProductSales": {"amount": 129.90, "currencyCode": "EUR"}, "unitsOrdered": 3},
+    "trafficByDate": {"pageViews": 120, "sessions": 80, "buyBoxPercentage": 97.5}
+  }],
+  "salesAndTrafficByAsin": [{
+    "parentAsin": "B000PARENT",
+    "childAsin": "B000CHILD1",
+    "salesByAsin": {"unitsOrdered": 3, "orderedProductSales": {"amount": 129.90, "currencyCode": "EUR"}},
+    "trafficByAsin": {"sessions": 80, "unitSessionPercentage": 3.75}
+  }]
+}`
+
+	report, err := DecodeSalesAndTrafficReport(strings.NewReader(doc))
+
+	assert.NoError(t, err)
+	assert.Equal(t, ASINGranularityChild, report.ReportSpecification.ReportOptions.ASINGranularity)
+	assert.Len(t, report.SalesAndTrafficByDate, 1)
+	assert.Equal(t, json.Number("129.90"), report.SalesAndTrafficByDate[0].SalesByDate.OrderedProductSales.Amount)
+	assert.Equal(t, 80, report.SalesAndTrafficByDate[0].TrafficByDate.Sessions)
+	assert.Nil(t, report.SalesAndTrafficByDate[0].SalesByDate.UnitsOrderedB2B)
+	assert.Len(t, report.SalesAndTrafficByASIN, 1)
+	assert.Equal(t, "B000CHILD1", *report.SalesAndTrafficByASIN[0].ChildASIN)
+	assert.Equal(t, 3.75, report.SalesAndTrafficByASIN[0].TrafficByASIN.UnitSessionPercentage)
+}
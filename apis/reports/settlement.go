@@ -0,0 +1,96 @@
+package reports
+
+import (
+	"errors"
+	"io"
+	"time"
+)
+
+// SettlementReport is the parsed GET_V2_SETTLEMENT_REPORT_DATA_FLAT_FILE_V2 report.
+type SettlementReport struct {
+	Header       SettlementHeader
+	Transactions []SettlementTransaction
+}
+
+// SettlementHeader contains the summary of a settlement period, taken from the first row of the report.
+type SettlementHeader struct {
+	SettlementID        string
+	SettlementStartDate time.Time
+	SettlementEndDate   time.Time
+	DepositDate         time.Time
+	TotalAmount         Decimal
+	Currency            string
+}
+
+// SettlementTransaction is a single amount line of a settlement report.
+type SettlementTransaction struct {
+	SettlementID             string     `tsv:"settlement-id"`
+	TransactionType          string     `tsv:"transaction-type"`
+	OrderID                  string     `tsv:"order-id"`
+	MerchantOrderID          string     `tsv:"merchant-order-id"`
+	AdjustmentID             string     `tsv:"adjustment-id"`
+	ShipmentID               string     `tsv:"shipment-id"`
+	MarketplaceName          string     `tsv:"marketplace-name"`
+	AmountType               string     `tsv:"amount-type"`
+	AmountDescription        string     `tsv:"amount-description"`
+	Amount                   Decimal    `tsv:"amount"`
+	Currency                 string     `tsv:"currency"`
+	FulfillmentID            string     `tsv:"fulfillment-id"`
+	PostedDate               *time.Time `tsv:"posted-date"`
+	PostedDateTime           *time.Time `tsv:"posted-date-time"`
+	OrderItemCode            string     `tsv:"order-item-code"`
+	MerchantOrderItemID      string     `tsv:"merchant-order-item-id"`
+	MerchantAdjustmentItemID string     `tsv:"merchant-adjustment-item-id"`
+	SKU                      string     `tsv:"sku"`
+	QuantityPurchased        *int       `tsv:"quantity-purchased"`
+	PromotionID              string     `tsv:"promotion-id"`
+}
+
+// settlementRow contains all columns of the flat file, the header columns are only set in the first row.
+type settlementRow struct {
+	SettlementTransaction
+	SettlementStartDate *time.Time `tsv:"settlement-start-date"`
+	SettlementEndDate   *time.Time `tsv:"settlement-end-date"`
+	DepositDate         *time.Time `tsv:"deposit-date"`
+	TotalAmount         Decimal    `tsv:"total-amount"`
+}
+
+// ParseSettlementReport parses a V2 settlement flat file report. Amounts are parsed as exact decimals,
+// both '.' and ',' decimal separators (used for european marketplaces) are supported.
+func ParseSettlementReport(r io.Reader) (*SettlementReport, error) {
+	dec := NewTSVDecoder[settlementRow](r)
+	report := &SettlementReport{}
+	for first := true; ; first = false {
+		row, err := dec.Next()
+		if errors.Is(err, io.EOF) {
+			if first {
+				return nil, errors.New("settlement report is empty")
+			}
+			return report, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if first {
+			report.Header = SettlementHeader{
+				SettlementID:        row.SettlementID,
+				SettlementStartDate: valueOrZero(row.SettlementStartDate),
+				SettlementEndDate:   valueOrZero(row.SettlementEndDate),
+				DepositDate:         valueOrZero(row.DepositDate),
+				TotalAmount:         row.TotalAmount,
+				Currency:            row.Currency,
+			}
+			continue
+		}
+		report.Transactions = append(report.Transactions, row.SettlementTransaction)
+	}
+}
+
+func valueOrZero[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
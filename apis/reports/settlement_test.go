@@ -0,0 +1,62 @@
+package reports
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSettlementReport(t *testing.T) {
+	columns := "settlement-id\tsettlement-start-date\tsettlement-end-date\tdeposit-date\ttotal-amount\tcurrency\ttransaction-type\torder-id\tmerchant-order-id\tadjustment-id\tshipment-id\tmarketplace-name\tamount-type\tamount-description\tamount\tfulfillment-id\tposted-date\tposted-date-time\torder-item-code\tmerchant-order-item-id\tmerchant-adjustment-item-id\tsku\tquantity-purchased\tpromotion-id\n"
+	doc := columns +
+		"123456\t01.01.2024 10:00:00 UTC\t15.01.2024 10:00:00 UTC\t17.01.2024 10:00:00 UTC\t1234,56\tEUR\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\n" +
+		"123456\t\t\t\t\tEUR\tOrder\t028-1234567-1234567\t\t\tS1\tAmazon.de\tItemPrice\tPrincipal\t19,99\tAFN\t02.01.2024\t02.01.2024 08:00:00 UTC\t12345\t\t\tSKU-1\t1\t\n" +
+		"123456\t\t\t\t\tEUR\tOrder\t028-1234567-1234567\t\t\tS1\tAmazon.de\tItemFees\tCommission\t-3,00\tAFN\t02.01.2024\t02.01.2024 08:00:00 UTC\t12345\t\t\tSKU-1\t\t\n"
+
+	report, err := ParseSettlementReport(strings.NewReader(doc))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "123456", report.Header.SettlementID)
+	assert.Equal(t, "1234.56", report.Header.TotalAmount.String())
+	assert.Equal(t, "EUR", report.Header.Currency)
+	assert.Equal(t, time.Date(2024, 1, 17, 10, 0, 0, 0, time.UTC), report.Header.DepositDate)
+	assert.Len(t, report.Transactions, 2)
+	assert.Equal(t, "19.99", report.Transactions[0].Amount.String())
+	assert.Equal(t, 1, *report.Transactions[0].QuantityPurchased)
+	assert.Equal(t, "-3.00", report.Transactions[1].Amount.String())
+	assert.Nil(t, report.Transactions[1].QuantityPurchased)
+
+	sum := report.Transactions[0].Amount.Rat()
+	sum.Add(sum, report.Transactions[1].Amount.Rat())
+	assert.Equal(t, "16.99", sum.FloatString(2))
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "12.34", want: "12.34"},
+		{in: "12,34", want: "12.34"},
+		{in: "1.234,56", want: "1234.56"},
+		{in: "1,234.56", want: "1234.56"},
+		{in: "-0.10", want: "-0.10"},
+		{in: "", want: "0"},
+		{in: "1e3", wantErr: true},
+		{in: "abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseDecimal(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package reports
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTimeLayouts are the date formats found in Amazon flat-file reports.
+var DefaultTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"02.01.2006 15:04:05 MST",
+	"02.01.2006",
+}
+
+// TSVDecoder decodes the rows of a tab-delimited flat-file report into structs of type T.
+// Columns are mapped to struct fields with the tsv tag, e.g. `tsv:"seller-sku"`. Columns without
+// a matching field are ignored, fields without a matching column keep their zero value.
+//
+// Supported field types are strings, bools, integers, floats, time.Time, types implementing
+// encoding.TextUnmarshaler and pointers to these. Empty cells leave pointers nil.
+type TSVDecoder[T any] struct {
+	reader           *csv.Reader
+	decimalSeparator rune
+	timeLayouts      []string
+	columns          [][]int
+	header           []string
+	line             int
+}
+
+// NewTSVDecoder returns a TSVDecoder reading from r. The first row must contain the column names.
+func NewTSVDecoder[T any](r io.Reader) *TSVDecoder[T] {
+	reader := csv.NewReader(r)
+	reader.Comma = '\t'
+	reader.LazyQuotes = true
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	return &TSVDecoder[T]{
+		reader:           reader,
+		decimalSeparator: '.',
+		timeLayouts:      DefaultTimeLayouts,
+	}
+}
+
+// WithDecimalSeparator sets the decimal separator used for float fields, e.g. ',' for reports of
+// european marketplaces. The respective other character is treated as thousands separator.
+func (d *TSVDecoder[T]) WithDecimalSeparator(separator rune) *TSVDecoder[T] {
+	d.decimalSeparator = separator
+	return d
+}
+
+// WithTimeLayouts overrides the DefaultTimeLayouts that are tried in order to parse time.Time fields.
+func (d *TSVDecoder[T]) WithTimeLayouts(layouts ...string) *TSVDecoder[T] {
+	d.timeLayouts = layouts
+	return d
+}
+
+// Header returns the column names of the report. It is available after the first call of Next.
+func (d *TSVDecoder[T]) Header() []string {
+	return d.header
+}
+
+// Next decodes the next row. It returns io.EOF when there are no more rows.
+func (d *TSVDecoder[T]) Next() (*T, error) {
+	if d.columns == nil {
+		if err := d.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		record, err := d.reader.Read()
+		if err != nil {
+			return nil, err
+		}
+		d.line++
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		row := new(T)
+		v := reflect.ValueOf(row).Elem()
+		for i, value := range record {
+			if i >= len(d.columns) || d.columns[i] == nil {
+				continue
+			}
+			if err = d.setField(v.FieldByIndex(d.columns[i]), value); err != nil {
+				return nil, fmt.Errorf("line %d, column %s: %w", d.line, d.header[i], err)
+			}
+		}
+		return row, nil
+	}
+}
+
+// DecodeTSV decodes all rows of the tab-delimited report read from r.
+func DecodeTSV[T any](r io.Reader) ([]T, error) {
+	return NewTSVDecoder[T](r).ReadAll()
+}
+
+// ReadAll decodes all remaining rows.
+func (d *TSVDecoder[T]) ReadAll() ([]T, error) {
+	var rows []T
+	for {
+		row, err := d.Next()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, *row)
+	}
+}
+
+func (d *TSVDecoder[T]) readHeader() error {
+	header, err := d.reader.Read()
+	if err != nil {
+		return err
+	}
+	d.line++
+	d.header = make([]string, len(header))
+	copy(d.header, header)
+	if len(d.header) > 0 {
+		d.header[0] = strings.TrimPrefix(d.header[0], "\uFEFF")
+	}
+
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("tsv decoding requires a struct type, got %s", t)
+	}
+	fieldByName := map[string][]int{}
+	collectTaggedFields(t, nil, fieldByName)
+
+	d.columns = make([][]int, len(d.header))
+	for i, name := range d.header {
+		d.header[i] = strings.TrimSpace(name)
+		d.columns[i] = fieldByName[normalizeColumnName(name)]
+	}
+	return nil
+}
+
+// collectTaggedFields maps the tsv tags of t, including the ones of embedded structs, to their field index.
+func collectTaggedFields(t reflect.Type, index []int, fieldByName map[string][]int) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldIndex := append(append([]int{}, index...), i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && field.Tag.Get("tsv") == "" {
+			collectTaggedFields(field.Type, fieldIndex, fieldByName)
+			continue
+		}
+
+		tag := field.Tag.Get("tsv")
+		if tag == "" || tag == "-" || !field.IsExported() {
+			continue
+		}
+		if _, exists := fieldByName[normalizeColumnName(tag)]; !exists {
+			fieldByName[normalizeColumnName(tag)] = fieldIndex
+		}
+	}
+}
+
+func normalizeColumnName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// notAvailable is written by reports like GET_FBA_ESTIMATED_FBA_FEES_TXT_DATA for values they don't have.
+const notAvailable = "--"
+
+func (d *TSVDecoder[T]) setField(field reflect.Value, value string) error {
+	value = strings.TrimSpace(value)
+	if value == notAvailable && field.Kind() != reflect.String {
+		value = ""
+	}
+	if field.Kind() == reflect.Pointer {
+		if value == "" {
+			return nil
+		}
+		ptr := reflect.New(field.Type().Elem())
+		if err := d.setField(ptr.Elem(), value); err != nil {
+			return err
+		}
+		field.Set(ptr)
+		return nil
+	}
+
+	if field.Type() == timeType {
+		if value == "" {
+			return nil
+		}
+		t, err := d.parseTime(value)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(t))
+		return nil
+	}
+	if field.CanAddr() && field.Addr().Type().Implements(textUnmarshalerType) {
+		return field.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value))
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+		return nil
+	}
+	if value == "" {
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.Bool:
+		b, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(d.normalizeDecimal(value), field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
+
+func (d *TSVDecoder[T]) normalizeDecimal(value string) string {
+	if d.decimalSeparator == ',' {
+		return strings.ReplaceAll(strings.ReplaceAll(value, ".", ""), ",", ".")
+	}
+	return strings.ReplaceAll(value, ",", "")
+}
+
+func (d *TSVDecoder[T]) parseTime(value string) (time.Time, error) {
+	for _, layout := range d.timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("cannot parse %q as time", value)
+}
+
+func parseBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "yes", "y":
+		return true, nil
+	case "no", "n":
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
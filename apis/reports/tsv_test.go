@@ -0,0 +1,68 @@
+package reports
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type inventoryRow struct {
+	SKU        string     `tsv:"seller-sku"`
+	Quantity   int        `tsv:"quantity"`
+	Price      float64    `tsv:"price"`
+	Fulfilled  *bool      `tsv:"afn-listing-exists"`
+	Note       *string    `tsv:"note"`
+	OpenedAt   time.Time  `tsv:"open-date"`
+	ClosedAt   *time.Time `tsv:"close-date"`
+	Unexported string
+}
+
+func TestTSVDecoder_Next(t *testing.T) {
+	doc := "\uFEFFseller-sku\tquantity\tprice\tafn-listing-exists\tnote\topen-date\tclose-date\tunknown\n" +
+		"SKU-1\t3\t1,234.50\tYes\t\"quoted\ttab\"\t2024-01-02 10:00:00 UTC\t\tx\n" +
+		"\n" +
+		"SKU-2\t0\t9.99\tNo\t\t2024-01-03T08:30:00+01:00\t2024-02-01\n"
+
+	dec := NewTSVDecoder[inventoryRow](strings.NewReader(doc))
+
+	first, err := dec.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "SKU-1", first.SKU)
+	assert.Equal(t, 3, first.Quantity)
+	assert.Equal(t, 1234.5, first.Price)
+	assert.True(t, *first.Fulfilled)
+	assert.Equal(t, "quoted\ttab", *first.Note)
+	assert.Equal(t, time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC), first.OpenedAt.UTC())
+	assert.Nil(t, first.ClosedAt)
+	assert.Equal(t, "seller-sku", dec.Header()[0])
+
+	second, err := dec.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "SKU-2", second.SKU)
+	assert.False(t, *second.Fulfilled)
+	assert.Nil(t, second.Note)
+	assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), *second.ClosedAt)
+
+	_, err = dec.Next()
+	assert.True(t, errors.Is(err, io.EOF))
+}
+
+func TestTSVDecoder_WithDecimalSeparator(t *testing.T) {
+	doc := "seller-sku\tprice\nSKU-1\t1.234,50\n"
+
+	row, err := NewTSVDecoder[inventoryRow](strings.NewReader(doc)).WithDecimalSeparator(',').Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 1234.5, row.Price)
+}
+
+func TestTSVDecoder_InvalidValue(t *testing.T) {
+	doc := "seller-sku\tquantity\nSKU-1\tmany\n"
+
+	_, err := NewTSVDecoder[inventoryRow](strings.NewReader(doc)).Next()
+
+	assert.ErrorContains(t, err, "line 2, column quantity")
+}
@@ -0,0 +1,127 @@
+package reports
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"time"
+)
+
+// XMLOrder is an <Order> element of the XML all orders reports
+// (FBAXMLAllOrdersReportbyOrderDate and FBAXMLAllOrdersReportbyLastUpdate).
+type XMLOrder struct {
+	AmazonOrderID       string             `xml:"AmazonOrderID"`
+	MerchantOrderID     string             `xml:"MerchantOrderID"`
+	PurchaseDate        time.Time          `xml:"PurchaseDate"`
+	LastUpdatedDate     time.Time          `xml:"LastUpdatedDate"`
+	OrderStatus         string             `xml:"OrderStatus"`
+	SalesChannel        string             `xml:"SalesChannel"`
+	OrderChannel        string             `xml:"OrderChannel"`
+	URL                 string             `xml:"URL"`
+	FulfillmentData     XMLFulfillmentData `xml:"FulfillmentData"`
+	IsBusinessOrder     bool               `xml:"IsBusinessOrder"`
+	PurchaseOrderNumber string             `xml:"PurchaseOrderNumber"`
+	PriceDesignation    string             `xml:"PriceDesignation"`
+	IsReplacementOrder  bool               `xml:"IsReplacementOrder"`
+	OrderItems          []XMLOrderItem     `xml:"OrderItem"`
+}
+
+// XMLFulfillmentData contains the fulfillment channel and the anonymized shipping address of an order.
+type XMLFulfillmentData struct {
+	FulfillmentChannel string     `xml:"FulfillmentChannel"`
+	ShipServiceLevel   string     `xml:"ShipServiceLevel"`
+	Address            XMLAddress `xml:"Address"`
+}
+
+// XMLAddress is the shipping address of an order. The report does not contain personal information.
+type XMLAddress struct {
+	City       string `xml:"City"`
+	State      string `xml:"State"`
+	PostalCode string `xml:"PostalCode"`
+	Country    string `xml:"Country"`
+}
+
+// XMLOrderItem is an <OrderItem> element of an order.
+type XMLOrderItem struct {
+	AmazonOrderItemCode string            `xml:"AmazonOrderItemCode"`
+	ASIN                string            `xml:"ASIN"`
+	SKU                 string            `xml:"SKU"`
+	ItemStatus          string            `xml:"ItemStatus"`
+	ProductName         string            `xml:"ProductName"`
+	Quantity            int               `xml:"Quantity"`
+	ItemPrice           []XMLComponent    `xml:"ItemPrice>Component"`
+	Promotions          []XMLPromotion    `xml:"Promotion"`
+	CustomizationInfo   *XMLCustomization `xml:"CustomizationInfo"`
+}
+
+// XMLComponent is a price component of an order item, e.g. Principal, Tax or Shipping.
+type XMLComponent struct {
+	Type   string    `xml:"Type"`
+	Amount XMLAmount `xml:"Amount"`
+}
+
+// XMLAmount is an exact amount with its currency.
+type XMLAmount struct {
+	Currency string  `xml:"currency,attr"`
+	Value    Decimal `xml:",chardata"`
+}
+
+// XMLPromotion contains the promotion discounts applied to an order item.
+type XMLPromotion struct {
+	PromotionIDs          string    `xml:"PromotionIDs"`
+	ShipPromotionDiscount XMLAmount `xml:"ShipPromotionDiscount"`
+	ItemPromotionDiscount XMLAmount `xml:"ItemPromotionDiscount"`
+}
+
+// XMLCustomization contains the customization of an order item, if any.
+type XMLCustomization struct {
+	Type string `xml:"Type"`
+	Data string `xml:"Data"`
+}
+
+// XMLOrdersDecoder streams the orders of an XML all orders report one <Order> element at a time.
+type XMLOrdersDecoder struct {
+	dec *xml.Decoder
+}
+
+// NewXMLOrdersDecoder returns a XMLOrdersDecoder reading from r.
+func NewXMLOrdersDecoder(r io.Reader) *XMLOrdersDecoder {
+	return &XMLOrdersDecoder{dec: xml.NewDecoder(r)}
+}
+
+// Next decodes the next order. It returns io.EOF when there are no more orders.
+func (d *XMLOrdersDecoder) Next() (*XMLOrder, error) {
+	for {
+		token, err := d.dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "Order" {
+			continue
+		}
+
+		order := &XMLOrder{}
+		if err = d.dec.DecodeElement(order, &start); err != nil {
+			return nil, err
+		}
+		return order, nil
+	}
+}
+
+// ParseXMLAllOrdersReport decodes all orders of an XML all orders report.
+func ParseXMLAllOrdersReport(r io.Reader) ([]XMLOrder, error) {
+	dec := NewXMLOrdersDecoder(r)
+	var orders []XMLOrder
+	for {
+		order, err := dec.Next()
+		if errors.Is(err, io.EOF) {
+			return orders, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, *order)
+	}
+}
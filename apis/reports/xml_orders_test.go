@@ -0,0 +1,62 @@
+package reports
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseXMLAllOrdersReport(t *testing.T) {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<AmazonEnvelope xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:noNamespaceSchemaLocation="amzn-envelope.xsd">
+  <Header><DocumentVersion>1.00</DocumentVersion></Header>
+  <MessageType>AllOrdersReport</MessageType>
+  <Message>
+    <Order>
+      <AmazonOrderID>028-1234567-1234567</AmazonOrderID>
+      <PurchaseDate>2024-01-02T10:00:00+00:00</PurchaseDate>
+      <LastUpdatedDate>2024-01-03T10:00:00+00:00</LastUpdatedDate>
+      <OrderStatus>Shipped</OrderStatus>
+      <SalesChannel>Amazon.de</SalesChannel>
+      <FulfillmentData>
+        <FulfillmentChannel>Amazon</FulfillmentChannel>
+        <Address><City>Berlin</City><PostalCode>10115</PostalCode><Country>DE</Country></Address>
+      </FulfillmentData>
+      <IsBusinessOrder>false</IsBusinessOrder>
+      <OrderItem>
+        <ASIN>B000000001</ASIN>
+        <SKU>SKU-1</SKU>
+        <Quantity>2</Quantity>
+        <ItemPrice>
+          <Component><Type>Principal</Type><Amount currency="EUR">39.98</Amount></Component>
+          <Component><Type>Tax</Type><Amount currency="EUR">6.38</Amount></Component>
+        </ItemPrice>
+      </OrderItem>
+    </Order>
+  </Message>
+  <Message>
+    <Order>
+      <AmazonOrderID>028-7654321-7654321</AmazonOrderID>
+      <PurchaseDate>2024-01-02T11:00:00+00:00</PurchaseDate>
+      <LastUpdatedDate>2024-01-02T11:00:00+00:00</LastUpdatedDate>
+      <OrderStatus>Pending</OrderStatus>
+    </Order>
+  </Message>
+</AmazonEnvelope>`
+
+	orders, err := ParseXMLAllOrdersReport(strings.NewReader(doc))
+
+	assert.NoError(t, err)
+	assert.Len(t, orders, 2)
+	assert.Equal(t, "028-1234567-1234567", orders[0].AmazonOrderID)
+	assert.Equal(t, time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC), orders[0].PurchaseDate.UTC())
+	assert.Equal(t, "Berlin", orders[0].FulfillmentData.Address.City)
+	assert.Len(t, orders[0].OrderItems, 1)
+	assert.Equal(t, 2, orders[0].OrderItems[0].Quantity)
+	assert.Equal(t, "EUR", orders[0].OrderItems[0].ItemPrice[0].Amount.Currency)
+	assert.Equal(t, "39.98", orders[0].OrderItems[0].ItemPrice[0].Amount.Value.String())
+	assert.Equal(t, "Pending", orders[1].OrderStatus)
+	assert.Empty(t, orders[1].OrderItems)
+}
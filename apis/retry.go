@@ -0,0 +1,118 @@
+package apis
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+// RetryPolicy configures the retries of calls answered with 429, 500, 502 or 503 and of calls
+// failing with transient network errors. The backoff starts at InitialBackoff and doubles with
+// each attempt up to MaxBackoff. Calls answered with 429 wait at least the rate limit of the operation.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the first one.
+	MaxAttempts int
+	// MaxElapsedTime stops retrying once the next attempt would start later. Zero means no limit.
+	MaxElapsedTime time.Duration
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Jitter randomizes each backoff by up to this fraction, e.g. 0.2 for ±20%.
+	Jitter float64
+	// OnRetry is called for each failed attempt before waiting for the retry, and when the retries
+	// are exhausted, e.g. to alert on throttling or back off job schedulers. Optional.
+	OnRetry func(RetryEvent)
+}
+
+// RetryEvent describes a failed attempt of a call passed to RetryPolicy.OnRetry.
+type RetryEvent struct {
+	// Operation is the operation of the call, e.g. "orders.GetOrders".
+	Operation string
+	// Attempt is the number of the failed attempt, starting at 1.
+	Attempt int
+	// Wait is the duration until the next attempt, or 0 if GaveUp.
+	Wait time.Duration
+	// StatusCode is the status of the response, or 0 if the attempt failed with Err.
+	StatusCode int
+	Err        error
+	// Throttled is true if the attempt was answered with 429 Too Many Requests.
+	Throttled bool
+	// GaveUp is true if the call isn't retried anymore because the RetryPolicy is exhausted.
+	GaveUp bool
+}
+
+// DefaultRetryPolicy is used if the HTTP client doesn't configure a RetryPolicy.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    constants.MaxRetryCountOnTooManyRequestsError,
+		InitialBackoff: constants.DefaultWaitDurationOnTooManyRequestsError,
+		MaxBackoff:     constants.MaxRetryBackoff,
+		Jitter:         constants.DefaultRetryJitter,
+	}
+}
+
+// RetryingHTTPClient is implemented by HTTP clients with a configurable RetryPolicy.
+type RetryingHTTPClient interface {
+	RetryPolicy() RetryPolicy
+}
+
+func retryPolicyOf(httpClient HTTPClient) RetryPolicy {
+	if retryingClient, ok := httpClient.(RetryingHTTPClient); ok {
+		return retryingClient.RetryPolicy()
+	}
+	return DefaultRetryPolicy()
+}
+
+// jitterFunc as variable for mocking, returns a random number in [0, 1)
+var jitterFunc = rand.Float64
+
+// backoff returns the wait time before the given retry, starting at 1.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < retry && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 {
+		backoff = min(backoff, p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		backoff += time.Duration(float64(backoff) * p.Jitter * (2*jitterFunc() - 1))
+	}
+	return backoff
+}
+
+func (p RetryPolicy) notify(event RetryEvent) {
+	if p.OnRetry != nil {
+		p.OnRetry(event)
+	}
+}
+
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable:
+		return true
+	}
+	return false
+}
+
+// isTransientNetworkError reports whether the request failed because of the network, e.g. a timeout or
+// a reset connection, and not because the context is done.
+func isTransientNetworkError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
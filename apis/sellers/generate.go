@@ -0,0 +1,3 @@
+package sellers
+
+//go:generate go run ../../internal/modelgen -config modelgen.json -out model.go
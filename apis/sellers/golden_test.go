@@ -0,0 +1,13 @@
+package sellers
+
+import (
+	"testing"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/internal/golden"
+)
+
+func TestGoldenFixtures(t *testing.T) {
+	t.Run("getMarketplaceParticipations", func(t *testing.T) {
+		golden.RoundTrip[GetMarketplaceParticipationsResponse](t, "testdata/getMarketplaceParticipations.json")
+	})
+}
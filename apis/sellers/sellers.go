@@ -0,0 +1,39 @@
+package sellers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/sellers/v1"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.SellersClient for tests.
+type Client interface {
+	GetMarketplaceParticipations(ctx context.Context) (*apis.CallResponse[GetMarketplaceParticipationsResponse], error)
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// GetMarketplaceParticipations returns the marketplaces the seller can sell in and the participation
+// of the seller in each of them.
+func (a *API) GetMarketplaceParticipations(ctx context.Context) (*apis.CallResponse[GetMarketplaceParticipationsResponse], error) {
+	return apis.NewCall[GetMarketplaceParticipationsResponse](http.MethodGet, pathPrefix+"/marketplaceParticipations").
+		WithParseErrorListOnError().
+		WithRateLimit(0.016, time.Second).
+		WithBurst(15).
+		Execute(ctx, a.httpClient)
+}
@@ -0,0 +1,221 @@
+package sellerwallet
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+// BankAccountOwnershipType The type of ownership of the bank account.
+type BankAccountOwnershipType string
+
+const (
+	BankAccountOwnershipTypeSelf       BankAccountOwnershipType = "SELF"
+	BankAccountOwnershipTypeThirdParty BankAccountOwnershipType = "THIRD_PARTY"
+	BankAccountOwnershipTypeUnknown    BankAccountOwnershipType = "UNKNOWN"
+)
+
+// BankAccountNumberFormat The format of the bank account number.
+type BankAccountNumberFormat string
+
+const (
+	BankAccountNumberFormatIBAN  BankAccountNumberFormat = "IBAN"
+	BankAccountNumberFormatBBAN  BankAccountNumberFormat = "BBAN"
+	BankAccountNumberFormatOther BankAccountNumberFormat = "OTHER"
+)
+
+// BalanceType The type of the balance.
+type BalanceType string
+
+const (
+	BalanceTypeAvailable BalanceType = "AVAILABLE"
+	BalanceTypeReserved  BalanceType = "RESERVED"
+)
+
+// TransactionType The type of a transaction.
+type TransactionType string
+
+const (
+	TransactionTypeCredit TransactionType = "CREDIT"
+	TransactionTypeDebit  TransactionType = "DEBIT"
+)
+
+// TransactionStatus The current status of a transaction.
+type TransactionStatus string
+
+const (
+	TransactionStatusFailed     TransactionStatus = "FAILED"
+	TransactionStatusSuccessful TransactionStatus = "SUCCESSFUL"
+	TransactionStatusInitiated  TransactionStatus = "INITIATED"
+	TransactionStatusReversed   TransactionStatus = "REVERSED"
+)
+
+// ListTransactionsFilter is used to filter the ListAccountTransactions call.
+type ListTransactionsFilter struct {
+	// AccountID is the ID of the Amazon Seller Wallet account. Required.
+	AccountID string
+	// MarketplaceID is the marketplace for which items are returned. Required.
+	MarketplaceID constants.MarketplaceID
+	// NextPageToken is the pagination token to retrieve a specific page of results.
+	NextPageToken string
+}
+
+func (f *ListTransactionsFilter) GetQuery() url.Values {
+	q := url.Values{}
+	q.Add("accountId", f.AccountID)
+	q.Add("marketplaceId", string(f.MarketplaceID))
+	if f.NextPageToken != "" {
+		q.Add("nextPageToken", f.NextPageToken)
+	}
+	return q
+}
+
+// Currency A currency type and amount.
+type Currency struct {
+	// The three-digit currency code in ISO 4217 format.
+	CurrencyCode string `json:"currencyCode"`
+	// The monetary value.
+	CurrencyAmount json.Number `json:"currencyAmount"`
+}
+
+// BankAccount Details of an Amazon Seller Wallet bank account or a third party bank account.
+type BankAccount struct {
+	// The unique bank account identifier provided by Amazon.
+	AccountID *string `json:"accountId,omitempty"`
+	// The bank account holder's name.
+	AccountHolderName        *string                   `json:"accountHolderName,omitempty"`
+	BankAccountNumberFormat  BankAccountNumberFormat   `json:"bankAccountNumberFormat"`
+	BankAccountOwnershipType *BankAccountOwnershipType `json:"bankAccountOwnershipType,omitempty"`
+	// The name of the bank.
+	BankName *string `json:"bankName,omitempty"`
+	// Routing number for automated clearing house transfers for all Amazon Seller Wallet accounts.
+	RoutingNumber *string `json:"routingNumber,omitempty"`
+	// The two-digit country code in ISO 3166-1 alpha-2 format.
+	AccountCountryCode string `json:"accountCountryCode"`
+	// The currency code in ISO 4217 format.
+	AccountCurrency string `json:"accountCurrency"`
+	// The last three digits of the bank account number.
+	BankAccountNumberTail *string `json:"bankAccountNumberTail,omitempty"`
+}
+
+// BankAccountListing A list of bank accounts.
+type BankAccountListing struct {
+	Accounts []BankAccount `json:"accounts"`
+	// If present, use this pagination token to retrieve the next page.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+}
+
+// Balance The balance amount of an Amazon Seller Wallet account.
+type Balance struct {
+	// The unique identifier provided by Amazon to identify the account.
+	AccountID   string      `json:"accountId"`
+	BalanceType BalanceType `json:"balanceType"`
+	// The balance amount of the account.
+	BalanceAmount json.Number `json:"balanceAmount"`
+	// The currency code in ISO 4217 format.
+	BalanceCurrency string `json:"balanceCurrency"`
+	// The time at which the balance was last updated.
+	LastUpdateDate time.Time `json:"lastUpdateDate"`
+}
+
+// BalanceListing A list of balances of an Amazon Seller Wallet account.
+type BalanceListing struct {
+	Balances []Balance `json:"balances"`
+	// If present, use this pagination token to retrieve the next page.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+}
+
+// TransferRateDetails The fees and rates applied to a transaction.
+type TransferRateDetails struct {
+	BaseRate           *Currency `json:"baseRate,omitempty"`
+	EffectiveFxRate    *float64  `json:"effectiveFxRate,omitempty"`
+	ForexRate          *float64  `json:"forexRate,omitempty"`
+	TransactionFeeRate *float64  `json:"transactionFeeRate,omitempty"`
+	TransactionFee     *Currency `json:"transactionFee,omitempty"`
+}
+
+// TransactionAccount Details of a bank account involved in a transaction.
+type TransactionAccount struct {
+	// The unique identifier provided by Amazon to identify the account.
+	AccountID *string `json:"accountId,omitempty"`
+	// The bank account holder's name.
+	BankAccountHolderName *string `json:"bankAccountHolderName,omitempty"`
+	// The name of the bank.
+	BankName                *string                  `json:"bankName,omitempty"`
+	BankAccountNumberFormat *BankAccountNumberFormat `json:"bankAccountNumberFormat,omitempty"`
+	// The last three digits of the bank account number.
+	BankAccountNumberTail *string `json:"bankAccountNumberTail,omitempty"`
+	// The bank account's currency code in ISO 4217 format.
+	BankAccountCurrency *string `json:"bankAccountCurrency,omitempty"`
+}
+
+// Transaction The current transaction status and historical details of a transaction.
+type Transaction struct {
+	// The unique identifier provided by Amazon to the transaction.
+	TransactionID     string            `json:"transactionId"`
+	TransactionType   TransactionType   `json:"transactionType"`
+	TransactionStatus TransactionStatus `json:"transactionStatus"`
+	// The date on which the transaction was initiated.
+	TransactionRequestDate time.Time `json:"transactionRequestDate"`
+	// The expected completion date of the transaction.
+	ExpectedCompletionDate *time.Time `json:"expectedCompletionDate,omitempty"`
+	// The date on which the transaction was completed.
+	TransactionActualCompletionDate *time.Time `json:"transactionActualCompletionDate,omitempty"`
+	// The last update date on the transaction.
+	LastUpdateDate time.Time `json:"lastUpdateDate"`
+	// The name of the requester who initiated the transaction.
+	RequesterName *string `json:"requesterName,omitempty"`
+	// The source of the transaction request, e.g. the name of the 3P application.
+	TransactionRequesterSource string `json:"transactionRequesterSource"`
+	// A description of the transaction that the requester provides.
+	TransactionDescription        string               `json:"transactionDescription"`
+	TransactionSourceAccount      *TransactionAccount  `json:"transactionSourceAccount,omitempty"`
+	TransactionDestinationAccount TransactionAccount   `json:"transactionDestinationAccount"`
+	TransactionRequestAmount      Currency             `json:"transactionRequestAmount"`
+	TransferRateDetails           *TransferRateDetails `json:"transferRateDetails,omitempty"`
+	TransactionFinalAmount        *Currency            `json:"transactionFinalAmount,omitempty"`
+	// The reason why the transaction failed, if applicable.
+	TransactionFailureReason *string `json:"transactionFailureReason,omitempty"`
+}
+
+// TransactionListing A list of transactions.
+type TransactionListing struct {
+	Transactions []Transaction `json:"transactions"`
+	// If present, use this pagination token to retrieve the next page.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+}
+
+// TransactionInstrumentDetails Details of the destination bank account of a transaction.
+type TransactionInstrumentDetails struct {
+	BankAccount BankAccount `json:"bankAccount"`
+	// The bank account number of the destination payment method.
+	BankAccountNumber string `json:"bankAccountNumber"`
+	// The name of the bank account holder.
+	AccountHolderName string `json:"accountHolderName"`
+}
+
+// TransactionInitiationRequest The request schema for the CreateTransaction operation.
+type TransactionInitiationRequest struct {
+	// The unique identifier of the source Amazon Seller Wallet bank account from which money is debited.
+	SourceAccountID string `json:"sourceAccountId"`
+	// The unique identifier of the destination bank account where the money is deposited.
+	DestinationAccountID             *string                      `json:"destinationAccountId,omitempty"`
+	DestinationTransactionInstrument TransactionInstrumentDetails `json:"destinationTransactionInstrument"`
+	// A description of the transaction.
+	TransactionDescription *string `json:"transactionDescription,omitempty"`
+	// The time at which the transaction was initiated.
+	RequestTime         apis.JsonTimeISO8601 `json:"requestTime"`
+	SourceAmount        Currency             `json:"sourceAmount"`
+	TransferRateDetails *TransferRateDetails `json:"transferRateDetails,omitempty"`
+}
+
+// CreateTransactionSignatures contains the digital signatures required by the CreateTransaction operation.
+type CreateTransactionSignatures struct {
+	// DestinationAccount is the digital signature of the destination bank account details.
+	DestinationAccount string
+	// Amount is the digital signature of the source currency transaction amount.
+	Amount string
+}
@@ -0,0 +1,113 @@
+package sellerwallet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/finances/transfers/wallet/2024-03-01"
+
+const (
+	destAccountSignatureHeader = "x-amzn-dest-account-digital-signature"
+	amountSignatureHeader      = "x-amzn-amount-digital-signature"
+)
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.SellerwalletClient for tests.
+type Client interface {
+	ListAccounts(ctx context.Context, marketplaceID constants.MarketplaceID) (*apis.CallResponse[BankAccountListing], error)
+	GetAccount(ctx context.Context, accountID string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[BankAccount], error)
+	ListAccountBalances(ctx context.Context, accountID string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[BalanceListing], error)
+	ListAccountTransactions(ctx context.Context, filter *ListTransactionsFilter) (*apis.CallResponse[TransactionListing], error)
+	CreateTransaction(ctx context.Context, marketplaceID constants.MarketplaceID, request *TransactionInitiationRequest, signatures CreateTransactionSignatures) (*apis.CallResponse[Transaction], error)
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// ListAccounts returns all Amazon Seller Wallet accounts of the selling partner in the given marketplace.
+func (a *API) ListAccounts(ctx context.Context, marketplaceID constants.MarketplaceID) (*apis.CallResponse[BankAccountListing], error) {
+	return apis.NewCall[BankAccountListing](http.MethodGet, pathPrefix+"/accounts").
+		WithQueryParams(marketplaceQuery(marketplaceID)).
+		WithParseErrorListOnError().
+		WithRateLimit(30, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetAccount returns the Amazon Seller Wallet account with the given accountID.
+func (a *API) GetAccount(ctx context.Context, accountID string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[BankAccount], error) {
+	return apis.NewCall[BankAccount](http.MethodGet, pathPrefix+"/accounts/{accountID}").
+		WithPathParam("accountID", accountID).
+		WithQueryParams(marketplaceQuery(marketplaceID)).
+		WithParseErrorListOnError().
+		WithRateLimit(30, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// ListAccountBalances returns the balances of the Amazon Seller Wallet account with the given accountID.
+func (a *API) ListAccountBalances(ctx context.Context, accountID string, marketplaceID constants.MarketplaceID) (*apis.CallResponse[BalanceListing], error) {
+	return apis.NewCall[BalanceListing](http.MethodGet, pathPrefix+"/accounts/{accountID}/balance").
+		WithPathParam("accountID", accountID).
+		WithQueryParams(marketplaceQuery(marketplaceID)).
+		WithParseErrorListOnError().
+		WithRateLimit(30, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// ListAccountTransactions returns the transactions of an Amazon Seller Wallet account.
+func (a *API) ListAccountTransactions(ctx context.Context, filter *ListTransactionsFilter) (*apis.CallResponse[TransactionListing], error) {
+	if filter.AccountID == "" || filter.MarketplaceID == "" {
+		return nil, errors.New("accountID and marketplaceID are required")
+	}
+
+	return apis.NewCall[TransactionListing](http.MethodGet, pathPrefix+"/transactions").
+		WithQueryParams(filter.GetQuery()).
+		WithParseErrorListOnError().
+		WithRateLimit(30, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// CreateTransaction initiates a transfer from an Amazon Seller Wallet account to another bank account.
+// The destination account details and the amount must be signed, see CreateTransactionSignatures.
+func (a *API) CreateTransaction(ctx context.Context, marketplaceID constants.MarketplaceID, request *TransactionInitiationRequest, signatures CreateTransactionSignatures) (*apis.CallResponse[Transaction], error) {
+	if signatures.DestinationAccount == "" || signatures.Amount == "" {
+		return nil, errors.New("destination account and amount signatures are required")
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[Transaction](http.MethodPost, pathPrefix+"/transactions").
+		WithIdempotencyToken().
+		WithQueryParams(marketplaceQuery(marketplaceID)).
+		WithBody(body).
+		WithHeader(destAccountSignatureHeader, signatures.DestinationAccount).
+		WithHeader(amountSignatureHeader, signatures.Amount).
+		WithParseErrorListOnError().
+		WithRateLimit(30, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+func marketplaceQuery(marketplaceID constants.MarketplaceID) url.Values {
+	q := url.Values{}
+	q.Add("marketplaceId", string(marketplaceID))
+	return q
+}
@@ -0,0 +1,254 @@
+package supplysources
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// SupplySourceStatus The status of a supply source.
+type SupplySourceStatus string
+
+const (
+	SupplySourceStatusActive   SupplySourceStatus = "Active"
+	SupplySourceStatusInactive SupplySourceStatus = "Inactive"
+	SupplySourceStatusArchived SupplySourceStatus = "Archived"
+)
+
+// TimeUnit The time unit of a duration or throughput cap.
+type TimeUnit string
+
+const (
+	TimeUnitHours TimeUnit = "Hours"
+	TimeUnitDays  TimeUnit = "Days"
+)
+
+// ThroughputUnit The throughput unit.
+type ThroughputUnit string
+
+const (
+	ThroughputUnitOrder ThroughputUnit = "Order"
+)
+
+// GetSupplySourcesFilter contains the paging parameters of the GetSupplySources call.
+type GetSupplySourcesFilter struct {
+	// NextPageToken is the pagination token to retrieve a specific page of results.
+	NextPageToken string
+	// PageSize is the number of supply sources to return per paginated request. Must be between 1 and 50, defaults to 10.
+	PageSize int
+}
+
+func (f *GetSupplySourcesFilter) GetQuery() url.Values {
+	q := url.Values{}
+	if f.NextPageToken != "" {
+		q.Add("nextPageToken", f.NextPageToken)
+	}
+	if f.PageSize != 0 {
+		q.Add("pageSize", strconv.Itoa(f.PageSize))
+	}
+	return q
+}
+
+// Address A physical address.
+type Address struct {
+	// The name of the person, business or institution at the address.
+	Name string `json:"name"`
+	// The first line of the address.
+	AddressLine1 string `json:"addressLine1"`
+	// Additional address information, if required.
+	AddressLine2 *string `json:"addressLine2,omitempty"`
+	// Additional address information, if required.
+	AddressLine3 *string `json:"addressLine3,omitempty"`
+	// The city where the person, business or institution is located.
+	City *string `json:"city,omitempty"`
+	// The county where the person, business or institution is located.
+	County *string `json:"county,omitempty"`
+	// The district where the person, business or institution is located.
+	District *string `json:"district,omitempty"`
+	// The state or region where the person, business or institution is located.
+	StateOrRegion *string `json:"stateOrRegion,omitempty"`
+	// The postal code of the address.
+	PostalCode *string `json:"postalCode,omitempty"`
+	// The two digit country code. In ISO 3166-1 alpha-2 format.
+	CountryCode string `json:"countryCode"`
+	// The phone number of the person, business or institution located at the address.
+	Phone *string `json:"phone,omitempty"`
+}
+
+// AddressWithContact The address and contact details.
+type AddressWithContact struct {
+	ContactDetails *ContactDetails `json:"contactDetails,omitempty"`
+	Address        *Address        `json:"address,omitempty"`
+}
+
+// ContactDetails The contact details.
+type ContactDetails struct {
+	Primary *PrimaryContact `json:"primary,omitempty"`
+}
+
+// PrimaryContact The primary contact of a supply source.
+type PrimaryContact struct {
+	// The email address to which email messages are delivered.
+	Email *string `json:"email,omitempty"`
+	// The phone number of the person, business or institution.
+	Phone *string `json:"phone,omitempty"`
+}
+
+// Duration The duration of time.
+type Duration struct {
+	// An unsigned integer that can be only positive or zero.
+	Value    *int      `json:"value,omitempty"`
+	TimeUnit *TimeUnit `json:"timeUnit,omitempty"`
+}
+
+// OperatingHour The operating hour schema.
+type OperatingHour struct {
+	// The opening time, ISO 8601 formatted timestamp without date, HH:mm.
+	StartTime *string `json:"startTime,omitempty"`
+	// The closing time, ISO 8601 formatted timestamp without date, HH:mm.
+	EndTime *string `json:"endTime,omitempty"`
+}
+
+// OperatingHoursByDay The operating hours per day.
+type OperatingHoursByDay struct {
+	Monday    []OperatingHour `json:"monday,omitempty"`
+	Tuesday   []OperatingHour `json:"tuesday,omitempty"`
+	Wednesday []OperatingHour `json:"wednesday,omitempty"`
+	Thursday  []OperatingHour `json:"thursday,omitempty"`
+	Friday    []OperatingHour `json:"friday,omitempty"`
+	Saturday  []OperatingHour `json:"saturday,omitempty"`
+	Sunday    []OperatingHour `json:"sunday,omitempty"`
+}
+
+// ThroughputCap The throughput capacity.
+type ThroughputCap struct {
+	// An unsigned integer that can be only positive or zero.
+	Value    *int      `json:"value,omitempty"`
+	TimeUnit *TimeUnit `json:"timeUnit,omitempty"`
+}
+
+// ThroughputConfig The throughput configuration.
+type ThroughputConfig struct {
+	ThroughputCap  *ThroughputCap `json:"throughputCap,omitempty"`
+	ThroughputUnit ThroughputUnit `json:"throughputUnit"`
+}
+
+// OperationalConfiguration The operational configuration of supply sources.
+type OperationalConfiguration struct {
+	ContactDetails      *ContactDetails      `json:"contactDetails,omitempty"`
+	ThroughputConfig    *ThroughputConfig    `json:"throughputConfig,omitempty"`
+	OperatingHoursByDay *OperatingHoursByDay `json:"operatingHoursByDay,omitempty"`
+	HandlingTime        *Duration            `json:"handlingTime,omitempty"`
+}
+
+// SupplySourceConfiguration Includes configuration and timezone of a supply source.
+type SupplySourceConfiguration struct {
+	OperationalConfiguration *OperationalConfiguration `json:"operationalConfiguration,omitempty"`
+	// Please see RFC 6557, should be a canonical time zone ID as listed here: https://www.joda.org/joda-time/timezones.html.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// ReturnLocation The address or reference to another supplySourceId to be used for returns.
+type ReturnLocation struct {
+	// The Amazon provided supplySourceId where orders can be returned to.
+	SupplySourceID     *string             `json:"supplySourceId,omitempty"`
+	AddressWithContact *AddressWithContact `json:"addressWithContact,omitempty"`
+}
+
+// DeliveryChannel The delivery channel of a supply source.
+type DeliveryChannel struct {
+	IsSupported              *bool                     `json:"isSupported,omitempty"`
+	OperationalConfiguration *OperationalConfiguration `json:"operationalConfiguration,omitempty"`
+}
+
+// PickupChannel The pick up channel of a supply source.
+type PickupChannel struct {
+	InventoryHoldPeriod      *Duration                 `json:"inventoryHoldPeriod,omitempty"`
+	IsSupported              *bool                     `json:"isSupported,omitempty"`
+	OperationalConfiguration *OperationalConfiguration `json:"operationalConfiguration,omitempty"`
+}
+
+// OutboundCapability The outbound capability of a supply source.
+type OutboundCapability struct {
+	IsSupported              *bool                     `json:"isSupported,omitempty"`
+	OperationalConfiguration *OperationalConfiguration `json:"operationalConfiguration,omitempty"`
+	ReturnLocation           *ReturnLocation           `json:"returnLocation,omitempty"`
+	DeliveryChannel          *DeliveryChannel          `json:"deliveryChannel,omitempty"`
+	PickupChannel            *PickupChannel            `json:"pickupChannel,omitempty"`
+}
+
+// ServicesCapability The services capability of a supply source.
+type ServicesCapability struct {
+	IsSupported              *bool                     `json:"isSupported,omitempty"`
+	OperationalConfiguration *OperationalConfiguration `json:"operationalConfiguration,omitempty"`
+}
+
+// SupplySourceCapabilities The capabilities of a supply source.
+type SupplySourceCapabilities struct {
+	Outbound *OutboundCapability `json:"outbound,omitempty"`
+	Services *ServicesCapability `json:"services,omitempty"`
+}
+
+// SupplySourcesItem The summary of a supply source as returned by GetSupplySources.
+type SupplySourcesItem struct {
+	// The custom alias for this supply location.
+	Alias *string `json:"alias,omitempty"`
+	// An Amazon generated unique supply source ID.
+	SupplySourceID *string `json:"supplySourceId,omitempty"`
+	// The seller-provided unique supply source code.
+	SupplySourceCode *string  `json:"supplySourceCode,omitempty"`
+	Address          *Address `json:"address,omitempty"`
+}
+
+// GetSupplySourcesResponse The paginated list of supply sources.
+type GetSupplySourcesResponse struct {
+	SupplySources []SupplySourcesItem `json:"supplySources,omitempty"`
+	// If present, use this pagination token to retrieve the next page of supply sources.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+}
+
+// SupplySource The supply source details.
+type SupplySource struct {
+	// An Amazon generated unique supply source ID.
+	SupplySourceID *string `json:"supplySourceId,omitempty"`
+	// The seller-provided unique supply source code.
+	SupplySourceCode *string `json:"supplySourceCode,omitempty"`
+	// The custom alias for this supply location.
+	Alias         *string                    `json:"alias,omitempty"`
+	Status        *SupplySourceStatus        `json:"status,omitempty"`
+	Address       *Address                   `json:"address,omitempty"`
+	Configuration *SupplySourceConfiguration `json:"configuration,omitempty"`
+	Capabilities  *SupplySourceCapabilities  `json:"capabilities,omitempty"`
+	CreatedAt     *time.Time                 `json:"createdAt,omitempty"`
+	UpdatedAt     *time.Time                 `json:"updatedAt,omitempty"`
+}
+
+// CreateSupplySourceRequest A request to create a supply source.
+type CreateSupplySourceRequest struct {
+	// The seller-provided unique supply source code.
+	SupplySourceCode string `json:"supplySourceCode"`
+	// The custom alias for this supply location.
+	Alias   string  `json:"alias"`
+	Address Address `json:"address"`
+}
+
+// CreateSupplySourceResponse The result of creating a new supply source.
+type CreateSupplySourceResponse struct {
+	// An Amazon generated unique supply source ID.
+	SupplySourceID string `json:"supplySourceId"`
+	// The seller-provided unique supply source code.
+	SupplySourceCode string `json:"supplySourceCode"`
+}
+
+// UpdateSupplySourceRequest A request to update the configuration and capabilities of a supply source.
+type UpdateSupplySourceRequest struct {
+	// The custom alias for this supply location.
+	Alias         *string                    `json:"alias,omitempty"`
+	Configuration *SupplySourceConfiguration `json:"configuration,omitempty"`
+	Capabilities  *SupplySourceCapabilities  `json:"capabilities,omitempty"`
+}
+
+// UpdateSupplySourceStatusRequest A request to update the status of a supply source.
+type UpdateSupplySourceStatusRequest struct {
+	Status SupplySourceStatus `json:"status"`
+}
@@ -0,0 +1,115 @@
+package supplysources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go/types"
+	"net/http"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/supplySources/2020-07-01"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.SupplysourcesClient for tests.
+type Client interface {
+	GetSupplySources(ctx context.Context, filter *GetSupplySourcesFilter) (*apis.CallResponse[GetSupplySourcesResponse], error)
+	CreateSupplySource(ctx context.Context, request *CreateSupplySourceRequest) (*apis.CallResponse[CreateSupplySourceResponse], error)
+	GetSupplySource(ctx context.Context, supplySourceID string) (*apis.CallResponse[SupplySource], error)
+	UpdateSupplySource(ctx context.Context, supplySourceID string, request *UpdateSupplySourceRequest) error
+	UpdateSupplySourceStatus(ctx context.Context, supplySourceID string, request *UpdateSupplySourceStatusRequest) error
+	ArchiveSupplySource(ctx context.Context, supplySourceID string) error
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// GetSupplySources returns the list of supply sources of the selling partner.
+func (a *API) GetSupplySources(ctx context.Context, filter *GetSupplySourcesFilter) (*apis.CallResponse[GetSupplySourcesResponse], error) {
+	if filter.PageSize != 0 && (filter.PageSize < 1 || filter.PageSize > 50) {
+		return nil, errors.New("pageSize must be between 1 and 50")
+	}
+
+	return apis.NewCall[GetSupplySourcesResponse](http.MethodGet, pathPrefix+"/supplySources").
+		WithQueryParams(filter.GetQuery()).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// CreateSupplySource creates a new supply source.
+func (a *API) CreateSupplySource(ctx context.Context, request *CreateSupplySourceRequest) (*apis.CallResponse[CreateSupplySourceResponse], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[CreateSupplySourceResponse](http.MethodPost, pathPrefix+"/supplySources").
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetSupplySource returns the details of the supply source with the given supplySourceID.
+func (a *API) GetSupplySource(ctx context.Context, supplySourceID string) (*apis.CallResponse[SupplySource], error) {
+	return apis.NewCall[SupplySource](http.MethodGet, pathPrefix+"/supplySources/{supplySourceID}").
+		WithPathParam("supplySourceID", supplySourceID).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// UpdateSupplySource updates the configuration and capabilities of a supply source.
+func (a *API) UpdateSupplySource(ctx context.Context, supplySourceID string, request *UpdateSupplySourceRequest) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	_, err = apis.NewCall[types.Nil](http.MethodPut, pathPrefix+"/supplySources/{supplySourceID}").
+		WithPathParam("supplySourceID", supplySourceID).
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+	return err
+}
+
+// UpdateSupplySourceStatus updates the status of a supply source.
+func (a *API) UpdateSupplySourceStatus(ctx context.Context, supplySourceID string, request *UpdateSupplySourceStatusRequest) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	_, err = apis.NewCall[types.Nil](http.MethodPut, pathPrefix+"/supplySources/{supplySourceID}/status").
+		WithPathParam("supplySourceID", supplySourceID).
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+	return err
+}
+
+// ArchiveSupplySource archives a supply source, making it immutable and non-usable.
+func (a *API) ArchiveSupplySource(ctx context.Context, supplySourceID string) error {
+	_, err := apis.NewCall[types.Nil](http.MethodDelete, pathPrefix+"/supplySources/{supplySourceID}").
+		WithPathParam("supplySourceID", supplySourceID).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+	return err
+}
@@ -1,6 +1,7 @@
 package tokens
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"time"
@@ -15,6 +16,13 @@ type API struct {
 	httpClient *httpx.Client
 }
 
+// Client is the interface of API, implemented by *API and by mocks.TokensClient for tests.
+type Client interface {
+	CreateRestrictedDataTokenRequest(ctx context.Context, restrictedResources *CreateRestrictedDataTokenRequest) (*apis.CallResponse[CreateRestrictedDataTokenResponse], error)
+}
+
+var _ Client = (*API)(nil)
+
 func NewAPI(httpClient *httpx.Client) *API {
 	return &API{
 		httpClient: httpClient,
@@ -22,7 +30,7 @@ func NewAPI(httpClient *httpx.Client) *API {
 }
 
 // CreateRestrictedDataTokenRequest returns a Restricted Data Token (RDT) for one or more restricted resources that you specify.
-func (t *API) CreateRestrictedDataTokenRequest(restrictedResources *CreateRestrictedDataTokenRequest) (*apis.CallResponse[CreateRestrictedDataTokenResponse], error) {
+func (t *API) CreateRestrictedDataTokenRequest(ctx context.Context, restrictedResources *CreateRestrictedDataTokenRequest) (*apis.CallResponse[CreateRestrictedDataTokenResponse], error) {
 	body, err := json.Marshal(restrictedResources)
 	if err != nil {
 		return nil, err
@@ -30,6 +38,7 @@ func (t *API) CreateRestrictedDataTokenRequest(restrictedResources *CreateRestri
 	return apis.NewCall[CreateRestrictedDataTokenResponse](http.MethodPost, pathPrefix+"/restrictedDataToken").
 		WithBody(body).
 		WithRateLimit(1.0, time.Second).
+		WithBurst(10).
 		WithParseErrorListOnError().
-		Execute(t.httpClient)
+		Execute(ctx, t.httpClient)
 }
@@ -1,11 +1,7 @@
 package apis
 
 import (
-	"encoding/json"
-	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"net/url"
 	"strings"
 )
@@ -31,26 +27,11 @@ func MapToCommaString[t any](slice []t) string {
 	return strings.Join(result, ",")
 }
 
-func mapErrorListToError(errorList *ErrorList) (errs error) {
-	if errorList == nil || len(errorList.Errors) == 0 {
-		return nil
+// Deref returns the value p points to, or the zero value if p is nil.
+func Deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
 	}
-
-	for _, err := range errorList.Errors {
-		errs = errors.Join(errs, fmt.Errorf("code=%s, message=%s, details=%v", err.Code, err.Message, err.Details))
-	}
-
-	return errs
-}
-
-func unmarshalBody(resp *http.Response, into any) error {
-	bodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return err
-	}
-
-	if len(bodyBytes) == 0 {
-		return nil
-	}
-	return json.Unmarshal(bodyBytes, into)
+	return *p
 }
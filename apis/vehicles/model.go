@@ -0,0 +1,100 @@
+package vehicles
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/fond-of-vertigo/amazon-sp-api/internal/utils"
+)
+
+// VehicleType The type of vehicle.
+type VehicleType string
+
+const (
+	VehicleTypeCar       VehicleType = "CAR"
+	VehicleTypeMotorbike VehicleType = "MOTORBIKE"
+)
+
+// GetVehiclesFilter is used to filter the GetVehicles call. MarketplaceID and VehicleType are required.
+type GetVehiclesFilter struct {
+	MarketplaceID constants.MarketplaceID
+	VehicleType   VehicleType
+	// UpdatedAfter returns only vehicles updated after the given time.
+	UpdatedAfter apis.JsonTimeISO8601
+	// PageToken is the token to fetch the next or previous page of results.
+	PageToken string
+}
+
+func (f *GetVehiclesFilter) GetQuery() url.Values {
+	q := url.Values{}
+	utils.AddToQueryIfSet(q, "marketplaceId", string(f.MarketplaceID))
+	utils.AddToQueryIfSet(q, "vehicleType", string(f.VehicleType))
+	utils.AddToQueryIfSet(q, "updatedAfter", f.UpdatedAfter.String())
+	utils.AddToQueryIfSet(q, "pageToken", f.PageToken)
+	return q
+}
+
+// Pagination When a request produces a response that exceeds the pageSize, pagination occurs.
+type Pagination struct {
+	// A token that can be used to fetch the next page.
+	NextToken *string `json:"nextToken,omitempty"`
+	// A token that can be used to fetch the previous page.
+	PreviousToken *string `json:"previousToken,omitempty"`
+}
+
+// EngineOutput The engine output of a vehicle.
+type EngineOutput struct {
+	// The engine output value.
+	Value float64 `json:"value"`
+	// The unit of the engine output value, e.g. KILOWATT or HORSEPOWER.
+	Unit string `json:"unit"`
+}
+
+// MonthAndYear A month and year.
+type MonthAndYear struct {
+	Month *int `json:"month,omitempty"`
+	Year  *int `json:"year,omitempty"`
+}
+
+// VehicleIdentifiers Standard vehicle identifiers, e.g. a KType for European vehicles.
+type VehicleIdentifiers struct {
+	// The identifier standard.
+	Standard string `json:"standard"`
+	// The identifier value.
+	Value string `json:"value"`
+}
+
+// Vehicle The vehicle fitment data.
+type Vehicle struct {
+	// The vehicle make.
+	Make string `json:"make"`
+	// The vehicle model.
+	Model string `json:"model"`
+	// The vehicle variant name.
+	VariantName *string `json:"variantName,omitempty"`
+	// The vehicle body style.
+	BodyStyle *string `json:"bodyStyle,omitempty"`
+	// The vehicle drive type.
+	DriveType *string `json:"driveType,omitempty"`
+	// The vehicle energy source.
+	Energy *string `json:"energy,omitempty"`
+	// The engine outputs of the vehicle.
+	EngineOutput           []EngineOutput `json:"engineOutput,omitempty"`
+	ManufacturingStartDate *MonthAndYear  `json:"manufacturingStartDate,omitempty"`
+	ManufacturingStopDate  *MonthAndYear  `json:"manufacturingStopDate,omitempty"`
+	// The date the vehicle was last processed.
+	LastProcessedDate *time.Time `json:"lastProcessedDate,omitempty"`
+	// The status of the vehicle, e.g. ACTIVE or DEPRECATED.
+	Status *string `json:"status,omitempty"`
+	// The identifiers of the vehicle.
+	Identifiers []VehicleIdentifiers `json:"identifiers"`
+}
+
+// VehiclesResponse The response of the GetVehicles operation.
+type VehiclesResponse struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	// A list of vehicles.
+	Vehicles []Vehicle `json:"vehicles"`
+}
@@ -0,0 +1,44 @@
+package vehicles
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/catalog/2024-11-01"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.VehiclesClient for tests.
+type Client interface {
+	GetVehicles(ctx context.Context, filter *GetVehiclesFilter) (*apis.CallResponse[VehiclesResponse], error)
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// GetVehicles returns the list of vehicles that the automotive catalog knows for the given marketplace and vehicle type.
+// Use the UpdatedAfter filter to synchronize only the vehicles that changed since the last run.
+func (a *API) GetVehicles(ctx context.Context, filter *GetVehiclesFilter) (*apis.CallResponse[VehiclesResponse], error) {
+	if filter.MarketplaceID == "" || filter.VehicleType == "" {
+		return nil, errors.New("marketplaceID and vehicleType are required")
+	}
+
+	return apis.NewCall[VehiclesResponse](http.MethodGet, pathPrefix+"/automotive/vehicles").
+		WithQueryParams(filter.GetQuery()).
+		WithParseErrorListOnError().
+		WithRateLimit(1, time.Second).
+		Execute(ctx, a.httpClient)
+}
@@ -0,0 +1,58 @@
+package vendordfinventory
+
+import "github.com/fond-of-vertigo/amazon-sp-api/apis"
+
+// SubmitInventoryUpdateRequest The request body for the submitInventoryUpdate operation.
+type SubmitInventoryUpdateRequest struct {
+	Inventory InventoryUpdate `json:"inventory"`
+}
+
+// InventoryUpdate Inventory details required to update some or all items for the requested warehouse.
+type InventoryUpdate struct {
+	SellingParty PartyIdentification `json:"sellingParty"`
+	// When true, this request contains a full feed. Otherwise, this request contains a partial feed.
+	// When sending a full feed, you must send information about all items in the warehouse. Any items
+	// not in the full feed are updated as not available. When sending a partial feed, only include
+	// the items that need an update to inventory. The status of other items will remain unchanged.
+	IsFullUpdate bool `json:"isFullUpdate"`
+	// A list of inventory items with updated details, including quantity available.
+	Items []ItemDetails `json:"items"`
+}
+
+// PartyIdentification Identification of the party.
+type PartyIdentification struct {
+	// Assigned identification for the party.
+	PartyID string `json:"partyId"`
+}
+
+// ItemDetails Updated inventory details for an item.
+type ItemDetails struct {
+	// The buyer selected product identification of the item. Either buyerProductIdentifier or vendorProductIdentifier should be submitted.
+	BuyerProductIdentifier *string `json:"buyerProductIdentifier,omitempty"`
+	// The vendor selected product identification of the item. Either buyerProductIdentifier or vendorProductIdentifier should be submitted.
+	VendorProductIdentifier *string      `json:"vendorProductIdentifier,omitempty"`
+	AvailableQuantity       ItemQuantity `json:"availableQuantity"`
+	// When true, the item is permanently unavailable.
+	IsObsolete *bool `json:"isObsolete,omitempty"`
+}
+
+// ItemQuantity Details of quantity.
+type ItemQuantity struct {
+	// Quantity of units available for a specific item.
+	Amount *int `json:"amount,omitempty"`
+	// Unit of measure for the available quantity.
+	UnitOfMeasure string `json:"unitOfMeasure"`
+}
+
+// SubmitInventoryUpdateResponse The response schema for the submitInventoryUpdate operation.
+type SubmitInventoryUpdateResponse struct {
+	Payload *TransactionReference `json:"payload,omitempty"`
+	// A list of error responses returned when a request is unsuccessful.
+	Errors []apis.Error `json:"errors,omitempty"`
+}
+
+// TransactionReference Response containing the transaction ID.
+type TransactionReference struct {
+	// GUID to identify this transaction. This value can be used with the Transaction Status API to return the status of this transaction.
+	TransactionID *string `json:"transactionId,omitempty"`
+}
@@ -0,0 +1,48 @@
+package vendordfinventory
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/vendor/directFulfillment/inventory/v1"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.VendordfinventoryClient for tests.
+type Client interface {
+	SubmitInventoryUpdate(ctx context.Context, warehouseID string, request *SubmitInventoryUpdateRequest) (*apis.CallResponse[SubmitInventoryUpdateResponse], error)
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// SubmitInventoryUpdate submits inventory updates for the specified warehouse for either a partial or full feed
+// of inventory items. Use SubmitInventoryUpdateRequest.Inventory.IsFullUpdate to choose between both modes.
+// The returned transactionId can be passed to the Vendor Direct Fulfillment Transactions API.
+func (a *API) SubmitInventoryUpdate(ctx context.Context, warehouseID string, request *SubmitInventoryUpdateRequest) (*apis.CallResponse[SubmitInventoryUpdateResponse], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[SubmitInventoryUpdateResponse](http.MethodPost, pathPrefix+"/warehouses/{warehouseID}/items").
+		WithIdempotencyToken().
+		WithPathParam("warehouseID", warehouseID).
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(10, time.Second).
+		Execute(ctx, a.httpClient)
+}
@@ -0,0 +1,211 @@
+package vendordfpayments
+
+import "github.com/fond-of-vertigo/amazon-sp-api/apis"
+
+// TaxType Type of the tax applied.
+type TaxType string
+
+const (
+	TaxTypeCGST            TaxType = "CGST"
+	TaxTypeSGST            TaxType = "SGST"
+	TaxTypeCESS            TaxType = "CESS"
+	TaxTypeUTGST           TaxType = "UTGST"
+	TaxTypeIGST            TaxType = "IGST"
+	TaxTypeMwSt            TaxType = "MwSt."
+	TaxTypePST             TaxType = "PST"
+	TaxTypeTVA             TaxType = "TVA"
+	TaxTypeVAT             TaxType = "VAT"
+	TaxTypeGST             TaxType = "GST"
+	TaxTypeST              TaxType = "ST"
+	TaxTypeConsumption     TaxType = "Consumption"
+	TaxTypeMutuallyDefined TaxType = "MutuallyDefined"
+	TaxTypeDomesticVAT     TaxType = "DomesticVAT"
+)
+
+// ChargeType Type of charge applied.
+type ChargeType string
+
+const (
+	ChargeTypeGiftWrap             ChargeType = "GIFTWRAP"
+	ChargeTypeFulfillment          ChargeType = "FULFILLMENT"
+	ChargeTypeMarketingInsert      ChargeType = "MARKETINGINSERT"
+	ChargeTypePackaging            ChargeType = "PACKAGING"
+	ChargeTypeLoading              ChargeType = "LOADING"
+	ChargeTypeFreightOut           ChargeType = "FREIGHTOUT"
+	ChargeTypeTaxCollectedAtSource ChargeType = "TAX_COLLECTED_AT_SOURCE"
+)
+
+// AdditionalDetailType The type of the additional information provided by the selling party.
+type AdditionalDetailType string
+
+const (
+	AdditionalDetailTypeSUR         AdditionalDetailType = "SUR"
+	AdditionalDetailTypeOCR         AdditionalDetailType = "OCR"
+	AdditionalDetailTypeCartonCount AdditionalDetailType = "CartonCount"
+)
+
+// TaxRegistrationType The tax registration type for the entity.
+type TaxRegistrationType string
+
+const (
+	TaxRegistrationTypeVAT TaxRegistrationType = "VAT"
+	TaxRegistrationTypeGST TaxRegistrationType = "GST"
+)
+
+// SubmitInvoiceRequest The request schema for the submitInvoice operation.
+type SubmitInvoiceRequest struct {
+	Invoices []InvoiceDetail `json:"invoices,omitempty"`
+}
+
+// InvoiceDetail Represents the details of an invoice, encompassing information about the invoice
+// number, invoice date, parties involved, totals, charges, and items.
+type InvoiceDetail struct {
+	// The unique invoice number.
+	InvoiceNumber string `json:"invoiceNumber"`
+	// Invoice date.
+	InvoiceDate apis.JsonTimeISO8601 `json:"invoiceDate"`
+	// An additional unique reference number used for regulatory or other purposes.
+	ReferenceNumber *string              `json:"referenceNumber,omitempty"`
+	RemitToParty    PartyIdentification  `json:"remitToParty"`
+	ShipFromParty   PartyIdentification  `json:"shipFromParty"`
+	BillToParty     *PartyIdentification `json:"billToParty,omitempty"`
+	// Ship-to country code.
+	ShipToCountryCode *string `json:"shipToCountryCode,omitempty"`
+	// The payment terms for the invoice.
+	PaymentTermsCode *string `json:"paymentTermsCode,omitempty"`
+	InvoiceTotal     Money   `json:"invoiceTotal"`
+	// Individual tax details per line item.
+	TaxTotals []TaxDetail `json:"taxTotals,omitempty"`
+	// Additional details provided by the selling party, for tax-related or any other purpose.
+	AdditionalDetails []AdditionalDetails `json:"additionalDetails,omitempty"`
+	// Total charge amount details for all line items.
+	ChargeDetails []ChargeDetails `json:"chargeDetails,omitempty"`
+	// Provides the details of the items in this invoice.
+	Items []InvoiceItem `json:"items"`
+}
+
+// PartyIdentification Name, address, and tax details of a party.
+type PartyIdentification struct {
+	// Assigned identification for the party.
+	PartyID string   `json:"partyId"`
+	Address *Address `json:"address,omitempty"`
+	// Tax registration details of the party.
+	TaxRegistrationDetails []TaxRegistrationDetail `json:"taxRegistrationDetails,omitempty"`
+}
+
+// Address A physical address.
+type Address struct {
+	// The name of the person, business or institution at that address.
+	Name string `json:"name"`
+	// First line of the address.
+	AddressLine1 string `json:"addressLine1"`
+	// Additional address information, if required.
+	AddressLine2 *string `json:"addressLine2,omitempty"`
+	// Additional address information, if required.
+	AddressLine3 *string `json:"addressLine3,omitempty"`
+	// The city where the person, business or institution is located.
+	City string `json:"city"`
+	// The county where person, business or institution is located.
+	County *string `json:"county,omitempty"`
+	// The district where person, business or institution is located.
+	District *string `json:"district,omitempty"`
+	// The state or region where person, business or institution is located.
+	StateOrRegion string `json:"stateOrRegion"`
+	// The postal code of that address. It contains a series of letters or digits or both, sometimes including spaces or punctuation.
+	PostalCode string `json:"postalCode"`
+	// The two digit country code in ISO 3166-1 alpha-2 format.
+	CountryCode string `json:"countryCode"`
+	// The phone number of the person, business or institution located at that address.
+	Phone *string `json:"phone,omitempty"`
+}
+
+// TaxRegistrationDetail Details about the tax registration of the entity.
+type TaxRegistrationDetail struct {
+	// The tax registration type for the entity.
+	TaxRegistrationType TaxRegistrationType `json:"taxRegistrationType"`
+	// The tax registration number for the entity. For example, VAT ID, Consumption Tax ID.
+	TaxRegistrationNumber  string   `json:"taxRegistrationNumber"`
+	TaxRegistrationAddress *Address `json:"taxRegistrationAddress,omitempty"`
+	// The tax registration message that can be used for additional tax related details.
+	TaxRegistrationMessage *string `json:"taxRegistrationMessage,omitempty"`
+}
+
+// Money An amount of money, including units in the form of currency.
+type Money struct {
+	// Three-digit currency code in ISO 4217 format.
+	CurrencyCode string `json:"currencyCode"`
+	// A decimal number with no loss of precision.
+	Amount string `json:"amount"`
+}
+
+// TaxDetail Details of tax amount applied.
+type TaxDetail struct {
+	// Type of the tax applied.
+	TaxType TaxType `json:"taxType"`
+	// A decimal number with no loss of precision.
+	TaxRate       *string `json:"taxRate,omitempty"`
+	TaxAmount     Money   `json:"taxAmount"`
+	TaxableAmount *Money  `json:"taxableAmount,omitempty"`
+}
+
+// AdditionalDetails Additional information provided by the selling party for tax-related or any other purpose.
+type AdditionalDetails struct {
+	// The type of the additional information provided by the selling party.
+	Type AdditionalDetailType `json:"type"`
+	// The detail of the additional information provided by the selling party.
+	Detail string `json:"detail"`
+	// The language code of the additional information detail.
+	LanguageCode *string `json:"languageCode,omitempty"`
+}
+
+// ChargeDetails Monetary and tax details of the charge.
+type ChargeDetails struct {
+	// Type of charge applied.
+	Type         ChargeType `json:"type"`
+	ChargeAmount Money      `json:"chargeAmount"`
+	// Individual tax details per line item.
+	TaxDetails []TaxDetail `json:"taxDetails,omitempty"`
+}
+
+// InvoiceItem Provides the details of the items in this invoice.
+type InvoiceItem struct {
+	// Numbering of the item on the purchase order. The first item will be 1, the second 2, and so on.
+	ItemSequenceNumber string `json:"itemSequenceNumber"`
+	// Buyer's standard identification number (ASIN) of an item.
+	BuyerProductIdentifier *string `json:"buyerProductIdentifier,omitempty"`
+	// The vendor selected product identification of the item.
+	VendorProductIdentifier *string      `json:"vendorProductIdentifier,omitempty"`
+	InvoicedQuantity        ItemQuantity `json:"invoicedQuantity"`
+	NetCost                 Money        `json:"netCost"`
+	// The purchase order number for this order. Formatting Notes: 8-character alpha-numeric code.
+	PurchaseOrderNumber string `json:"purchaseOrderNumber"`
+	// The vendor's order number for this order.
+	VendorOrderNumber *string `json:"vendorOrderNumber,omitempty"`
+	// Harmonized System of Nomenclature (HSN) tax code. The HSN number cannot contain alphabets.
+	HsnCode *string `json:"hsnCode,omitempty"`
+	// Individual tax details per line item.
+	TaxDetails []TaxDetail `json:"taxDetails,omitempty"`
+	// Individual charge details per line item.
+	ChargeDetails []ChargeDetails `json:"chargeDetails,omitempty"`
+}
+
+// ItemQuantity Details of item quantity.
+type ItemQuantity struct {
+	// Quantity of units available for a specific item.
+	Amount int `json:"amount"`
+	// Unit of measure for the available quantity.
+	UnitOfMeasure string `json:"unitOfMeasure"`
+}
+
+// SubmitInvoiceResponse The response schema for the submitInvoice operation.
+type SubmitInvoiceResponse struct {
+	Payload *TransactionReference `json:"payload,omitempty"`
+	// A list of error responses returned when a request is unsuccessful.
+	Errors []apis.Error `json:"errors,omitempty"`
+}
+
+// TransactionReference Response containing the transaction ID.
+type TransactionReference struct {
+	// GUID to identify this transaction. This value can be used with the Transaction Status API to return the status of this transaction.
+	TransactionID *string `json:"transactionId,omitempty"`
+}
@@ -0,0 +1,46 @@
+package vendordfpayments
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/vendor/directFulfillment/payments/v1"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.VendordfpaymentsClient for tests.
+type Client interface {
+	SubmitInvoice(ctx context.Context, request *SubmitInvoiceRequest) (*apis.CallResponse[SubmitInvoiceResponse], error)
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// SubmitInvoice submits one or more invoices for a vendor's direct fulfillment orders.
+// The returned transactionId can be passed to the Vendor Direct Fulfillment Transactions API.
+func (a *API) SubmitInvoice(ctx context.Context, request *SubmitInvoiceRequest) (*apis.CallResponse[SubmitInvoiceResponse], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[SubmitInvoiceResponse](http.MethodPost, pathPrefix+"/invoices").
+		WithIdempotencyToken().
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(10, time.Second).
+		Execute(ctx, a.httpClient)
+}
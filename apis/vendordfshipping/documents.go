@@ -0,0 +1,203 @@
+package vendordfshipping
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const (
+	ContentTypePDF = "application/pdf"
+	ContentTypePNG = "image/png"
+	ContentTypeZPL = "application/x-zpl"
+)
+
+// Document is a decoded shipping label, packing slip or customer invoice.
+type Document struct {
+	// ContentType is the detected MIME type of Data, e.g. application/pdf.
+	ContentType string
+	// Data contains the raw document bytes.
+	Data []byte
+}
+
+// Extension returns the file extension (including the dot) that matches the ContentType.
+func (d *Document) Extension() string {
+	switch d.ContentType {
+	case ContentTypePDF:
+		return ".pdf"
+	case ContentTypePNG:
+		return ".png"
+	case ContentTypeZPL:
+		return ".zpl"
+	}
+	return ".bin"
+}
+
+// WriteFile writes the document to the named file, creating it if necessary.
+func (d *Document) WriteFile(name string) error {
+	return os.WriteFile(name, d.Data, 0o644)
+}
+
+// DecodeContent decodes the Base64 encoded content returned by the Vendor Direct Fulfillment
+// Shipping API and detects its content type.
+func DecodeContent(content string) (*Document, error) {
+	data, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64 content failed: %w", err)
+	}
+	return &Document{
+		ContentType: DetectContentType(data),
+		Data:        data,
+	}, nil
+}
+
+// DecodeTo decodes the Base64 encoded content and streams the raw bytes to w,
+// without holding the decoded document in memory.
+func DecodeTo(w io.Writer, content string) (int64, error) {
+	return io.Copy(w, base64.NewDecoder(base64.StdEncoding, strings.NewReader(content)))
+}
+
+// DecodeToFile decodes the Base64 encoded content and streams the raw bytes to the named file.
+func DecodeToFile(name string, content string) (err error) {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, f.Close())
+	}()
+
+	_, err = DecodeTo(f, content)
+	return err
+}
+
+// zplTildeCommands are the ZPL commands which may precede the ^XA of a label, e.g. ~DG to download
+// the graphics printed by the label.
+var zplTildeCommands = [][]byte{[]byte("~DG"), []byte("~DY"), []byte("~DB"), []byte("~JA"), []byte("~SD"), []byte("~TA")}
+
+// DetectContentType detects the content type of PDF, PNG and ZPL documents. Other content is
+// classified by http.DetectContentType.
+func DetectContentType(data []byte) string {
+	trimmed := bytes.TrimLeft(data, " \t\r\n")
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("%PDF-")):
+		return ContentTypePDF
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return ContentTypePNG
+	case bytes.HasPrefix(trimmed, []byte("^XA")), hasZPLTildeCommand(trimmed):
+		return ContentTypeZPL
+	}
+	return http.DetectContentType(data)
+}
+
+func hasZPLTildeCommand(data []byte) bool {
+	for _, command := range zplTildeCommands {
+		if bytes.HasPrefix(data, command) {
+			return true
+		}
+	}
+	return false
+}
+
+// Decode returns the decoded label of a single package.
+func (l *LabelData) Decode() (*Document, error) {
+	return DecodeContent(l.Content)
+}
+
+// Decode returns the decoded packing slip. The content type returned by Amazon is preferred over the detected one.
+func (p *PackingSlip) Decode() (*Document, error) {
+	doc, err := DecodeContent(p.Content)
+	if err != nil {
+		return nil, err
+	}
+	if p.ContentType != nil && *p.ContentType != "" {
+		doc.ContentType = *p.ContentType
+	}
+	return doc, nil
+}
+
+// Decode returns the decoded customer invoice.
+func (c *CustomerInvoice) Decode() (*Document, error) {
+	return DecodeContent(c.Content)
+}
+
+// MergeLabels decodes the labels of all packages of a multi-package shipment and merges them into
+// a single printable document. ZPL labels are concatenated, PNG labels are stacked vertically.
+func (s *ShippingLabel) MergeLabels() (*Document, error) {
+	if len(s.LabelData) == 0 {
+		return nil, errors.New("shipping label does not contain any label data")
+	}
+
+	docs := make([]*Document, 0, len(s.LabelData))
+	for i := range s.LabelData {
+		doc, err := s.LabelData[i].Decode()
+		if err != nil {
+			return nil, fmt.Errorf("label %d: %w", i, err)
+		}
+		docs = append(docs, doc)
+	}
+
+	if len(docs) == 1 {
+		return docs[0], nil
+	}
+
+	switch docs[0].ContentType {
+	case ContentTypeZPL:
+		return mergeZPL(docs)
+	case ContentTypePNG:
+		return mergePNG(docs)
+	}
+	return nil, fmt.Errorf("merging labels of content type %s is not supported", docs[0].ContentType)
+}
+
+func mergeZPL(docs []*Document) (*Document, error) {
+	var buf bytes.Buffer
+	for i, doc := range docs {
+		if doc.ContentType != ContentTypeZPL {
+			return nil, fmt.Errorf("label %d: expected content type %s, got %s", i, ContentTypeZPL, doc.ContentType)
+		}
+		buf.Write(bytes.TrimSpace(doc.Data))
+		buf.WriteByte('\n')
+	}
+	return &Document{ContentType: ContentTypeZPL, Data: buf.Bytes()}, nil
+}
+
+func mergePNG(docs []*Document) (*Document, error) {
+	images := make([]image.Image, 0, len(docs))
+	width, height := 0, 0
+	for i, doc := range docs {
+		if doc.ContentType != ContentTypePNG {
+			return nil, fmt.Errorf("label %d: expected content type %s, got %s", i, ContentTypePNG, doc.ContentType)
+		}
+		img, err := png.Decode(bytes.NewReader(doc.Data))
+		if err != nil {
+			return nil, fmt.Errorf("label %d: %w", i, err)
+		}
+		width = max(width, img.Bounds().Dx())
+		height += img.Bounds().Dy()
+		images = append(images, img)
+	}
+
+	merged := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(merged, merged.Bounds(), image.White, image.Point{}, draw.Src)
+	offset := 0
+	for _, img := range images {
+		target := image.Rect(0, offset, img.Bounds().Dx(), offset+img.Bounds().Dy())
+		draw.Draw(merged, target, img, img.Bounds().Min, draw.Src)
+		offset += img.Bounds().Dy()
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, merged); err != nil {
+		return nil, err
+	}
+	return &Document{ContentType: ContentTypePNG, Data: buf.Bytes()}, nil
+}
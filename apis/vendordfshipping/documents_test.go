@@ -0,0 +1,94 @@
+package vendordfshipping
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func encodePNG(t *testing.T, width, height int) string {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	img.Set(0, 0, color.Black)
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, img))
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func TestDetectContentType(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{name: "PDF", data: []byte("%PDF-1.4\n..."), want: ContentTypePDF},
+		{name: "ZPL", data: []byte("\n^XA^FO50,50^FDLabel^FS^XZ"), want: ContentTypeZPL},
+		{name: "ZPLWithGraphic", data: []byte("~DGR:LOGO.GRF,00080,010,FFFF\n^XA^FO50,50^XGR:LOGO.GRF^FS^XZ"), want: ContentTypeZPL},
+		{name: "TextWithTilde", data: []byte("~ not a label"), want: "text/plain; charset=utf-8"},
+		{name: "PNG", data: []byte("\x89PNG\r\n\x1a\n...."), want: ContentTypePNG},
+		{name: "Text", data: []byte("hello"), want: "text/plain; charset=utf-8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DetectContentType(tt.data))
+		})
+	}
+}
+
+func TestShippingLabel_MergeLabels(t *testing.T) {
+	t.Run("ZPL labels are concatenated", func(t *testing.T) {
+		label := ShippingLabel{LabelData: []LabelData{
+			{Content: base64.StdEncoding.EncodeToString([]byte("^XA^FDone^FS^XZ"))},
+			{Content: base64.StdEncoding.EncodeToString([]byte("^XA^FDtwo^FS^XZ\n"))},
+		}}
+
+		doc, err := label.MergeLabels()
+
+		assert.NoError(t, err)
+		assert.Equal(t, ContentTypeZPL, doc.ContentType)
+		assert.Equal(t, "^XA^FDone^FS^XZ\n^XA^FDtwo^FS^XZ\n", string(doc.Data))
+	})
+
+	t.Run("PNG labels are stacked", func(t *testing.T) {
+		label := ShippingLabel{LabelData: []LabelData{
+			{Content: encodePNG(t, 20, 10)},
+			{Content: encodePNG(t, 30, 15)},
+		}}
+
+		doc, err := label.MergeLabels()
+
+		assert.NoError(t, err)
+		assert.Equal(t, ContentTypePNG, doc.ContentType)
+		img, err := png.Decode(bytes.NewReader(doc.Data))
+		assert.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 30, 25), img.Bounds())
+	})
+
+	t.Run("Mixed formats fail", func(t *testing.T) {
+		label := ShippingLabel{LabelData: []LabelData{
+			{Content: base64.StdEncoding.EncodeToString([]byte("^XA^XZ"))},
+			{Content: encodePNG(t, 1, 1)},
+		}}
+
+		_, err := label.MergeLabels()
+
+		assert.Error(t, err)
+	})
+}
+
+func TestDecodeToFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "slip.pdf")
+	content := base64.StdEncoding.EncodeToString([]byte("%PDF-1.4 packing slip"))
+
+	assert.NoError(t, DecodeToFile(name, content))
+
+	got, err := os.ReadFile(name)
+	assert.NoError(t, err)
+	assert.Equal(t, "%PDF-1.4 packing slip", string(got))
+}
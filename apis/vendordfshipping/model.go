@@ -0,0 +1,383 @@
+package vendordfshipping
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/internal/utils"
+)
+
+// SortOrder Sort order of the returned list, based on the purchase order date.
+type SortOrder string
+
+const (
+	SortOrderAscending  SortOrder = "ASC"
+	SortOrderDescending SortOrder = "DESC"
+)
+
+// LabelFormat The format of the label.
+type LabelFormat string
+
+const (
+	LabelFormatPNG LabelFormat = "PNG"
+	LabelFormatZPL LabelFormat = "ZPL"
+)
+
+// ContainerType The type of container.
+type ContainerType string
+
+const (
+	ContainerTypeCarton ContainerType = "carton"
+	ContainerTypePallet ContainerType = "pallet"
+)
+
+// ShipmentStatus Indicate the shipment status.
+type ShipmentStatus string
+
+const (
+	ShipmentStatusShipped     ShipmentStatus = "SHIPPED"
+	ShipmentStatusFloorDenial ShipmentStatus = "FLOOR_DENIAL"
+)
+
+// TaxRegistrationType Tax registration type for the entity.
+type TaxRegistrationType string
+
+const (
+	TaxRegistrationTypeVAT TaxRegistrationType = "VAT"
+	TaxRegistrationTypeGST TaxRegistrationType = "GST"
+)
+
+// ListFilter is used to filter the GetShippingLabels, GetCustomerInvoices and GetPackingSlips calls.
+type ListFilter struct {
+	// ShipFromPartyID the vendor warehouse identifier for the fulfillment warehouse.
+	// If not specified, the result will contain entries for all warehouses.
+	ShipFromPartyID string
+	// Limit the number of entries in the response. Value must be 1 - 100.
+	Limit int
+	// CreatedAfter entries that become available after this date and time will be included in the result.
+	// Must be in ISO-8601 date/time format. Required.
+	CreatedAfter apis.JsonTimeISO8601
+	// CreatedBefore entries that became available before this date and time will be included in the result.
+	// Must be in ISO-8601 date/time format. Required.
+	CreatedBefore apis.JsonTimeISO8601
+	// SortOrder the sort order of the list, based on the purchase order date.
+	SortOrder SortOrder
+	// NextToken used for pagination when there are more entries than the specified result size limit.
+	NextToken string
+}
+
+// GetQuery returns the query parameters for ListFilter.
+func (f *ListFilter) GetQuery() url.Values {
+	q := url.Values{}
+	utils.AddToQueryIfSet(q, "shipFromPartyId", f.ShipFromPartyID)
+	if f.Limit > 0 {
+		q.Set("limit", strconv.Itoa(f.Limit))
+	}
+	utils.AddToQueryIfSet(q, "createdAfter", f.CreatedAfter.String())
+	utils.AddToQueryIfSet(q, "createdBefore", f.CreatedBefore.String())
+	utils.AddToQueryIfSet(q, "sortOrder", string(f.SortOrder))
+	utils.AddToQueryIfSet(q, "nextToken", f.NextToken)
+	return q
+}
+
+// Pagination The pagination elements required to retrieve the remaining data.
+type Pagination struct {
+	// A generated string used to pass information to your next request. If NextToken is returned, pass the value of NextToken to the next request. If NextToken is not returned, there are no more items to return.
+	NextToken *string `json:"nextToken,omitempty"`
+}
+
+// Address of the party.
+type Address struct {
+	// The name of the person, business or institution at that address.
+	Name string `json:"name"`
+	// The attention name of the person at that address.
+	Attention *string `json:"attention,omitempty"`
+	// First line of the address.
+	AddressLine1 string `json:"addressLine1"`
+	// Additional address information, if required.
+	AddressLine2 *string `json:"addressLine2,omitempty"`
+	// Additional address information, if required.
+	AddressLine3 *string `json:"addressLine3,omitempty"`
+	// The city where the person, business or institution is located.
+	City *string `json:"city,omitempty"`
+	// The county where person, business or institution is located.
+	County *string `json:"county,omitempty"`
+	// The district where person, business or institution is located.
+	District *string `json:"district,omitempty"`
+	// The state or region where person, business or institution is located.
+	StateOrRegion string `json:"stateOrRegion"`
+	// The postal code of that address. It contains a series of letters or digits or both, sometimes including spaces or punctuation.
+	PostalCode *string `json:"postalCode,omitempty"`
+	// The two digit country code. In ISO 3166-1 alpha-2 format.
+	CountryCode string `json:"countryCode"`
+	// The phone number of the person, business or institution located at that address.
+	Phone *string `json:"phone,omitempty"`
+}
+
+// TaxRegistrationDetails Tax registration details of the entity.
+type TaxRegistrationDetails struct {
+	// Tax registration type for the entity.
+	TaxRegistrationType *TaxRegistrationType `json:"taxRegistrationType,omitempty"`
+	// Tax registration number for the party. For example, VAT ID.
+	TaxRegistrationNumber string `json:"taxRegistrationNumber"`
+	// Address of the party.
+	TaxRegistrationAddress *Address `json:"taxRegistrationAddress,omitempty"`
+	// Tax registration message that can be used for additional tax related details.
+	TaxRegistrationMessage *string `json:"taxRegistrationMessage,omitempty"`
+}
+
+// PartyIdentification Name, address and tax details of a party.
+type PartyIdentification struct {
+	// Assigned Identification for the party.
+	PartyID string `json:"partyId"`
+	// Address of the party.
+	Address *Address `json:"address,omitempty"`
+	// Tax registration details of the entity.
+	TaxInfo *TaxRegistrationDetails `json:"taxInfo,omitempty"`
+}
+
+// ItemQuantity Details of item quantity.
+type ItemQuantity struct {
+	// Quantity of units shipped for a specific item at a shipment level. If the item is present only in certain packages or pallets within the shipment, please provide this at the appropriate package or pallet level.
+	Amount int `json:"amount"`
+	// Unit of measure for the shipped quantity.
+	UnitOfMeasure string `json:"unitOfMeasure"`
+}
+
+// Dimensions Physical dimensional measurements of a container.
+type Dimensions struct {
+	// The length of the container.
+	Length string `json:"length"`
+	// The width of the container.
+	Width string `json:"width"`
+	// The height of the container.
+	Height string `json:"height"`
+	// The unit of measure for dimensions. Possible values: IN, CM.
+	UnitOfMeasure string `json:"unitOfMeasure"`
+}
+
+// Weight The weight.
+type Weight struct {
+	// The unit of measurement. Possible values: KG, LB.
+	UnitOfMeasure string `json:"unitOfMeasure"`
+	// A decimal number with no loss of precision.
+	Value string `json:"value"`
+}
+
+// PackedItem Details of the item being shipped.
+type PackedItem struct {
+	// Item Sequence Number for the item. This must be the same value as sent in the order for a given item.
+	ItemSequenceNumber int `json:"itemSequenceNumber"`
+	// Buyer's Standard Identification Number (ASIN) of an item. Either buyerProductIdentifier or vendorProductIdentifier is required.
+	BuyerProductIdentifier *string `json:"buyerProductIdentifier,omitempty"`
+	// Piece number of the item in this container.
+	PieceNumber *int `json:"pieceNumber,omitempty"`
+	// An item's product identifier, which the vendor can use to identify items in the container. Either buyerProductIdentifier or vendorProductIdentifier is required.
+	VendorProductIdentifier *string      `json:"vendorProductIdentifier,omitempty"`
+	PackedQuantity          ItemQuantity `json:"packedQuantity"`
+}
+
+// Container A container used for shipping and packing items.
+type Container struct {
+	// The type of container.
+	ContainerType ContainerType `json:"containerType"`
+	// The container identifier.
+	ContainerIdentifier string `json:"containerIdentifier"`
+	// The tracking number.
+	TrackingNumber *string `json:"trackingNumber,omitempty"`
+	// The manifest identifier.
+	ManifestID *string `json:"manifestId,omitempty"`
+	// The date of the manifest.
+	ManifestDate *string `json:"manifestDate,omitempty"`
+	// The shipment method. This property is required when calling the submitShipmentConfirmations operation, and optional otherwise.
+	ShipMethod *string `json:"shipMethod,omitempty"`
+	// SCAC code required for NA VOC vendors only.
+	ScacCode *string `json:"scacCode,omitempty"`
+	// Carrier required for EU VOC vendors only.
+	Carrier *string `json:"carrier,omitempty"`
+	// An integer that must be submitted for multi-box shipments only, where one item may come in separate packages.
+	ContainerSequenceNumber *int        `json:"containerSequenceNumber,omitempty"`
+	Dimensions              *Dimensions `json:"dimensions,omitempty"`
+	Weight                  Weight      `json:"weight"`
+	// A list of packed items.
+	PackedItems []PackedItem `json:"packedItems"`
+}
+
+// LabelData Details of the shipment label.
+type LabelData struct {
+	// Identifier for the package. The first package will be 001, the second 002, and so on. This number is used as a reference to refer to this package from the pallet level.
+	PackageIdentifier *string `json:"packageIdentifier,omitempty"`
+	// Package tracking identifier from the shipping carrier.
+	TrackingNumber *string `json:"trackingNumber,omitempty"`
+	// Ship method to be used for shipping the order. Amazon defines Ship Method Codes indicating shipping carrier and shipment service level.
+	ShipMethod *string `json:"shipMethod,omitempty"`
+	// Shipping method name for internal reference.
+	ShipMethodName *string `json:"shipMethodName,omitempty"`
+	// This field will contain the Base64 encoded string of the shipment label content.
+	Content string `json:"content"`
+}
+
+// ShippingLabel Shipping label information for an order, including the purchase order number, selling party, ship from party, label format, and package details.
+type ShippingLabel struct {
+	// This field will contain the Purchase Order Number for this order.
+	PurchaseOrderNumber string              `json:"purchaseOrderNumber"`
+	SellingParty        PartyIdentification `json:"sellingParty"`
+	ShipFromParty       PartyIdentification `json:"shipFromParty"`
+	// Format of the label.
+	LabelFormat LabelFormat `json:"labelFormat"`
+	// Provides the details of the packages in this shipment.
+	LabelData []LabelData `json:"labelData"`
+}
+
+// ShippingLabelList Response payload with the list of shipping labels.
+type ShippingLabelList struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	// An array containing the details of the generated shipping labels.
+	ShippingLabels []ShippingLabel `json:"shippingLabels,omitempty"`
+}
+
+// ShippingLabelRequest Represents the request payload for creating a shipping label, containing the purchase order number, selling party, ship from party, and a list of containers or packages in the shipment.
+type ShippingLabelRequest struct {
+	// Purchase order number of the order for which to create a shipping label.
+	PurchaseOrderNumber string              `json:"purchaseOrderNumber"`
+	SellingParty        PartyIdentification `json:"sellingParty"`
+	ShipFromParty       PartyIdentification `json:"shipFromParty"`
+	// A list of the packages in this shipment.
+	Containers []Container `json:"containers,omitempty"`
+}
+
+// SubmitShippingLabelsRequest The request schema for the submitShippingLabelRequest operation.
+type SubmitShippingLabelsRequest struct {
+	// An array of shipping label requests to be processed.
+	ShippingLabelRequests []ShippingLabelRequest `json:"shippingLabelRequests"`
+}
+
+// CreateShippingLabelsRequest The request body for the createShippingLabels operation.
+type CreateShippingLabelsRequest struct {
+	SellingParty  PartyIdentification `json:"sellingParty"`
+	ShipFromParty PartyIdentification `json:"shipFromParty"`
+	// A list of the packages in this shipment.
+	Containers []Container `json:"containers,omitempty"`
+}
+
+// TransactionReference Response containing the transaction ID.
+type TransactionReference struct {
+	// GUID used by Amazon to identify this transaction. This value can be used with the Transaction Status API to return the status of this transaction.
+	TransactionID *string `json:"transactionId,omitempty"`
+}
+
+// ShipmentDetails Details about a shipment.
+type ShipmentDetails struct {
+	// This field indicates the date of the departure of the shipment from vendor's location. Vendors are requested to send ASNs within 30 minutes of departure from their warehouse/distribution center or at least 6 hours prior to the appointment time at the Amazon destination warehouse, whichever is sooner.
+	ShippedDate apis.JsonTimeISO8601 `json:"shippedDate"`
+	// Indicate the shipment status.
+	ShipmentStatus ShipmentStatus `json:"shipmentStatus"`
+	// Provide the priority of the shipment.
+	IsPriorityShipment *bool `json:"isPriorityShipment,omitempty"`
+	// The vendor order number is a unique identifier generated by a vendor for their reference.
+	VendorOrderNumber *string `json:"vendorOrderNumber,omitempty"`
+	// Date on which the shipment is expected to reach the buyer's warehouse. It needs to be an estimate based on the average transit time between the ship-from location and the destination.
+	EstimatedDeliveryDate *apis.JsonTimeISO8601 `json:"estimatedDeliveryDate,omitempty"`
+}
+
+// Item Details of the item being shipped.
+type Item struct {
+	// Item Sequence Number for the item. This must be the same value as sent in order for a given item.
+	ItemSequenceNumber int `json:"itemSequenceNumber"`
+	// Buyer's Standard Identification Number (ASIN) of an item. Either buyerProductIdentifier or vendorProductIdentifier is required.
+	BuyerProductIdentifier *string `json:"buyerProductIdentifier,omitempty"`
+	// The vendor selected product identification of the item. Should be the same as was sent in the purchase order, like SKU Number.
+	VendorProductIdentifier *string      `json:"vendorProductIdentifier,omitempty"`
+	ShippedQuantity         ItemQuantity `json:"shippedQuantity"`
+}
+
+// ShipmentConfirmation Represents the confirmation details of a shipment, including the purchase order number and other shipment details.
+type ShipmentConfirmation struct {
+	// Purchase order number corresponding to the shipment.
+	PurchaseOrderNumber string              `json:"purchaseOrderNumber"`
+	ShipmentDetails     ShipmentDetails     `json:"shipmentDetails"`
+	SellingParty        PartyIdentification `json:"sellingParty"`
+	ShipFromParty       PartyIdentification `json:"shipFromParty"`
+	// Provide the details of the items in this shipment. If any of the item details field is common at a package or a pallet level, then provide them at the corresponding package.
+	Items []Item `json:"items"`
+	// A list of the container details to be included in the shipment confirmation.
+	Containers []Container `json:"containers,omitempty"`
+}
+
+// SubmitShipmentConfirmationsRequest The request schema for the submitShipmentConfirmations operation.
+type SubmitShipmentConfirmationsRequest struct {
+	// Array of ShipmentConfirmation objects, each representing confirmation details for a specific shipment.
+	ShipmentConfirmations []ShipmentConfirmation `json:"shipmentConfirmations"`
+}
+
+// ShipmentSchedule Details about the estimated delivery window.
+type ShipmentSchedule struct {
+	// Date on which the shipment is expected to reach the customer delivery location. This field is expected to be in ISO-8601 date/time format, with UTC time zone or UTC offset.
+	EstimatedDeliveryDateTime *apis.JsonTimeISO8601 `json:"estimatedDeliveryDateTime,omitempty"`
+	// This field indicates the date and time at the start of the appointment window scheduled to deliver the shipment.
+	ApptWindowStartDateTime *apis.JsonTimeISO8601 `json:"apptWindowStartDateTime,omitempty"`
+	// This field indicates the date and time at the end of the appointment window scheduled to deliver the shipment.
+	ApptWindowEndDateTime *apis.JsonTimeISO8601 `json:"apptWindowEndDateTime,omitempty"`
+}
+
+// StatusUpdateDetails Details for the shipment status update given by the vendor for the specific package.
+type StatusUpdateDetails struct {
+	// This is required to be provided for every package and should match with the trackingNumber sent for the shipment confirmation.
+	TrackingNumber string `json:"trackingNumber"`
+	// Indicates the shipment status code of the package that provides transportation information for Amazon tracking systems and ultimately for the final customer.
+	StatusCode string `json:"statusCode"`
+	// Provides a reason code for the status of the package that will provide additional information about the transportation status.
+	ReasonCode string `json:"reasonCode"`
+	// The date and time when the shipment status was updated. Values are in ISO 8601 date-time format.
+	StatusDateTime        apis.JsonTimeISO8601 `json:"statusDateTime"`
+	StatusLocationAddress Address              `json:"statusLocationAddress"`
+	ShipmentSchedule      *ShipmentSchedule    `json:"shipmentSchedule,omitempty"`
+}
+
+// ShipmentStatusUpdate Represents a shipment status update.
+type ShipmentStatusUpdate struct {
+	// Purchase order number of the shipment for which to update the shipment status.
+	PurchaseOrderNumber string              `json:"purchaseOrderNumber"`
+	SellingParty        PartyIdentification `json:"sellingParty"`
+	ShipFromParty       PartyIdentification `json:"shipFromParty"`
+	StatusUpdateDetails StatusUpdateDetails `json:"statusUpdateDetails"`
+}
+
+// SubmitShipmentStatusUpdatesRequest The request schema for the submitShipmentStatusUpdates operation.
+type SubmitShipmentStatusUpdatesRequest struct {
+	// Contains a list of one or more ShipmentStatusUpdate objects, each representing an update to the status of a specific shipment.
+	ShipmentStatusUpdates []ShipmentStatusUpdate `json:"shipmentStatusUpdates"`
+}
+
+// CustomerInvoice Represents a customer invoice associated with a purchase order.
+type CustomerInvoice struct {
+	// The purchase order number for this order.
+	PurchaseOrderNumber string `json:"purchaseOrderNumber"`
+	// The Base64encoded customer invoice.
+	Content string `json:"content"`
+}
+
+// CustomerInvoiceList Represents a list of customer invoices, potentially paginated.
+type CustomerInvoiceList struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	// Represents a customer invoice within the CustomerInvoiceList.
+	CustomerInvoices []CustomerInvoice `json:"customerInvoices,omitempty"`
+}
+
+// PackingSlip Packing slip information.
+type PackingSlip struct {
+	// Purchase order number of the shipment that corresponds to the packing slip.
+	PurchaseOrderNumber string `json:"purchaseOrderNumber"`
+	// A Base64encoded string of the packing slip PDF.
+	Content string `json:"content"`
+	// The format of the file such as PDF, JPEG etc.
+	ContentType *string `json:"contentType,omitempty"`
+}
+
+// PackingSlipList A list of packing slips.
+type PackingSlipList struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	// An array of packing slip objects.
+	PackingSlips []PackingSlip `json:"packingSlips,omitempty"`
+}
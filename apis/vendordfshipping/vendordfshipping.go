@@ -0,0 +1,181 @@
+package vendordfshipping
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/vendor/directFulfillment/shipping/2021-12-28"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.VendordfshippingClient for tests.
+type Client interface {
+	GetShippingLabels(ctx context.Context, filter *ListFilter) (*apis.CallResponse[ShippingLabelList], error)
+	SubmitShippingLabelRequest(ctx context.Context, request *SubmitShippingLabelsRequest) (*apis.CallResponse[TransactionReference], error)
+	GetShippingLabel(ctx context.Context, purchaseOrderNumber string) (*apis.CallResponse[ShippingLabel], error)
+	CreateShippingLabels(ctx context.Context, purchaseOrderNumber string, request *CreateShippingLabelsRequest) (*apis.CallResponse[ShippingLabel], error)
+	SubmitShipmentConfirmations(ctx context.Context, request *SubmitShipmentConfirmationsRequest) (*apis.CallResponse[TransactionReference], error)
+	SubmitShipmentStatusUpdates(ctx context.Context, request *SubmitShipmentStatusUpdatesRequest) (*apis.CallResponse[TransactionReference], error)
+	GetCustomerInvoices(ctx context.Context, filter *ListFilter) (*apis.CallResponse[CustomerInvoiceList], error)
+	GetCustomerInvoice(ctx context.Context, purchaseOrderNumber string) (*apis.CallResponse[CustomerInvoice], error)
+	GetPackingSlips(ctx context.Context, filter *ListFilter) (*apis.CallResponse[PackingSlipList], error)
+	GetPackingSlip(ctx context.Context, purchaseOrderNumber string) (*apis.CallResponse[PackingSlip], error)
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// GetShippingLabels returns a list of shipping labels created during the time frame that you specify.
+// CreatedAfter and CreatedBefore are required unless a NextToken is passed.
+func (a *API) GetShippingLabels(ctx context.Context, filter *ListFilter) (*apis.CallResponse[ShippingLabelList], error) {
+	if err := validateListFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[ShippingLabelList](http.MethodGet, pathPrefix+"/shippingLabels").
+		WithQueryParams(filter.GetQuery()).
+		WithParseErrorListOnError().
+		WithRateLimit(10, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// SubmitShippingLabelRequest creates a shipping label for a purchase order and returns a transactionId for reference.
+func (a *API) SubmitShippingLabelRequest(ctx context.Context, request *SubmitShippingLabelsRequest) (*apis.CallResponse[TransactionReference], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[TransactionReference](http.MethodPost, pathPrefix+"/shippingLabels").
+		WithIdempotencyToken().
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(10, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetShippingLabel returns a shipping label for the purchaseOrderNumber that you specify.
+func (a *API) GetShippingLabel(ctx context.Context, purchaseOrderNumber string) (*apis.CallResponse[ShippingLabel], error) {
+	return apis.NewCall[ShippingLabel](http.MethodGet, pathPrefix+"/shippingLabels/{purchaseOrderNumber}").
+		WithPathParam("purchaseOrderNumber", purchaseOrderNumber).
+		WithParseErrorListOnError().
+		WithRateLimit(10, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// CreateShippingLabels creates shipping labels for a purchase order and returns the labels synchronously.
+func (a *API) CreateShippingLabels(ctx context.Context, purchaseOrderNumber string, request *CreateShippingLabelsRequest) (*apis.CallResponse[ShippingLabel], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[ShippingLabel](http.MethodPost, pathPrefix+"/shippingLabels/{purchaseOrderNumber}").
+		WithIdempotencyToken().
+		WithPathParam("purchaseOrderNumber", purchaseOrderNumber).
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(10, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// SubmitShipmentConfirmations submits one or more shipment confirmations for vendor orders.
+func (a *API) SubmitShipmentConfirmations(ctx context.Context, request *SubmitShipmentConfirmationsRequest) (*apis.CallResponse[TransactionReference], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[TransactionReference](http.MethodPost, pathPrefix+"/shipmentConfirmations").
+		WithIdempotencyToken().
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(10, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// SubmitShipmentStatusUpdates submits shipment status updates for vendor orders. This API is only
+// applicable to vendors that deliver the orders themselves (Vendor Own Carrier, VOC).
+func (a *API) SubmitShipmentStatusUpdates(ctx context.Context, request *SubmitShipmentStatusUpdatesRequest) (*apis.CallResponse[TransactionReference], error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[TransactionReference](http.MethodPost, pathPrefix+"/shipmentStatusUpdates").
+		WithIdempotencyToken().
+		WithBody(body).
+		WithParseErrorListOnError().
+		WithRateLimit(10, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetCustomerInvoices returns a list of customer invoices created during the time frame that you specify.
+// CreatedAfter and CreatedBefore are required unless a NextToken is passed.
+func (a *API) GetCustomerInvoices(ctx context.Context, filter *ListFilter) (*apis.CallResponse[CustomerInvoiceList], error) {
+	if err := validateListFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[CustomerInvoiceList](http.MethodGet, pathPrefix+"/customerInvoices").
+		WithQueryParams(filter.GetQuery()).
+		WithParseErrorListOnError().
+		WithRateLimit(10, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetCustomerInvoice returns a customer invoice based on the purchaseOrderNumber that you specify.
+func (a *API) GetCustomerInvoice(ctx context.Context, purchaseOrderNumber string) (*apis.CallResponse[CustomerInvoice], error) {
+	return apis.NewCall[CustomerInvoice](http.MethodGet, pathPrefix+"/customerInvoices/{purchaseOrderNumber}").
+		WithPathParam("purchaseOrderNumber", purchaseOrderNumber).
+		WithParseErrorListOnError().
+		WithRateLimit(10, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetPackingSlips returns a list of packing slips for the purchase orders that match the criteria specified.
+// CreatedAfter and CreatedBefore are required unless a NextToken is passed.
+func (a *API) GetPackingSlips(ctx context.Context, filter *ListFilter) (*apis.CallResponse[PackingSlipList], error) {
+	if err := validateListFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return apis.NewCall[PackingSlipList](http.MethodGet, pathPrefix+"/packingSlips").
+		WithQueryParams(filter.GetQuery()).
+		WithParseErrorListOnError().
+		WithRateLimit(10, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+// GetPackingSlip returns a packing slip based on the purchaseOrderNumber that you specify.
+func (a *API) GetPackingSlip(ctx context.Context, purchaseOrderNumber string) (*apis.CallResponse[PackingSlip], error) {
+	return apis.NewCall[PackingSlip](http.MethodGet, pathPrefix+"/packingSlips/{purchaseOrderNumber}").
+		WithPathParam("purchaseOrderNumber", purchaseOrderNumber).
+		WithParseErrorListOnError().
+		WithRateLimit(10, time.Second).
+		Execute(ctx, a.httpClient)
+}
+
+func validateListFilter(filter *ListFilter) error {
+	if filter.Limit != 0 && (filter.Limit < 1 || filter.Limit > 100) {
+		return errors.New("limit must be between 1 and 100")
+	}
+	if filter.NextToken == "" && (filter.CreatedAfter.IsZero() || filter.CreatedBefore.IsZero()) {
+		return errors.New("createdAfter and createdBefore are required")
+	}
+	return nil
+}
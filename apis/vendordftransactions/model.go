@@ -0,0 +1,30 @@
+package vendordftransactions
+
+import "github.com/fond-of-vertigo/amazon-sp-api/apis"
+
+// Status Current processing status of the transaction.
+type Status string
+
+const (
+	// StatusFailure The submission could not be processed. Check Transaction.Errors for details.
+	StatusFailure Status = "Failure"
+	// StatusProcessing The submission is still being processed.
+	StatusProcessing Status = "Processing"
+	// StatusSuccess The submission was processed successfully.
+	StatusSuccess Status = "Success"
+)
+
+// TransactionStatus The payload for the getTransactionStatus operation.
+type TransactionStatus struct {
+	TransactionStatus *Transaction `json:"transactionStatus,omitempty"`
+}
+
+// Transaction The transaction status details.
+type Transaction struct {
+	// The unique identifier sent in the 'transactionId' field in response to the post request of a specific transaction.
+	TransactionID string `json:"transactionId"`
+	// Current processing status of the transaction.
+	Status Status `json:"status"`
+	// A list of error responses returned when the submission failed.
+	Errors *apis.ErrorList `json:"errors,omitempty"`
+}
@@ -0,0 +1,40 @@
+package vendordftransactions
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+const pathPrefix = "/vendor/directFulfillment/transactions/2021-12-28"
+
+type API struct {
+	httpClient *httpx.Client
+}
+
+// Client is the interface of API, implemented by *API and by mocks.VendordftransactionsClient for tests.
+type Client interface {
+	GetTransactionStatus(ctx context.Context, transactionID string) (*apis.CallResponse[TransactionStatus], error)
+}
+
+var _ Client = (*API)(nil)
+
+func NewAPI(httpClient *httpx.Client) *API {
+	return &API{
+		httpClient: httpClient,
+	}
+}
+
+// GetTransactionStatus returns the status of the transaction indicated by the specified transactionID.
+// The transactionID is returned by the asynchronous Vendor Direct Fulfillment operations, e.g. when
+// submitting shipping label requests, shipment confirmations, inventory updates or invoices.
+func (a *API) GetTransactionStatus(ctx context.Context, transactionID string) (*apis.CallResponse[TransactionStatus], error) {
+	return apis.NewCall[TransactionStatus](http.MethodGet, pathPrefix+"/transactions/{transactionID}").
+		WithPathParam("transactionID", transactionID).
+		WithParseErrorListOnError().
+		WithRateLimit(10, time.Second).
+		Execute(ctx, a.httpClient)
+}
@@ -0,0 +1,220 @@
+package sp_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+// SellerCredentials are the per-seller settings of a ClientManager.
+type SellerCredentials struct {
+	RefreshToken string
+	Endpoint     constants.Endpoint
+	// TokenStore is optional, see Config.TokenStore.
+	TokenStore httpx.TokenStore
+	// TokenProvider is optional, see Config.TokenProvider.
+	TokenProvider httpx.TokenProvider
+	// Credentials is optional, see Config.Credentials.
+	Credentials httpx.CredentialsFunc
+	// RateLimiter is optional, see Config.RateLimiter. Rate limits apply per seller, so it must not
+	// be shared with other sellers.
+	RateLimiter httpx.RateLimiter
+}
+
+// ClientManager holds the credentials of many sellers authorized for the same application and
+// hands out a Client per seller. Clients are created on first use and share the HTTP client with
+// its transport and the ConcurrencyLimiter. Clients unused for a while can be closed with EvictIdle.
+type ClientManager struct {
+	config     Config
+	sharedOnce sync.Once
+	sharedErr  error
+	mu         sync.Mutex
+	sellers    map[string]*managedClient
+	// httpClient is the HTTP client shared by the clients, nil until the first client is created.
+	httpClient *http.Client
+	// ownsHTTPClient is set if the transport of httpClient was created by the manager, so that
+	// Close may close its idle connections.
+	ownsHTTPClient bool
+}
+
+type managedClient struct {
+	mu          sync.Mutex
+	credentials SellerCredentials
+	client      *Client
+	lastUsed    time.Time
+}
+
+// NewClientManager returns a ClientManager using the ClientID, ClientSecret, Log, HTTPClient, transport
+// settings, limits, Metrics and AuditSink of config for all sellers. RefreshToken, Endpoint, TokenStore,
+// TokenProvider, Credentials, RateLimiter and SellerID of config are ignored, each seller gets its own
+// rate limiter with RateLimiting.
+func NewClientManager(config Config) *ClientManager {
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+	config.RateLimiter = nil
+	return &ClientManager{
+		config:  config,
+		sellers: map[string]*managedClient{},
+	}
+}
+
+// shareResources creates the HTTP client and the ConcurrencyLimiter once, so that the clients of
+// all sellers share the connections of the transport and the cap on requests in flight.
+func (m *ClientManager) shareResources() error {
+	m.sharedOnce.Do(func() {
+		hc, owned, err := newHTTPClient(m.config)
+		if err != nil {
+			m.sharedErr = err
+			return
+		}
+		m.config.HTTPClient = hc
+		m.mu.Lock()
+		m.httpClient = hc
+		m.ownsHTTPClient = owned
+		m.mu.Unlock()
+		m.config.Transport = nil
+		m.config.ProxyURL = nil
+		m.config.TLSConfig = nil
+		m.config.DialTimeout = 0
+		m.config.MaxIdleConnsPerHost = 0
+		m.config.Debug = false
+		if m.config.ConcurrencyLimiter == nil && m.config.MaxConcurrentRequests > 0 {
+			m.config.ConcurrencyLimiter = httpx.NewConcurrencyLimiter(m.config.MaxConcurrentRequests)
+		}
+	})
+	return m.sharedErr
+}
+
+// AddSeller registers the credentials of a seller. An existing client of the seller is closed.
+func (m *ClientManager) AddSeller(sellerID string, credentials SellerCredentials) {
+	m.mu.Lock()
+	previous := m.sellers[sellerID]
+	m.sellers[sellerID] = &managedClient{credentials: credentials}
+	m.mu.Unlock()
+
+	if previous != nil {
+		go previous.close(context.Background())
+	}
+}
+
+// RemoveSeller removes the seller and closes its client.
+func (m *ClientManager) RemoveSeller(sellerID string) {
+	m.mu.Lock()
+	previous := m.sellers[sellerID]
+	delete(m.sellers, sellerID)
+	m.mu.Unlock()
+
+	if previous != nil {
+		go previous.close(context.Background())
+	}
+}
+
+// Client returns the client of the seller, creating it on first use.
+func (m *ClientManager) Client(sellerID string) (*Client, error) {
+	m.mu.Lock()
+	seller, ok := m.sellers[sellerID]
+	m.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("seller %s is not registered", sellerID)
+	}
+
+	seller.mu.Lock()
+	defer seller.mu.Unlock()
+	seller.lastUsed = time.Now()
+	if seller.client != nil {
+		return seller.client, nil
+	}
+
+	if err := m.shareResources(); err != nil {
+		return nil, err
+	}
+	config := m.config
+	config.RefreshToken = seller.credentials.RefreshToken
+	config.Endpoint = seller.credentials.Endpoint
+	config.TokenStore = seller.credentials.TokenStore
+	config.TokenProvider = seller.credentials.TokenProvider
+	config.Credentials = seller.credentials.Credentials
+	config.RateLimiter = seller.credentials.RateLimiter
+	config.SellerID = sellerID
+	client, err := NewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("creating client for seller %s failed: %w", sellerID, err)
+	}
+	seller.client = client
+	return client, nil
+}
+
+// EvictIdle closes the clients which weren't returned by Client for maxIdle and returns their number.
+// The sellers stay registered, so their clients are created again on the next use. Call it
+// periodically on platforms creating clients for many sellers on demand.
+func (m *ClientManager) EvictIdle(maxIdle time.Duration) int {
+	m.mu.Lock()
+	sellers := make([]*managedClient, 0, len(m.sellers))
+	for _, seller := range m.sellers {
+		sellers = append(sellers, seller)
+	}
+	m.mu.Unlock()
+
+	evicted := 0
+	for _, seller := range sellers {
+		if seller.closeIfIdle(maxIdle) {
+			evicted++
+		}
+	}
+	return evicted
+}
+
+// Close closes the clients of all sellers and the idle connections of the transport the manager
+// created for the shared HTTP client, see Client.Close. It returns the errors of the clients which
+// didn't finish their requests before ctx is done.
+func (m *ClientManager) Close(ctx context.Context) error {
+	m.mu.Lock()
+	sellers := m.sellers
+	m.sellers = map[string]*managedClient{}
+	httpClient := m.httpClient
+	if !m.ownsHTTPClient {
+		httpClient = nil
+	}
+	m.mu.Unlock()
+
+	var errs []error
+	for sellerID, seller := range sellers {
+		if err := seller.close(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("closing client of seller %s failed: %w", sellerID, err))
+		}
+	}
+	if httpClient != nil {
+		httpClient.CloseIdleConnections()
+	}
+	return errors.Join(errs...)
+}
+
+func (c *managedClient) close(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.client == nil {
+		return nil
+	}
+	err := c.client.Close(ctx)
+	c.client = nil
+	return err
+}
+
+func (c *managedClient) closeIfIdle(maxIdle time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.client == nil || time.Since(c.lastUsed) < maxIdle {
+		return false
+	}
+	// the client wasn't used for maxIdle, so requests in flight are left to finish on their own
+	go c.client.Close(context.Background())
+	c.client = nil
+	return true
+}
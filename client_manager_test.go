@@ -0,0 +1,90 @@
+package sp_api
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+type tokenRoundTripper struct {
+	calls atomic.Int32
+}
+
+func (rt *tokenRoundTripper) RoundTrip(_ *http.Request) (*http.Response, error) {
+	rt.calls.Add(1)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"access_token":"ACCESS-TOKEN","expires_in":3600}`)),
+	}, nil
+}
+
+func TestClientManager_Client(t *testing.T) {
+	transport := &tokenRoundTripper{}
+	m := NewClientManager(Config{
+		ClientID:     "ID",
+		ClientSecret: "SECRET",
+		Log:          slog.New(slog.NewTextHandler(io.Discard, nil)),
+		HTTPClient:   &http.Client{Transport: transport},
+	})
+	defer m.Close(context.Background())
+
+	m.AddSeller("SELLER-A", SellerCredentials{RefreshToken: "REFRESH-A", Endpoint: constants.Europe})
+	m.AddSeller("SELLER-B", SellerCredentials{RefreshToken: "REFRESH-B", Endpoint: constants.NorthAmerica})
+
+	a, err := m.Client("SELLER-A")
+	assert.NoError(t, err)
+	again, err := m.Client("SELLER-A")
+	assert.NoError(t, err)
+	assert.Same(t, a, again, "the client should be created only once per seller")
+
+	b, err := m.Client("SELLER-B")
+	assert.NoError(t, err)
+	assert.NotSame(t, a, b)
+	assert.Equal(t, constants.NorthAmerica, b.httpClient.GetEndpoint())
+	assert.Equal(t, int32(2), transport.calls.Load())
+
+	_, err = m.Client("UNKNOWN")
+	assert.Error(t, err)
+
+	m.RemoveSeller("SELLER-A")
+	_, err = m.Client("SELLER-A")
+	assert.Error(t, err)
+}
+
+func TestClientManager_EvictIdle(t *testing.T) {
+	transport := &tokenRoundTripper{}
+	m := NewClientManager(Config{
+		ClientID:              "ID",
+		ClientSecret:          "SECRET",
+		Log:                   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Transport:             transport,
+		MaxConcurrentRequests: 4,
+	})
+	defer m.Close(context.Background())
+
+	m.AddSeller("SELLER-A", SellerCredentials{RefreshToken: "REFRESH-A", Endpoint: constants.Europe})
+	m.AddSeller("SELLER-B", SellerCredentials{RefreshToken: "REFRESH-B", Endpoint: constants.Europe})
+	a, err := m.Client("SELLER-A")
+	assert.NoError(t, err)
+	_, err = m.Client("SELLER-B")
+	assert.NoError(t, err)
+	assert.Same(t, transport, m.config.HTTPClient.Transport, "all sellers should share the transport")
+	assert.NotNil(t, m.config.ConcurrencyLimiter, "all sellers should share the concurrency limiter")
+
+	m.sellers["SELLER-A"].lastUsed = time.Now().Add(-time.Hour)
+	assert.Equal(t, 1, m.EvictIdle(30*time.Minute))
+	assert.Equal(t, 0, m.EvictIdle(30*time.Minute))
+
+	recreated, err := m.Client("SELLER-A")
+	assert.NoError(t, err)
+	assert.NotSame(t, a, recreated, "an evicted client should be created again")
+}
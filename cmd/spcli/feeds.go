@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	sp_api "github.com/fond-of-vertigo/amazon-sp-api"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/feeds"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+// feedsSubmit uploads the feed document and creates the feed. It prints the feedId, or the processing
+// report once the feed is done with -wait.
+func feedsSubmit(ctx context.Context, a *app, args []string) error {
+	flags := a.newFlagSet("feeds submit", "-type FEED_TYPE [-content-type TYPE] [-wait] FILE|-")
+	feedType := flags.String("type", "", "feed type, e.g. JSON_LISTINGS_FEED (required)")
+	contentType := flags.String("content-type", "application/json; charset=UTF-8", "content type of the feed document")
+	wait := flags.Bool("wait", false, "wait until the feed is processed and print its processing report")
+	poll := flags.Duration("poll", constants.DefaultReportPollInterval, "interval of the status checks with -wait")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *feedType == "" || flags.NArg() != 1 {
+		flags.Usage()
+		return errors.New("-type and the feed file are required")
+	}
+	marketplaceIDs, err := a.requireMarketplace()
+	if err != nil {
+		return err
+	}
+	content, contentLength, err := a.openFeedContent(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	return a.withClient(ctx, func(client *sp_api.Client) error {
+		document, err := client.FeedsAPI.CreateFeedDocument(ctx, &feeds.CreateFeedDocumentSpecification{ContentType: *contentType})
+		if err != nil {
+			return err
+		}
+		if err = client.FeedsAPI.UploadFeedDocument(ctx, document.ResponseBody, *contentType, content, contentLength); err != nil {
+			return err
+		}
+
+		created, err := client.FeedsAPI.CreateFeed(ctx, &feeds.CreateFeedSpecification{
+			FeedType:            *feedType,
+			MarketplaceIDs:      marketplaceIDs,
+			InputFeedDocumentId: document.ResponseBody.FeedDocumentId,
+		})
+		if err != nil {
+			return err
+		}
+		feedID := created.ResponseBody.FeedId
+		if !*wait {
+			_, err = fmt.Fprintln(a.stdout, feedID)
+			return err
+		}
+
+		fmt.Fprintf(a.stderr, "waiting for feed %s\n", feedID)
+		feed, err := waitForFeed(ctx, client, feedID, *poll)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(a.stderr, "feed %s finished with %s\n", feedID, feed.ProcessingStatus)
+		if feed.ResultFeedDocumentId == nil {
+			return nil
+		}
+		return a.writeFeedDocument(ctx, client, *feed.ResultFeedDocumentId)
+	})
+}
+
+// openFeedContent opens the feed file, or reads stdin for "-", since the presigned upload URL
+// requires the Content-Length.
+func (a *app) openFeedContent(name string) (io.ReadCloser, int64, error) {
+	if name == "-" {
+		content, err := io.ReadAll(a.stdin)
+		if err != nil {
+			return nil, 0, err
+		}
+		return io.NopCloser(bytes.NewReader(content)), int64(len(content)), nil
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, 0, err
+	}
+	return f, info.Size(), nil
+}
+
+// waitForFeed polls GetFeed until the feed reached a terminal processing status.
+func waitForFeed(ctx context.Context, client *sp_api.Client, feedID string, interval time.Duration) (*feeds.Feed, error) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		resp, err := client.FeedsAPI.GetFeed(ctx, feedID)
+		if err != nil {
+			return nil, err
+		}
+		switch resp.ResponseBody.ProcessingStatus {
+		case feeds.ProcessingStatusDone, feeds.ProcessingStatusCanceled, feeds.ProcessingStatusFatal:
+			return resp.ResponseBody, nil
+		}
+		timer.Reset(interval)
+	}
+}
+
+// writeFeedDocument writes the content of a feed document, e.g. the processing report, to stdout.
+func (a *app) writeFeedDocument(ctx context.Context, client *sp_api.Client, feedDocumentID string) error {
+	resp, err := client.FeedsAPI.GetFeedDocument(ctx, feedDocumentID)
+	if err != nil {
+		return err
+	}
+
+	doc, err := apis.OpenDocument(ctx, presignedClient{a.config.HTTPClient}, resp.ResponseBody.Url)
+	if err != nil {
+		return err
+	}
+	defer doc.Close()
+	_, err = io.Copy(a.stdout, doc)
+	return err
+}
+
+// presignedClient downloads from presigned URLs, which need no access token.
+type presignedClient struct {
+	httpClient *http.Client
+}
+
+func (p presignedClient) DoPresigned(req *http.Request) (*http.Response, error) {
+	if p.httpClient == nil {
+		return http.DefaultClient.Do(req)
+	}
+	return p.httpClient.Do(req)
+}
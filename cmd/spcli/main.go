@@ -0,0 +1,181 @@
+// Command spcli calls SP-API operations from the command line, e.g. to debug an integration or to
+// fetch a report by hand:
+//
+//	spcli [-marketplace ID] [-debug] reports create -type GET_FBA_MYI_UNSUPPRESSED_INVENTORY_DATA -wait
+//	spcli reports download -report 123456 -o inventory.tsv
+//	spcli feeds submit -type JSON_LISTINGS_FEED -wait listings.json
+//	spcli orders list -created-after 2024-05-01 -status Unshipped
+//
+// The LWA credentials are read from SPAPI_CLIENT_ID, SPAPI_CLIENT_SECRET and SPAPI_REFRESH_TOKEN.
+// The endpoint serving the marketplace is used, unless SPAPI_ENDPOINT is set. The marketplace
+// defaults to SPAPI_MARKETPLACE_ID.
+//
+// Each command is a short example of the SDK: see the files of the same name.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+
+	sp_api "github.com/fond-of-vertigo/amazon-sp-api"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+// command runs a subcommand with its arguments after the command name.
+type command func(ctx context.Context, a *app, args []string) error
+
+var commands = map[string]command{
+	"reports create":   reportsCreate,
+	"reports download": reportsDownload,
+	"feeds submit":     feedsSubmit,
+	"orders list":      ordersList,
+}
+
+// app holds the configuration shared by all commands.
+type app struct {
+	config      sp_api.Config
+	marketplace constants.MarketplaceID
+	stdin       io.Reader
+	stdout      io.Writer
+	stderr      io.Writer
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	a := &app{config: configFromEnv(os.Getenv), stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}
+	err := a.run(ctx, os.Args[1:])
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+		os.Exit(2)
+	case err != nil:
+		fmt.Fprintln(os.Stderr, "spcli:", err)
+		os.Exit(1)
+	}
+}
+
+// configFromEnv returns the client configuration of the SPAPI_* environment variables.
+func configFromEnv(getenv func(string) string) sp_api.Config {
+	return sp_api.Config{
+		ClientID:      getenv("SPAPI_CLIENT_ID"),
+		ClientSecret:  getenv("SPAPI_CLIENT_SECRET"),
+		RefreshToken:  getenv("SPAPI_REFRESH_TOKEN"),
+		Endpoint:      constants.Endpoint(getenv("SPAPI_ENDPOINT")),
+		MarketplaceID: constants.MarketplaceID(getenv("SPAPI_MARKETPLACE_ID")),
+		Application:   "spcli",
+	}
+}
+
+func (a *app) run(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("spcli", flag.ContinueOnError)
+	flags.SetOutput(a.stderr)
+	marketplace := flags.String("marketplace", string(a.config.MarketplaceID), "marketplace ID, e.g. A1PA6795UKMFR9 for Germany")
+	debug := flags.Bool("debug", false, "log all requests and responses to stderr")
+	flags.Usage = func() {
+		fmt.Fprintln(a.stderr, "usage: spcli [flags] <command> [command flags]")
+		fmt.Fprintln(a.stderr, "\ncommands:")
+		for _, name := range commandNames() {
+			fmt.Fprintln(a.stderr, "  "+name)
+		}
+		fmt.Fprintln(a.stderr, "\nflags:")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	a.marketplace = constants.MarketplaceID(*marketplace)
+	if a.config.MarketplaceID == "" {
+		a.config.MarketplaceID = a.marketplace
+	}
+	level := slog.LevelWarn
+	if *debug {
+		level = slog.LevelDebug
+		a.config.Debug = true
+	}
+	a.config.Log = slog.New(slog.NewTextHandler(a.stderr, &slog.HandlerOptions{Level: level}))
+
+	if flags.NArg() < 2 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+	name := flags.Arg(0) + " " + flags.Arg(1)
+	cmd, ok := commands[name]
+	if !ok {
+		flags.Usage()
+		return fmt.Errorf("unknown command %q", name)
+	}
+	return cmd(ctx, a, flags.Args()[2:])
+}
+
+func commandNames() []string {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newFlagSet returns the flags of the command name.
+func (a *app) newFlagSet(name, usage string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(a.stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(a.stderr, "usage: spcli %s %s\n\nflags:\n", name, usage)
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+// withClient calls f with a new client, which is closed afterwards.
+func (a *app) withClient(ctx context.Context, f func(client *sp_api.Client) error) (err error) {
+	client, err := sp_api.NewClient(a.config)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		err = errors.Join(err, client.Close(closeCtx))
+	}()
+	return f(client)
+}
+
+// requireMarketplace returns the marketplace selected by -marketplace or SPAPI_MARKETPLACE_ID.
+func (a *app) requireMarketplace() ([]constants.MarketplaceID, error) {
+	if a.marketplace == "" {
+		return nil, errors.New("no marketplace: set -marketplace or SPAPI_MARKETPLACE_ID")
+	}
+	return []constants.MarketplaceID{a.marketplace}, nil
+}
+
+// parseTime parses a date like 2024-05-01 or a RFC 3339 date and time.
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, use 2006-01-02 or 2006-01-02T15:04:05Z07:00", value)
+	}
+	return t, nil
+}
+
+// splitList splits a comma separated flag value.
+func splitList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/orders"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/reports"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/fond-of-vertigo/amazon-sp-api/spapitest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runCLI runs spcli against the server with the args and returns its stdout.
+func runCLI(t *testing.T, srv *spapitest.Server, stdin string, args ...string) (string, error) {
+	t.Helper()
+	var stdout bytes.Buffer
+	a := &app{config: srv.Config(), stdin: strings.NewReader(stdin), stdout: &stdout, stderr: io.Discard}
+	args = append([]string{"-marketplace", string(constants.Germany)}, args...)
+	err := a.run(context.Background(), args)
+	return stdout.String(), err
+}
+
+func TestReports(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.SetReportContent(reports.FBAInventoryLedgerReportSummaryView, []byte("sku\tquantity\nSKU-1\t3\n"))
+
+	out, err := runCLI(t, srv, "", "reports", "create", "-type", string(reports.FBAInventoryLedgerReportSummaryView), "-from", "2024-05-01", "-wait")
+	require.NoError(t, err)
+	var report reports.ReportModel
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.Equal(t, constants.Done, report.ProcessingStatus)
+	assert.Equal(t, []constants.MarketplaceID{constants.Germany}, report.MarketplaceIDs)
+
+	out, err = runCLI(t, srv, "", "reports", "download", "-report", report.ReportID)
+	require.NoError(t, err)
+	assert.Equal(t, "sku\tquantity\nSKU-1\t3\n", out)
+
+	file := filepath.Join(t.TempDir(), "report.tsv")
+	_, err = runCLI(t, srv, "", "reports", "download", "-document", *report.ReportDocumentID, "-o", file)
+	require.NoError(t, err)
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, "sku\tquantity\nSKU-1\t3\n", string(content))
+}
+
+func TestFeedsSubmit(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.SetFeedResult("JSON_LISTINGS_FEED", []byte(`{"summary":{"errors":0}}`))
+	feed := `{"header":{"sellerId":"SELLER"},"messages":[]}`
+
+	out, err := runCLI(t, srv, feed, "feeds", "submit", "-type", "JSON_LISTINGS_FEED", "-wait", "-poll", "10ms", "-")
+
+	require.NoError(t, err)
+	assert.Equal(t, `{"summary":{"errors":0}}`, out)
+	submitted := srv.Feeds()
+	require.Len(t, submitted, 1)
+	content, ok := srv.FeedContent(submitted[0].FeedId)
+	require.True(t, ok)
+	assert.Equal(t, feed, string(content))
+}
+
+func TestOrdersList(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.AddOrders(
+		orders.Order{AmazonOrderId: "028-1111111-1111111", OrderStatus: "Shipped"},
+		orders.Order{AmazonOrderId: "028-2222222-2222222", OrderStatus: "Unshipped"},
+		orders.Order{AmazonOrderId: "028-3333333-3333333", OrderStatus: "Shipped"},
+	)
+
+	out, err := runCLI(t, srv, "", "orders", "list", "-status", "Shipped", "-limit", "1")
+
+	require.NoError(t, err)
+	var order orders.Order
+	require.NoError(t, json.Unmarshal([]byte(out), &order))
+	assert.Equal(t, "028-1111111-1111111", order.AmazonOrderId)
+	assert.Equal(t, 1, strings.Count(out, "\n"))
+}
+
+func TestRun_InvalidArguments(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+
+	_, err := runCLI(t, srv, "", "reports", "delete")
+	assert.ErrorContains(t, err, `unknown command "reports delete"`)
+
+	_, err = runCLI(t, srv, "", "reports", "create")
+	assert.ErrorContains(t, err, "-type is required")
+
+	a := &app{config: srv.Config(), stdout: io.Discard, stderr: io.Discard}
+	err = a.run(context.Background(), []string{"orders", "list"})
+	assert.ErrorContains(t, err, "no marketplace")
+}
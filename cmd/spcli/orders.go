@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	sp_api "github.com/fond-of-vertigo/amazon-sp-api"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/orders"
+)
+
+// ordersList prints the orders created since -created-after as one JSON object per line.
+func ordersList(ctx context.Context, a *app, args []string) error {
+	flags := a.newFlagSet("orders list", "[-created-after DATE] [-status STATUS,...] [-limit N]")
+	createdAfter := flags.String("created-after", "", "list orders created since, defaults to 24 hours ago")
+	statuses := flags.String("status", "", "comma separated order statuses, e.g. Unshipped,PartiallyShipped")
+	limit := flags.Int("limit", 0, "stop after this many orders, 0 lists all")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	marketplaceIDs, err := a.requireMarketplace()
+	if err != nil {
+		return err
+	}
+
+	since := time.Now().Add(-24 * time.Hour)
+	if *createdAfter != "" {
+		if since, err = parseTime(*createdAfter); err != nil {
+			return err
+		}
+	}
+	filter := &orders.GetOrdersFilter{
+		CreateAfter:    apis.JsonTimeISO8601{Time: since},
+		MarketplaceIDs: marketplaceIDs,
+	}
+	for _, status := range splitList(*statuses) {
+		filter.OrderStatuses = append(filter.OrderStatuses, orders.OrderStatus(status))
+	}
+
+	return a.withClient(ctx, func(client *sp_api.Client) error {
+		encoder := json.NewEncoder(a.stdout)
+		paginator := client.OrdersAPI.OrdersPaginator(filter, nil)
+		for listed := 0; (*limit == 0 || listed < *limit) && paginator.Next(ctx); listed++ {
+			order := paginator.Item()
+			if err := encoder.Encode(&order); err != nil {
+				return err
+			}
+		}
+		return paginator.Err()
+	})
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	sp_api "github.com/fond-of-vertigo/amazon-sp-api"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/reports"
+)
+
+// reportsCreate requests a report and prints its reportId, or the report details once it is done with -wait.
+func reportsCreate(ctx context.Context, a *app, args []string) error {
+	flags := a.newFlagSet("reports create", "-type REPORT_TYPE [-from DATE] [-to DATE] [-wait]")
+	reportType := flags.String("type", "", "report type, e.g. GET_MERCHANT_LISTINGS_ALL_DATA (required)")
+	from := flags.String("from", "", "start of the data, defaults to 7 days ago")
+	to := flags.String("to", "", "end of the data, defaults to now")
+	wait := flags.Bool("wait", false, "wait until the report is done and print its details")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *reportType == "" {
+		flags.Usage()
+		return errors.New("-type is required")
+	}
+	marketplaceIDs, err := a.requireMarketplace()
+	if err != nil {
+		return err
+	}
+
+	end := time.Now()
+	if *to != "" {
+		if end, err = parseTime(*to); err != nil {
+			return err
+		}
+	}
+	start := end.AddDate(0, 0, -7)
+	if *from != "" {
+		if start, err = parseTime(*from); err != nil {
+			return err
+		}
+	}
+	specification := &reports.CreateReportSpecification{
+		ReportType:     reports.Type(*reportType),
+		DataStartTime:  apis.JsonTimeISO8601{Time: start},
+		DataEndTime:    apis.JsonTimeISO8601{Time: end},
+		MarketplaceIDs: marketplaceIDs,
+	}
+
+	return a.withClient(ctx, func(client *sp_api.Client) error {
+		created, err := client.ReportsAPI.CreateReport(ctx, specification)
+		if err != nil {
+			return err
+		}
+		reportID := created.ResponseBody.ReportID
+		if !*wait {
+			_, err = fmt.Fprintln(a.stdout, reportID)
+			return err
+		}
+
+		fmt.Fprintf(a.stderr, "waiting for report %s\n", reportID)
+		report, err := client.ReportsAPI.WaitForReport(ctx, reportID)
+		if err != nil {
+			return err
+		}
+		encoder := json.NewEncoder(a.stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	})
+}
+
+// reportsDownload writes the document of a done report to stdout or the file of -o.
+func reportsDownload(ctx context.Context, a *app, args []string) error {
+	flags := a.newFlagSet("reports download", "(-report ID | -document ID [-type TYPE]) [-o FILE] [-raw]")
+	reportID := flags.String("report", "", "reportId of a done report")
+	documentID := flags.String("document", "", "reportDocumentId, instead of -report")
+	reportType := flags.String("type", "", "report type of the -document, required for restricted reports")
+	output := flags.String("o", "", "file to write the document to, defaults to stdout")
+	raw := flags.Bool("raw", false, "keep the original charset instead of converting to UTF-8")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if (*reportID == "") == (*documentID == "") {
+		flags.Usage()
+		return errors.New("either -report or -document is required")
+	}
+	var opts []apis.DocumentOption
+	if *raw {
+		opts = append(opts, apis.WithoutCharsetConversion())
+	}
+	if *reportType != "" {
+		opts = append(opts, apis.WithReportType(*reportType))
+	}
+
+	w := a.stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	return a.withClient(ctx, func(client *sp_api.Client) error {
+		if *documentID != "" {
+			return client.ReportsAPI.WriteReportDocument(ctx, *documentID, w, opts...)
+		}
+		resp, err := client.ReportsAPI.GetReport(ctx, *reportID)
+		if err != nil {
+			return err
+		}
+		// WriteReport attaches a restrictedDataToken for restricted report types
+		return client.ReportsAPI.WriteReport(ctx, &resp.ResponseBody.ReportModel, w, opts...)
+	})
+}
@@ -11,7 +11,20 @@ const (
 	// DefaultWaitDurationOnTooManyRequestsError is the default wait time between two requests
 	// on HTTP 429 error
 	DefaultWaitDurationOnTooManyRequestsError time.Duration = 1 * time.Second
+	// MaxRetryBackoff limits the exponential backoff between the retries of a call
+	MaxRetryBackoff time.Duration = 1 * time.Minute
+	// DefaultRetryJitter randomizes the backoff between the retries of a call by ±20%
+	DefaultRetryJitter float64 = 0.2
 
 	//DefaultTokenUpdaterBackoffTime is the default backoff time for the token updater when a request fails
 	DefaultTokenUpdaterBackoffTime time.Duration = 15 * time.Second
+	// MaxTokenUpdaterBackoffTime limits the exponential backoff of the token updater
+	MaxTokenUpdaterBackoffTime time.Duration = 5 * time.Minute
+	// MaxTokenRequestAttempts is the maximum number of attempts for the first token request on transient errors
+	MaxTokenRequestAttempts int = 3
+	// DefaultTokenRequestRetryBackoff is the initial backoff between the attempts of the first token request
+	DefaultTokenRequestRetryBackoff time.Duration = 1 * time.Second
+
+	// DefaultReportPollInterval is the wait time between two status checks while waiting for a report
+	DefaultReportPollInterval time.Duration = 30 * time.Second
 )
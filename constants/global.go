@@ -6,14 +6,29 @@ func (s ProcessingStatus) IsDone() bool {
 	return s == Done
 }
 
+// IsTerminal reports whether the processing finished, either successfully or not, and the status won't change anymore.
+func (s ProcessingStatus) IsTerminal() bool {
+	return s == Done || s == Cancelled || s == Fatal
+}
+
 type MarketplaceID string
 type Region string
 type Endpoint string
 
+// Scope is the LWA scope of a grantless operation.
+type Scope string
+
 const (
-	AccessTokenHeader = "X-Amz-Access-Token"
-	RateLimitHeader   = "x-amzn-RateLimit-Limit"
-	ServiceExecuteAPI = "execute-api"
+	AccessTokenHeader      = "X-Amz-Access-Token"
+	RateLimitHeader        = "x-amzn-RateLimit-Limit"
+	RequestIDHeader        = "x-amzn-RequestId"
+	IdempotencyTokenHeader = "x-amzn-idempotency-token"
+	ServiceExecuteAPI      = "execute-api"
+)
+
+const (
+	ScopeNotifications            Scope = "sellingpartnerapi::notifications"
+	ScopeClientCredentialRotation Scope = "sellingpartnerapi::client_credential:rotation"
 )
 
 const (
@@ -36,6 +51,8 @@ const (
 	Italy                 MarketplaceID = "APJ6JRA9NG5V4"
 	Sweden                MarketplaceID = "A2NODRKZP88ZB9"
 	Poland                MarketplaceID = "A1C3SOZRARQ6R3"
+	Ireland               MarketplaceID = "A28R8C7NBKEWEA"
+	SouthAfrica           MarketplaceID = "AE08WJ6YKNBMC"
 	Egypt                 MarketplaceID = "ARBP9OOSHTCHU"
 	Turkey                MarketplaceID = "A33AVAJ2PDY3EV"
 	SaudiArabia           MarketplaceID = "A17E79C6D8DWNP"
@@ -52,4 +69,10 @@ const (
 	NorthAmerica Endpoint = "https://sellingpartnerapi-na.amazon.com"
 	Europe       Endpoint = "https://sellingpartnerapi-eu.amazon.com"
 	FarEast      Endpoint = "https://sellingpartnerapi-fe.amazon.com"
+
+	// The sandbox endpoints answer calls with the static responses of the API models, see
+	// https://developer-docs.amazon.com/sp-api/docs/the-selling-partner-api-sandbox.
+	SandboxNorthAmerica Endpoint = "https://sandbox.sellingpartnerapi-na.amazon.com"
+	SandboxEurope       Endpoint = "https://sandbox.sellingpartnerapi-eu.amazon.com"
+	SandboxFarEast      Endpoint = "https://sandbox.sellingpartnerapi-fe.amazon.com"
 )
@@ -0,0 +1,17 @@
+package constants
+
+import "testing"
+
+func TestProcessingStatus_IsTerminal(t *testing.T) {
+	for status, want := range map[ProcessingStatus]bool{
+		InQueue:    false,
+		InProgress: false,
+		Done:       true,
+		Cancelled:  true,
+		Fatal:      true,
+	} {
+		if got := status.IsTerminal(); got != want {
+			t.Errorf("%s.IsTerminal() = %v, want %v", status, got, want)
+		}
+	}
+}
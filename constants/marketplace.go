@@ -0,0 +1,65 @@
+package constants
+
+import "fmt"
+
+// endpointRegions maps the SP-API endpoints to their AWS regions.
+var endpointRegions = map[Endpoint]Region{
+	NorthAmerica: USEast,
+	Europe:       EUWest,
+	FarEast:      USWest,
+
+	SandboxNorthAmerica: USEast,
+	SandboxEurope:       EUWest,
+	SandboxFarEast:      USWest,
+}
+
+// marketplaceEndpoints maps the marketplaces to the endpoint serving them.
+var marketplaceEndpoints = map[MarketplaceID]Endpoint{
+	Canada:                NorthAmerica,
+	UnitedStatesOfAmerica: NorthAmerica,
+	Mexico:                NorthAmerica,
+	Brazil:                NorthAmerica,
+	Spain:                 Europe,
+	UnitedKingdom:         Europe,
+	France:                Europe,
+	Ireland:               Europe,
+	Belgium:               Europe,
+	Netherlands:           Europe,
+	Germany:               Europe,
+	Italy:                 Europe,
+	Sweden:                Europe,
+	SouthAfrica:           Europe,
+	Poland:                Europe,
+	Egypt:                 Europe,
+	Turkey:                Europe,
+	SaudiArabia:           Europe,
+	UnitedArabEmirates:    Europe,
+	India:                 Europe,
+	Singapore:             FarEast,
+	Australia:             FarEast,
+	Japan:                 FarEast,
+}
+
+// Region returns the AWS region of the endpoint, or an empty Region for unknown endpoints.
+func (e Endpoint) Region() Region {
+	return endpointRegions[e]
+}
+
+// Endpoint returns the endpoint serving the marketplace, or an empty Endpoint for unknown marketplaces.
+func (m MarketplaceID) Endpoint() Endpoint {
+	return marketplaceEndpoints[m]
+}
+
+// Region returns the AWS region of the endpoint serving the marketplace.
+func (m MarketplaceID) Region() Region {
+	return m.Endpoint().Region()
+}
+
+// EndpointForMarketplace returns the endpoint serving the marketplace.
+func EndpointForMarketplace(marketplaceID MarketplaceID) (Endpoint, error) {
+	endpoint := marketplaceID.Endpoint()
+	if endpoint == "" {
+		return "", fmt.Errorf("unknown marketplace %s", marketplaceID)
+	}
+	return endpoint, nil
+}
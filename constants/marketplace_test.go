@@ -0,0 +1,24 @@
+package constants
+
+import "testing"
+
+func TestEndpointForMarketplace(t *testing.T) {
+	for marketplaceID, want := range map[MarketplaceID]Endpoint{
+		UnitedStatesOfAmerica: NorthAmerica,
+		Germany:               Europe,
+		India:                 Europe,
+		Japan:                 FarEast,
+	} {
+		got, err := EndpointForMarketplace(marketplaceID)
+		if err != nil || got != want {
+			t.Errorf("EndpointForMarketplace(%s) = %s, %v, want %s", marketplaceID, got, err, want)
+		}
+	}
+
+	if _, err := EndpointForMarketplace("UNKNOWN"); err == nil {
+		t.Error("EndpointForMarketplace(UNKNOWN) should fail")
+	}
+	if got := Australia.Region(); got != USWest {
+		t.Errorf("Australia.Region() = %s, want %s", got, USWest)
+	}
+}
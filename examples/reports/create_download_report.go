@@ -1,22 +1,24 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	sp_api "github.com/fond-of-vertigo/amazon-sp-api"
 	"github.com/fond-of-vertigo/amazon-sp-api/apis"
 	"github.com/fond-of-vertigo/amazon-sp-api/apis/reports"
 	"github.com/fond-of-vertigo/amazon-sp-api/apis/tokens"
 	"github.com/fond-of-vertigo/amazon-sp-api/constants"
-	"github.com/fond-of-vertigo/logger"
 	"io"
+	"log/slog"
 	"net/http"
+	"os"
 	"time"
 )
 
 const PollingDelay = time.Second * 5
 
 func main() {
-	log := logger.New(logger.LvlDebug)
+	log := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
 	c := sp_api.Config{
 		ClientID:     "EXAMPLE_CLIENTID",
 		ClientSecret: "EXAMPLE_SECRET",
@@ -29,8 +31,15 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	defer client.Close()
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := client.Close(ctx); err != nil {
+			log.Error("Closing client failed", "error", err)
+		}
+	}()
 
+	ctx := context.Background()
 	now := time.Now()
 	from := now.Add(-24 * time.Hour * 7)
 	spec := &reports.CreateReportSpecification{
@@ -39,57 +48,58 @@ func main() {
 		DataEndTime:    apis.JsonTimeISO8601{Time: now},
 		MarketplaceIDs: []constants.MarketplaceID{constants.Germany},
 	}
-	reportID, err := RequestReport(log, client, spec)
+	reportID, err := RequestReport(ctx, log, client, spec)
 	if err != nil {
-		log.Errorf("Report could not be requested: %w - %v", err, err)
+		log.Error("Report could not be requested", "error", err)
 		return
 	}
-	getReport, err := WaitForReport(log, client, reportID)
+	getReport, err := WaitForReport(ctx, log, client, reportID)
 	if err != nil {
-		log.Errorf("Report could not be requested: %w", err)
-		log.Errorf("Error while waiting for report(%s): %w", reportID, err)
+		log.Error("Error while waiting for report", "reportID", reportID, "error", err)
 		return
 	}
-	r, err := DownloadReport(log, client, getReport, true)
+	r, err := DownloadReport(ctx, log, client, getReport, true)
 	if err != nil {
-		log.Errorf("Report could not be downloaded: %w", err)
+		log.Error("Report could not be downloaded", "error", err)
 		return
 	}
-	log.Infof("Report data: %s", r)
+	log.Info("Report downloaded", "data", string(r))
 }
 
-func RequestReport(log logger.Logger, client *sp_api.Client, specification *reports.CreateReportSpecification) (string, error) {
-	createdReportResp, err := client.ReportsAPI.CreateReport(specification)
+func RequestReport(ctx context.Context, log *slog.Logger, client *sp_api.Client, specification *reports.CreateReportSpecification) (string, error) {
+	createdReportResp, err := client.ReportsAPI.CreateReport(ctx, specification)
 	if err != nil {
 		return "", err
 	}
 	if createdReportResp.IsError() {
 		return "", fmt.Errorf("creating report failed with status %v. ErrorList: %v", createdReportResp.Status, createdReportResp.ErrorList)
 	}
-	log.Infof("API with ID=%s was queued..", createdReportResp.ResponseBody.ReportID)
+	log.Info("Report was queued", "reportID", createdReportResp.ResponseBody.ReportID)
 	return createdReportResp.ResponseBody.ReportID, nil
 }
-func WaitForReport(log logger.Logger, client *sp_api.Client, reportID string) (*reports.GetReportResponse, error) {
+func WaitForReport(ctx context.Context, log *slog.Logger, client *sp_api.Client, reportID string) (*reports.GetReportResponse, error) {
 	var getReportResp *apis.CallResponse[reports.GetReportResponse]
 	var err error
-	for getReportResp == nil || !getReportResp.ResponseBody.ProcessingStatus.IsDone() {
-		getReportResp, err = client.ReportsAPI.GetReport(reportID)
+	for getReportResp == nil || !getReportResp.ResponseBody.ProcessingStatus.IsTerminal() {
+		getReportResp, err = client.ReportsAPI.GetReport(ctx, reportID)
 		if err != nil {
 			return nil, err
 		}
 		if getReportResp.IsError() {
 			return nil, fmt.Errorf("waiting for report(id: %v) failed with status %v. ErrorList: %v", reportID, getReportResp.Status, getReportResp.ErrorList)
 		}
-		log.Infof("API with ID=%s has processingStatus=%s", getReportResp.ResponseBody.ReportID, getReportResp.ResponseBody.ProcessingStatus)
-		log.Infof("Wait %v seconds", PollingDelay.Seconds())
+		log.Info("Waiting for report", "reportID", getReportResp.ResponseBody.ReportID, "processingStatus", getReportResp.ResponseBody.ProcessingStatus, "delay", PollingDelay)
 		time.Sleep(PollingDelay)
 	}
+	if !getReportResp.ResponseBody.ProcessingStatus.IsDone() {
+		return nil, fmt.Errorf("report(id: %v) finished with processingStatus=%s", reportID, getReportResp.ResponseBody.ProcessingStatus)
+	}
 	return getReportResp.ResponseBody, nil
 }
-func DownloadReport(log logger.Logger, client *sp_api.Client, getReport *reports.GetReportResponse, useRDT bool) ([]byte, error) {
+func DownloadReport(ctx context.Context, log *slog.Logger, client *sp_api.Client, getReport *reports.GetReportResponse, useRDT bool) ([]byte, error) {
 	var rdt *string
 	if useRDT {
-		log.Infof("Fetching RDT for %s", getReport.GetDocumentAPIPath())
+		log.Info("Fetching RDT", "path", getReport.GetDocumentAPIPath())
 		rr := &tokens.CreateRestrictedDataTokenRequest{
 			RestrictedResources: []tokens.RestrictedResource{
 				{
@@ -98,25 +108,25 @@ func DownloadReport(log logger.Logger, client *sp_api.Client, getReport *reports
 				},
 			},
 		}
-		tokenResp, err := client.TokenAPI.CreateRestrictedDataTokenRequest(rr)
+		tokenResp, err := client.TokenAPI.CreateRestrictedDataTokenRequest(ctx, rr)
 		if err != nil {
 			return nil, err
 		}
 		if tokenResp.IsError() {
 			return nil, fmt.Errorf("create RestrictedDataToken failed with status %v. ErrorList: %v", tokenResp.Status, tokenResp.ErrorList)
 		}
-		log.Infof("Fetched RDT=%s", tokenResp.ResponseBody.RestrictedDataToken)
+		log.Info("Fetched RDT")
 		rdt = tokenResp.ResponseBody.RestrictedDataToken
 	}
 
-	getRepDocResp, err := client.ReportsAPI.GetReportDocument(*getReport.ReportDocumentID, rdt)
+	getRepDocResp, err := client.ReportsAPI.GetReportDocument(ctx, *getReport.ReportDocumentID, rdt)
 	if err != nil {
 		return nil, err
 	}
 	if getRepDocResp.IsError() {
 		return nil, fmt.Errorf("create GetReportDocument request failed with status %v. ErrorList: %v", getRepDocResp.Status, getRepDocResp.ErrorList)
 	}
-	log.Infof("Downloading document ID=%s via URL=%s", getRepDocResp.ResponseBody.ReportDocumentID, getRepDocResp.ResponseBody.Url)
+	log.Info("Downloading document", "reportDocumentID", getRepDocResp.ResponseBody.ReportDocumentID)
 
 	httpResp, httpErr := http.Get(getRepDocResp.ResponseBody.Url)
 	if httpErr != nil {
@@ -3,7 +3,6 @@ module github.com/fond-of-vertigo/amazon-sp-api
 go 1.21
 
 require (
-	github.com/fond-of-vertigo/logger v1.0.1
 	github.com/google/go-cmp v0.6.0
 	github.com/stretchr/testify v1.8.4
 )
@@ -0,0 +1,58 @@
+package httpx
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+// AuditRecord documents a request sent to SP-API. It contains no bodies and no query parameters.
+type AuditRecord struct {
+	Time time.Time
+	// SellerID is the ClientConfig.SellerID of the client.
+	SellerID string
+	// Operation is e.g. "orders.GetOrders", or empty for requests which weren't created by apis.Call.
+	Operation string
+	Method    string
+	Path      string
+	// StatusCode is the status of the response, or 0 if the request failed without response.
+	StatusCode int
+	// RequestID is the x-amzn-RequestId of the response.
+	RequestID string
+	// Error is the error of requests without response.
+	Error string
+}
+
+// AuditSink records each request, e.g. for compliance evidence of the data accessed.
+// Implementations must be safe for concurrent use.
+type AuditSink interface {
+	Record(record AuditRecord)
+}
+
+// AuditSinkFunc adapts a function to the AuditSink interface.
+type AuditSinkFunc func(record AuditRecord)
+
+func (f AuditSinkFunc) Record(record AuditRecord) {
+	f(record)
+}
+
+func (h *Client) audit(req *http.Request, operation string, start time.Time, resp *http.Response, err error) {
+	if h.auditSink == nil {
+		return
+	}
+	record := AuditRecord{
+		Time:      start,
+		SellerID:  h.sellerID,
+		Operation: operation,
+		Method:    req.Method,
+		Path:      req.URL.Path,
+	}
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.StatusCode = resp.StatusCode
+		record.RequestID = resp.Header.Get(constants.RequestIDHeader)
+	}
+	h.auditSink.Record(record)
+}
@@ -1,64 +1,307 @@
 package httpx
 
 import (
-	"github.com/fond-of-vertigo/amazon-sp-api/constants"
-	"io"
+	"context"
+	"fmt"
+	"log/slog"
 	"net/http"
+	"sync"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
 )
 
 type ClientConfig struct {
 	HTTPClient         HTTPRequester
 	TokenUpdaterConfig TokenUpdaterConfig
-	Endpoint           constants.Endpoint
+	// TokenProvider replaces the built-in token updater, e.g. to use access tokens vended by a central
+	// auth service. The TokenUpdaterConfig is still used for the tokens of grantless operations.
+	TokenProvider TokenProvider
+	Endpoint      constants.Endpoint
+	// RateLimiter limits the calls per operation before they are sent. Calls are not limited if nil.
+	RateLimiter RateLimiter
+	// ConcurrencyLimiter caps the number of requests in flight. Requests are not limited if nil.
+	ConcurrencyLimiter *ConcurrencyLimiter
+	// Metrics receives the metrics of all requests and token refreshes. Metrics are not recorded if nil.
+	Metrics MetricsRecorder
+	// AuditSink records every request without bodies. Requests are not audited if nil.
+	AuditSink AuditSink
+	// SellerID identifies the seller of the client in the AuditRecords.
+	SellerID string
+	// RetryPolicy configures the retries of failed calls. Defaults to apis.DefaultRetryPolicy.
+	RetryPolicy *apis.RetryPolicy
+	// DecodeOptions configure the decoding of the response bodies.
+	DecodeOptions apis.DecodeOptions
+	// Application is appended to the User-Agent of all calls, e.g. "MyApp/2.1".
+	Application string
+	// DisableCompression stops requesting gzip compressed responses.
+	DisableCompression bool
+	// CloseIdleConnections closes the idle connections of HTTPClient on Close. Leave it unset if
+	// HTTPClient is shared with other clients.
+	CloseIdleConnections bool
+	// Log receives the warnings about calls of deprecated operations. Defaults to slog.Default().
+	Log *slog.Logger
+}
+
+// TokenProvider returns the access token of the seller for SP-API calls.
+type TokenProvider interface {
+	AccessToken(ctx context.Context) (string, error)
+}
+
+// TokenRefresher can be implemented by a TokenProvider to fetch a new access token on demand.
+// The Client uses it when SP-API rejects the current access token with 401 Unauthorized.
+type TokenRefresher interface {
+	// RefreshAccessToken replaces staleToken with a new access token. It must not fetch a new
+	// token if staleToken was already replaced by a concurrent refresh.
+	RefreshAccessToken(ctx context.Context, staleToken string) error
+}
+
+// TokenProviderFunc adapts a function to the TokenProvider interface.
+type TokenProviderFunc func(ctx context.Context) (string, error)
+
+func (f TokenProviderFunc) AccessToken(ctx context.Context) (string, error) {
+	return f(ctx)
 }
 
 func NewClient(config ClientConfig) (c *Client, err error) {
 	c = &Client{
-		httpClient: config.HTTPClient,
-		endpoint:   config.Endpoint,
+		httpClient:           config.HTTPClient,
+		endpoint:             config.Endpoint,
+		tokenProvider:        config.TokenProvider,
+		rateLimiter:          config.RateLimiter,
+		concurrencyLimiter:   config.ConcurrencyLimiter,
+		metrics:              config.Metrics,
+		auditSink:            config.AuditSink,
+		sellerID:             config.SellerID,
+		retryPolicy:          config.RetryPolicy,
+		decodeOptions:        config.DecodeOptions,
+		userAgent:            UserAgent(config.Application),
+		disableCompression:   config.DisableCompression,
+		closeIdleConnections: config.CloseIdleConnections,
+		log:                  config.Log,
+	}
+	if c.log == nil {
+		c.log = slog.Default()
 	}
 
-	c.tokenUpdater = newTokenUpdater(config.TokenUpdaterConfig)
-	if c.tokenUpdaterCancelFunc, err = c.tokenUpdater.RunInBackground(); err != nil {
-		return nil, err
+	c.grantlessTokenUpdater = newGrantlessTokenUpdater(config.TokenUpdaterConfig)
+	if config.TokenUpdaterConfig.Metrics == nil {
+		config.TokenUpdaterConfig.Metrics = config.Metrics
+	}
+	if c.tokenProvider == nil {
+		updater := newTokenUpdater(config.TokenUpdaterConfig)
+		c.tokenProvider = updater
+		c.tokenUpdater = updater
+		if err = c.startTokenUpdater(); err != nil {
+			return nil, err
+		}
 	}
 
 	return c, nil
 }
 
+// startTokenUpdater runs the token updater with a context owned by the client, which is cancelled by Close.
+func (h *Client) startTokenUpdater() (err error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	h.cancelBackground = cancel
+	if h.tokenUpdaterStopped, err = h.tokenUpdater.RunInBackground(ctx); err != nil {
+		cancel()
+		return err
+	}
+	return nil
+}
+
 type Client struct {
-	tokenUpdater           tokenUpdater
-	tokenUpdaterCancelFunc func()
-	httpClient             HTTPRequester
-	endpoint               constants.Endpoint
+	tokenProvider         TokenProvider
+	tokenUpdater          tokenUpdater
+	tokenUpdaterStopped   <-chan struct{}
+	cancelBackground      context.CancelFunc
+	inFlight              inFlight
+	closeIdleConnections  bool
+	grantlessTokenUpdater *grantlessTokenUpdater
+	httpClient            HTTPRequester
+	endpoint              constants.Endpoint
+	rateLimiter           RateLimiter
+	concurrencyLimiter    *ConcurrencyLimiter
+	metrics               MetricsRecorder
+	rateLimitStats        rateLimitStats
+	auditSink             AuditSink
+	sellerID              string
+	retryPolicy           *apis.RetryPolicy
+	decodeOptions         apis.DecodeOptions
+	userAgent             string
+	disableCompression    bool
+	log                   *slog.Logger
+	// deprecations are the deprecated operations already reported.
+	deprecations sync.Map
 }
 
 type HTTPRequester interface {
 	Do(req *http.Request) (*http.Response, error)
-	Post(url string, bodyType string, body io.Reader) (*http.Response, error)
 }
 
 type tokenUpdater interface {
-	GetAccessToken() string
-	RunInBackground() (cancel func(), err error)
+	RunInBackground(ctx context.Context) (stopped <-chan struct{}, err error)
 }
 
+// Do sends the request with the User-Agent of the SDK and the access token of the seller, unless the request already carries
+// a restrictedDataToken or grantless access token. Responses are requested gzip compressed and decompressed transparently. It fails with the error of the token updater,
+// if the access token can't be refreshed anymore.
+// If SP-API rejects the seller's access token with 401 Unauthorized, e.g. because it was revoked
+// or expired early, Do forces a token refresh and sends the request once more.
 func (h *Client) Do(req *http.Request) (*http.Response, error) {
-	h.addAccessTokenToHeader(req)
+	return h.track(req, h.do)
+}
+
+func (h *Client) do(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", h.userAgent)
+	}
+	usesSellerToken := req.Header.Get(constants.AccessTokenHeader) == ""
+	if err := h.addAccessTokenToHeader(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.send(req)
+	if err != nil || !usesSellerToken || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	return h.retryWithRefreshedToken(req, resp)
+}
+
+// retryWithRefreshedToken sends req again with a new access token. It returns the original
+// response if the token provider can't refresh tokens or the request body can't be replayed.
+func (h *Client) retryWithRefreshedToken(req *http.Request, resp *http.Response) (*http.Response, error) {
+	refresher, ok := h.tokenProvider.(TokenRefresher)
+	if !ok || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return resp, nil
+	}
+
+	staleToken := req.Header.Get(constants.AccessTokenHeader)
+	if err := refresher.RefreshAccessToken(req.Context(), staleToken); err != nil {
+		return resp, nil
+	}
 
-	return h.httpClient.Do(req)
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	retry.Header.Del(constants.AccessTokenHeader)
+	if err := h.addAccessTokenToHeader(retry); err != nil {
+		return resp, nil
+	}
+
+	_ = resp.Body.Close()
+	return h.send(retry)
+}
+
+// DoPresigned sends the request without adding the access token. Use it for presigned
+// URLs (e.g. report or Data Kiosk documents) which carry their own authorization.
+func (h *Client) DoPresigned(req *http.Request) (*http.Response, error) {
+	return h.track(req, func(req *http.Request) (*http.Response, error) {
+		return h.limitConcurrency(req, h.doObserved)
+	})
+}
+
+// GetGrantlessAccessToken returns an access token for grantless operations of the given scope,
+// e.g. for the Notifications destinations or the Application Management API.
+func (h *Client) GetGrantlessAccessToken(ctx context.Context, scope constants.Scope) (string, error) {
+	return h.grantlessTokenUpdater.GetAccessToken(ctx, scope)
+}
+
+// TokenUpdater returns the built-in token updater, e.g. to report its health. It returns nil
+// if the client uses a custom TokenProvider.
+func (h *Client) TokenUpdater() *PeriodicTokenUpdater {
+	updater, _ := h.tokenUpdater.(*PeriodicTokenUpdater)
+	return updater
+}
+
+// WaitForRateLimit blocks until the operation may be called according to the RateLimiter
+// of the client. It returns immediately if the client has no RateLimiter.
+func (h *Client) WaitForRateLimit(ctx context.Context, operation string, interval time.Duration, burst int) error {
+	if h.rateLimiter == nil {
+		return nil
+	}
+	return h.rateLimiter.Acquire(ctx, operation, RateLimit{Interval: interval, Burst: burst})
+}
+
+// ObserveRateLimit passes the rate limit reported by SP-API for the operation to the RateLimiter
+// of the client, if it implements RateLimitObserver.
+func (h *Client) ObserveRateLimit(operation string, callsPerSecond float64) {
+	h.rateLimitStats.update(operation, func(stats *RateLimitStats) {
+		stats.Limit = callsPerSecond
+		stats.LimitObservedAt = nowFunc()
+	})
+	if observer, ok := h.rateLimiter.(RateLimitObserver); ok {
+		observer.Observe(operation, callsPerSecond)
+	}
+}
+
+// RateLimiter returns the RateLimiter of the client, or nil if calls are not rate limited.
+func (h *Client) RateLimiter() RateLimiter {
+	return h.rateLimiter
+}
+
+// DecodeOptions returns the configured DecodeOptions.
+func (h *Client) DecodeOptions() apis.DecodeOptions {
+	return h.decodeOptions
+}
+
+// RetryPolicy returns the configured RetryPolicy or apis.DefaultRetryPolicy.
+func (h *Client) RetryPolicy() apis.RetryPolicy {
+	if h.retryPolicy == nil {
+		return apis.DefaultRetryPolicy()
+	}
+	return *h.retryPolicy
 }
 
 func (h *Client) GetEndpoint() constants.Endpoint {
 	return h.endpoint
 }
 
-func (h *Client) Close() {
-	h.tokenUpdaterCancelFunc()
+// Close rejects new requests with ErrClientClosed, stops the background token updater and waits
+// until it has stopped and the requests in flight have finished, i.e. their response bodies were
+// closed. If ctx is done first, the requests in flight are cancelled and ctx.Err() is returned.
+// Idle connections of the HTTPClient are closed if it has a CloseIdleConnections method and
+// CloseIdleConnections is set. It is safe to call Close multiple times.
+func (h *Client) Close(ctx context.Context) error {
+	drained := h.inFlight.close()
+	if h.tokenUpdater != nil {
+		h.cancelBackground()
+		select {
+		case <-h.tokenUpdaterStopped:
+		case <-ctx.Done():
+			h.inFlight.cancel()
+			return fmt.Errorf("stopping token updater: %w", ctx.Err())
+		}
+	}
+
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		h.inFlight.cancel()
+		return fmt.Errorf("waiting for requests in flight: %w", ctx.Err())
+	}
+
+	if closer, ok := h.httpClient.(interface{ CloseIdleConnections() }); ok && h.closeIdleConnections {
+		closer.CloseIdleConnections()
+	}
+	return nil
 }
 
-func (h *Client) addAccessTokenToHeader(req *http.Request) {
-	if req.Header.Get(constants.AccessTokenHeader) == "" {
-		req.Header.Add(constants.AccessTokenHeader, h.tokenUpdater.GetAccessToken())
+func (h *Client) addAccessTokenToHeader(req *http.Request) error {
+	if req.Header.Get(constants.AccessTokenHeader) != "" {
+		return nil
+	}
+	token, err := h.tokenProvider.AccessToken(req.Context())
+	if err != nil {
+		return err
 	}
+	req.Header.Add(constants.AccessTokenHeader, token)
+	return nil
 }
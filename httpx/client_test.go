@@ -2,21 +2,36 @@ package httpx
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
 	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/fond-of-vertigo/amazon-sp-api/constants"
 )
 
 type mockTokenUpdater struct {
 	ReturnAccessToken string
+	ReturnErr         error
 }
 
-func (m *mockTokenUpdater) GetAccessToken() string {
-	return m.ReturnAccessToken
+func (m *mockTokenUpdater) AccessToken(_ context.Context) (string, error) {
+	return m.ReturnAccessToken, m.ReturnErr
 }
-func (m *mockTokenUpdater) RunInBackground() (func(), error) {
-	return func() {}, nil
+func (m *mockTokenUpdater) RunInBackground(ctx context.Context) (<-chan struct{}, error) {
+	stopped := make(chan struct{})
+	go func() {
+		<-ctx.Done()
+		close(stopped)
+	}()
+	return stopped, nil
 }
 
 func Test_httpClient_addAccessToken(t *testing.T) {
@@ -53,13 +68,491 @@ func Test_httpClient_addAccessToken(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			h := &Client{
-				httpClient:   nil,
-				tokenUpdater: tt.fields.TokenUpdater,
+				httpClient:    nil,
+				tokenProvider: tt.fields.TokenUpdater,
+			}
+			if err := h.addAccessTokenToHeader(tt.request); err != nil {
+				t.Fatal(err)
 			}
-			h.addAccessTokenToHeader(tt.request)
 			if tt.request.Header.Get(constants.AccessTokenHeader) != tt.wantAccessToken {
 				t.Fatalf("Token %s != %s", tt.request.Header.Get(constants.AccessTokenHeader), tt.wantAccessToken)
 			}
 		})
 	}
 }
+
+func TestClient_CloseIsIdempotent(t *testing.T) {
+	c := &Client{tokenUpdater: &mockTokenUpdater{}}
+	if err := c.startTokenUpdater(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-c.tokenUpdaterStopped:
+	default:
+		t.Error("token updater should be stopped after Close")
+	}
+}
+
+func TestClient_DoFailsOnTerminalTokenError(t *testing.T) {
+	tokenErr := &LWAError{StatusCode: http.StatusBadRequest, Code: "invalid_grant", Description: "The request has an invalid grant parameter"}
+	h := &Client{tokenProvider: &mockTokenUpdater{ReturnErr: tokenErr}}
+
+	req, _ := http.NewRequest(http.MethodGet, "example.com", nil)
+	_, err := h.Do(req)
+	if !errors.Is(err, ErrInvalidGrant) {
+		t.Errorf("Do() error = %v, want ErrInvalidGrant", err)
+	}
+}
+
+func TestNewClient_WithTokenProvider(t *testing.T) {
+	c, err := NewClient(ClientConfig{
+		TokenProvider: TokenProviderFunc(func(ctx context.Context) (string, error) {
+			return "VENDED-TOKEN", nil
+		}),
+		Endpoint: constants.Europe,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close(context.Background())
+
+	req, _ := http.NewRequest(http.MethodGet, "example.com", nil)
+	if err = c.addAccessTokenToHeader(req); err != nil {
+		t.Fatal(err)
+	}
+	if got := req.Header.Get(constants.AccessTokenHeader); got != "VENDED-TOKEN" {
+		t.Errorf("Token %s != VENDED-TOKEN", got)
+	}
+}
+
+type refreshingTokenProvider struct {
+	token     string
+	refreshes int
+}
+
+func (p *refreshingTokenProvider) AccessToken(_ context.Context) (string, error) {
+	return p.token, nil
+}
+
+func (p *refreshingTokenProvider) RefreshAccessToken(_ context.Context, staleToken string) error {
+	if p.token == staleToken {
+		p.refreshes++
+		p.token = "FRESH-TOKEN"
+	}
+	return nil
+}
+
+type requesterFunc func(req *http.Request) (*http.Response, error)
+
+func (f requesterFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClient_DoRetriesOnceWithRefreshedToken(t *testing.T) {
+	provider := &refreshingTokenProvider{token: "REVOKED-TOKEN"}
+	var bodies []string
+	h := &Client{
+		tokenProvider: provider,
+		httpClient: requesterFunc(func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			bodies = append(bodies, string(body))
+			status := http.StatusOK
+			if req.Header.Get(constants.AccessTokenHeader) != "FRESH-TOKEN" {
+				status = http.StatusUnauthorized
+			}
+			return &http.Response{StatusCode: status, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+		}),
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, "example.com", bytes.NewBufferString(`{"a":1}`))
+	resp, err := h.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode %d != %d", resp.StatusCode, http.StatusOK)
+	}
+	if provider.refreshes != 1 {
+		t.Errorf("refreshes %d != 1", provider.refreshes)
+	}
+	if len(bodies) != 2 || bodies[1] != `{"a":1}` {
+		t.Errorf("request bodies = %q, want the body sent twice", bodies)
+	}
+}
+
+func TestClient_DoSurfaces401AfterRetry(t *testing.T) {
+	provider := &refreshingTokenProvider{token: "REVOKED-TOKEN"}
+	calls := 0
+	h := &Client{
+		tokenProvider: provider,
+		httpClient: requesterFunc(func(req *http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{StatusCode: http.StatusUnauthorized, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+		}),
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "example.com", nil)
+	resp, err := h.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusUnauthorized || calls != 2 {
+		t.Errorf("StatusCode %d after %d calls, want 401 after 2 calls", resp.StatusCode, calls)
+	}
+}
+
+func TestNewClient_UserAgent(t *testing.T) {
+	var userAgent string
+	c, err := NewClient(ClientConfig{
+		HTTPClient: requesterFunc(func(req *http.Request) (*http.Response, error) {
+			userAgent = req.Header.Get("User-Agent")
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+		}),
+		TokenProvider: TokenProviderFunc(func(ctx context.Context) (string, error) {
+			return "ACCESS-TOKEN", nil
+		}),
+		Application: "MyApp/2.1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close(context.Background())
+
+	req, _ := http.NewRequest(http.MethodGet, "example.com", nil)
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if !strings.HasPrefix(userAgent, "amazon-sp-api-sdk-go/") || !strings.HasSuffix(userAgent, "; Platform="+runtime.GOOS+"/"+runtime.GOARCH+") MyApp/2.1") {
+		t.Errorf("User-Agent %s", userAgent)
+	}
+}
+
+func TestClient_DoDecompressesGzip(t *testing.T) {
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	_, _ = gz.Write([]byte(`{"payload":{}}`))
+	_ = gz.Close()
+
+	for _, disabled := range []bool{false, true} {
+		var acceptEncoding string
+		h := &Client{
+			tokenProvider:      &mockTokenUpdater{ReturnAccessToken: "ACCESS-TOKEN"},
+			disableCompression: disabled,
+			httpClient: requesterFunc(func(req *http.Request) (*http.Response, error) {
+				acceptEncoding = req.Header.Get("Accept-Encoding")
+				if acceptEncoding != "gzip" {
+					return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{"payload":{}}`))}, nil
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Encoding": {"gzip"}},
+					Body:       io.NopCloser(bytes.NewReader(compressed.Bytes())),
+				}, nil
+			}),
+		}
+
+		req, _ := http.NewRequest(http.MethodGet, "example.com", nil)
+		resp, err := h.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		if string(body) != `{"payload":{}}` {
+			t.Errorf("disabled=%v: body = %q", disabled, body)
+		}
+		if wantGzip := !disabled; (acceptEncoding == "gzip") != wantGzip {
+			t.Errorf("disabled=%v: Accept-Encoding = %q", disabled, acceptEncoding)
+		}
+	}
+}
+
+func TestClient_DoDecompressesGzipAfterRetry(t *testing.T) {
+	provider := &refreshingTokenProvider{token: "REVOKED-TOKEN"}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(constants.AccessTokenHeader) != "FRESH-TOKEN" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("Accept-Encoding = %q, want gzip", r.Header.Get("Accept-Encoding"))
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		_, _ = gz.Write([]byte(`{"payload":{}}`))
+		_ = gz.Close()
+	}))
+	defer srv.Close()
+	h := &Client{tokenProvider: provider, httpClient: srv.Client()}
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	resp, err := h.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || string(body) != `{"payload":{}}` {
+		t.Errorf("StatusCode %d, body %q, want the decompressed body", resp.StatusCode, body)
+	}
+	if req.Header.Get("Accept-Encoding") != "" {
+		t.Errorf("Accept-Encoding %q was set on the request of the caller", req.Header.Get("Accept-Encoding"))
+	}
+}
+
+func TestClient_DoReusesGzipReaders(t *testing.T) {
+	bodies := []string{`{"payload":{"first":true}}`, `{"payload":{"second":true}}`}
+	var calls int
+	h := &Client{
+		tokenProvider: &mockTokenUpdater{ReturnAccessToken: "ACCESS-TOKEN"},
+		httpClient: requesterFunc(func(req *http.Request) (*http.Response, error) {
+			var compressed bytes.Buffer
+			gz := gzip.NewWriter(&compressed)
+			_, _ = gz.Write([]byte(bodies[calls]))
+			_ = gz.Close()
+			calls++
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Encoding": {"gzip"}},
+				Body:       io.NopCloser(&compressed),
+			}, nil
+		}),
+	}
+
+	for _, want := range bodies {
+		req, _ := http.NewRequest(http.MethodGet, "example.com", nil)
+		resp, err := h.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		if string(body) != want {
+			t.Errorf("body = %q, want %q", body, want)
+		}
+		_ = resp.Body.Close()
+		if _, err = resp.Body.Read(make([]byte, 1)); !errors.Is(err, http.ErrBodyReadAfterClose) {
+			t.Errorf("Read() after Close error = %v, want http.ErrBodyReadAfterClose", err)
+		}
+	}
+}
+
+func TestClient_DoLimitsConcurrency(t *testing.T) {
+	limiter := NewConcurrencyLimiter(1)
+	h := &Client{
+		tokenProvider:      &mockTokenUpdater{ReturnAccessToken: "ACCESS-TOKEN"},
+		concurrencyLimiter: limiter,
+		httpClient: requesterFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+		}),
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "example.com", nil)
+	resp, err := h.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := limiter.InFlight(); got != 1 {
+		t.Errorf("InFlight() = %d, the request is in flight until its body is closed", got)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	queued, _ := http.NewRequestWithContext(ctx, http.MethodGet, "example.com", nil)
+	if _, err = h.Do(queued); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Do() error = %v, want context.DeadlineExceeded", err)
+	}
+
+	_ = resp.Body.Close()
+	_ = resp.Body.Close()
+	if got := limiter.InFlight(); got != 0 {
+		t.Errorf("InFlight() = %d after the body was closed", got)
+	}
+}
+
+type recordingRateLimiter struct {
+	operations []string
+	limits     []RateLimit
+}
+
+func (r *recordingRateLimiter) Acquire(_ context.Context, operation string, limit RateLimit) error {
+	r.operations = append(r.operations, operation)
+	r.limits = append(r.limits, limit)
+	return nil
+}
+
+func TestClient_WaitForRateLimitWithCustomLimiter(t *testing.T) {
+	limiter := &recordingRateLimiter{}
+	h := &Client{rateLimiter: limiter}
+
+	if err := h.WaitForRateLimit(context.Background(), "orders.GetOrders", time.Minute, 20); err != nil {
+		t.Fatal(err)
+	}
+	h.ObserveRateLimit("orders.GetOrders", 0.5)
+
+	if len(limiter.operations) != 1 || limiter.operations[0] != "orders.GetOrders" {
+		t.Errorf("Acquire() called with operations %v", limiter.operations)
+	}
+	if want := (RateLimit{Interval: time.Minute, Burst: 20}); limiter.limits[0] != want {
+		t.Errorf("Acquire() called with limit %+v, want %+v", limiter.limits[0], want)
+	}
+}
+
+func TestClient_ReportDeprecationLogsOncePerOperation(t *testing.T) {
+	var logs bytes.Buffer
+	h := &Client{log: slog.New(slog.NewTextHandler(&logs, nil))}
+
+	h.ReportDeprecation("finances.ListFinancialEvents", "use finances/v2024.ListTransactions")
+	h.ReportDeprecation("finances.ListFinancialEvents", "use finances/v2024.ListTransactions")
+	h.ReportDeprecation("orders.GetOrders", "Sunset: Wed, 31 Dec 2025 23:59:59 GMT")
+
+	if got := strings.Count(logs.String(), "level=WARN"); got != 2 {
+		t.Errorf("logged %d warnings, want one per operation:\n%s", got, logs.String())
+	}
+	if !strings.Contains(logs.String(), `notice="use finances/v2024.ListTransactions"`) {
+		t.Errorf("warning lacks the notice:\n%s", logs.String())
+	}
+}
+
+func TestClient_RateLimitStats(t *testing.T) {
+	statusCodes := []int{http.StatusOK, http.StatusTooManyRequests}
+	h := &Client{
+		tokenProvider: &mockTokenUpdater{ReturnAccessToken: "ACCESS-TOKEN"},
+		httpClient: requesterFunc(func(req *http.Request) (*http.Response, error) {
+			statusCode := statusCodes[0]
+			statusCodes = statusCodes[1:]
+			return &http.Response{StatusCode: statusCode, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+		}),
+	}
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest(http.MethodGet, "example.com", nil)
+		if _, err := h.Do(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	h.ObserveRateLimit("orders.GetOrders", 0.0167)
+
+	stats := h.RateLimitStats()
+	if got := stats[""]; got.Requests != 2 || got.Throttles != 1 || got.LastThrottledAt.IsZero() {
+		t.Errorf("RateLimitStats() of requests = %+v, want 2 requests with 1 throttle", got)
+	}
+	if got := stats["orders.GetOrders"]; got.Limit != 0.0167 || got.LimitObservedAt.IsZero() {
+		t.Errorf("RateLimitStats() of orders.GetOrders = %+v, want the observed limit", got)
+	}
+}
+
+func TestClient_DoRecordsAudit(t *testing.T) {
+	var records []AuditRecord
+	h := &Client{
+		tokenProvider: &mockTokenUpdater{ReturnAccessToken: "ACCESS-TOKEN"},
+		sellerID:      "SELLER-A",
+		auditSink: AuditSinkFunc(func(record AuditRecord) {
+			records = append(records, record)
+		}),
+		httpClient: requesterFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"X-Amzn-Requestid": {"REQUEST-1"}},
+				Body:       io.NopCloser(strings.NewReader(`{}`)),
+			}, nil
+		}),
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://sellingpartnerapi-eu.amazon.com/orders/v0/orders/123?MarketplaceIds=A1PA6795UKMFR9", nil)
+	if _, err := h.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("Do() recorded %d audit records, want 1", len(records))
+	}
+	got := records[0]
+	got.Time = time.Time{}
+	want := AuditRecord{SellerID: "SELLER-A", Method: http.MethodGet, Path: "/orders/v0/orders/123", StatusCode: http.StatusOK, RequestID: "REQUEST-1"}
+	if got != want {
+		t.Errorf("Do() recorded %+v, want %+v", got, want)
+	}
+}
+
+type idleClosingRequester struct {
+	requesterFunc
+	closed bool
+}
+
+func (r *idleClosingRequester) CloseIdleConnections() {
+	r.closed = true
+}
+
+func TestClient_CloseWaitsForRequestsInFlight(t *testing.T) {
+	requester := &idleClosingRequester{requesterFunc: func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}}
+	h := &Client{
+		tokenProvider:        &mockTokenUpdater{ReturnAccessToken: "ACCESS-TOKEN"},
+		httpClient:           requester,
+		closeIdleConnections: true,
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "example.com", nil)
+	resp, err := h.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	closed := make(chan error)
+	go func() {
+		closed <- h.Close(context.Background())
+	}()
+	select {
+	case err = <-closed:
+		t.Fatalf("Close() = %v before the response body was closed", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	_ = resp.Body.Close()
+	if err = <-closed; err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+	if !requester.closed {
+		t.Error("idle connections should be closed")
+	}
+	if _, err = h.Do(req); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("Do() after Close error = %v, want ErrClientClosed", err)
+	}
+}
+
+func TestClient_CloseCancelsRequestsOnTimeout(t *testing.T) {
+	started := make(chan struct{})
+	h := &Client{
+		tokenProvider: &mockTokenUpdater{ReturnAccessToken: "ACCESS-TOKEN"},
+		httpClient: requesterFunc(func(req *http.Request) (*http.Response, error) {
+			close(started)
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}),
+	}
+
+	done := make(chan error)
+	go func() {
+		req, _ := http.NewRequest(http.MethodGet, "example.com", nil)
+		_, err := h.Do(req)
+		done <- err
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := h.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Close() error = %v, want context.DeadlineExceeded", err)
+	}
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Do() error = %v, want context.Canceled", err)
+	}
+}
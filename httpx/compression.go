@@ -0,0 +1,83 @@
+package httpx
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// send sends the request with Accept-Encoding gzip, unless compression is disabled or the request
+// already sets an Accept-Encoding, and decompresses gzip encoded responses. Unlike the transparent
+// compression of http.Transport, this also works with custom transports.
+// The header is set on a clone, so that requests sent again, e.g. after a 401, are still
+// decompressed instead of being taken for requests of callers that handle the encoding themselves.
+func (h *Client) send(req *http.Request) (*http.Response, error) {
+	if h.disableCompression || req.Header.Get("Accept-Encoding") != "" {
+		return h.limitConcurrency(req, h.doObserved)
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("Accept-Encoding", "gzip")
+	resp, err := h.limitConcurrency(req, h.doObserved)
+	if err != nil || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return resp, err
+	}
+
+	gz, err := newGzipReader(resp.Body)
+	if err != nil && !errors.Is(err, io.EOF) {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+	resp.Body = &gzipBody{gz: gz, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return resp, nil
+}
+
+// gzipReaders recycles the gzip readers of closed response bodies, since each one allocates tens
+// of KB for its decompression state.
+var gzipReaders sync.Pool
+
+// newGzipReader returns a pooled gzip reader of r. It returns nil if r is empty.
+func newGzipReader(r io.Reader) (*gzip.Reader, error) {
+	gz, ok := gzipReaders.Get().(*gzip.Reader)
+	if !ok {
+		return gzip.NewReader(r)
+	}
+	if err := gz.Reset(r); err != nil {
+		return nil, err
+	}
+	return gz, nil
+}
+
+// gzipBody decompresses the response body and closes the underlying body.
+type gzipBody struct {
+	gz     *gzip.Reader
+	body   io.ReadCloser
+	closed bool
+}
+
+func (g *gzipBody) Read(p []byte) (int, error) {
+	if g.closed {
+		return 0, http.ErrBodyReadAfterClose
+	}
+	if g.gz == nil {
+		return 0, io.EOF
+	}
+	return g.gz.Read(p)
+}
+
+// Close closes the underlying body and returns the gzip reader to the pool.
+func (g *gzipBody) Close() error {
+	if !g.closed && g.gz != nil {
+		gzipReaders.Put(g.gz)
+	}
+	g.closed = true
+	g.gz = nil
+	return g.body.Close()
+}
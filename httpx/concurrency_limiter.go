@@ -0,0 +1,71 @@
+package httpx
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// ConcurrencyLimiter caps the number of SP-API requests in flight. Requests exceeding the limit
+// queue until a running request finished, so bursts from many goroutines are smoothed. A request
+// is in flight until its response body is closed. Share one ConcurrencyLimiter between clients to
+// apply a common limit.
+type ConcurrencyLimiter struct {
+	slots chan struct{}
+}
+
+// NewConcurrencyLimiter returns a limiter allowing maxInFlight concurrent requests, at least one.
+func NewConcurrencyLimiter(maxInFlight int) *ConcurrencyLimiter {
+	return &ConcurrencyLimiter{slots: make(chan struct{}, max(maxInFlight, 1))}
+}
+
+// Acquire blocks until a request may be sent or ctx is done.
+func (l *ConcurrencyLimiter) Acquire(ctx context.Context) error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees the slot of a finished request.
+func (l *ConcurrencyLimiter) Release() {
+	<-l.slots
+}
+
+// InFlight returns the number of requests currently in flight.
+func (l *ConcurrencyLimiter) InFlight() int {
+	return len(l.slots)
+}
+
+// limitConcurrency sends the request with do once the ConcurrencyLimiter of the client has a free slot.
+// The slot is released when the response body is closed.
+func (h *Client) limitConcurrency(req *http.Request, do func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	if h.concurrencyLimiter == nil {
+		return do(req)
+	}
+	if err := h.concurrencyLimiter.Acquire(req.Context()); err != nil {
+		return nil, err
+	}
+	resp, err := do(req)
+	if err != nil {
+		h.concurrencyLimiter.Release()
+		return nil, err
+	}
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: h.concurrencyLimiter.Release}
+	return resp, nil
+}
+
+type releaseOnClose struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.release)
+	return err
+}
@@ -0,0 +1,44 @@
+package httpx
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// Credentials are the LWA credentials used to request access tokens.
+type Credentials struct {
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+}
+
+// CredentialsFunc returns the current LWA credentials, e.g. read from Vault or AWS Secrets Manager.
+// It is called before every token request, so rotated secrets are picked up without a restart.
+type CredentialsFunc func(ctx context.Context) (Credentials, error)
+
+// credentials returns TokenUpdaterConfig.Credentials, or a CredentialsFunc returning the static
+// credentials of the config.
+func (c TokenUpdaterConfig) credentials() CredentialsFunc {
+	if c.Credentials != nil {
+		return func(ctx context.Context) (Credentials, error) {
+			creds, err := c.Credentials(ctx)
+			if err != nil {
+				return Credentials{}, fmt.Errorf("failed to get LWA credentials: %w", err)
+			}
+			return creds, nil
+		}
+	}
+	static := Credentials{ClientID: c.ClientID, ClientSecret: c.ClientSecret, RefreshToken: c.RefreshToken}
+	return func(context.Context) (Credentials, error) {
+		return static, nil
+	}
+}
+
+// hash returns the SHA-256 of the client ID and refresh token, which identify the seller and the
+// application of an access token. The client secret is left out, it may be rotated at any time.
+func (c Credentials) hash() string {
+	sum := sha256.Sum256([]byte(c.ClientID + "\n" + c.RefreshToken))
+	return hex.EncodeToString(sum[:])
+}
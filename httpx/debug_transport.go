@@ -0,0 +1,198 @@
+package httpx
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// maxDebugBodySize limits the size of the bodies logged by DebugTransport.
+const maxDebugBodySize = 64 << 10
+
+const redacted = "[REDACTED]"
+
+// redactedHeaders are the lowercase names of headers carrying credentials.
+var redactedHeaders = map[string]bool{
+	"authorization":        true,
+	"x-amz-access-token":   true,
+	"x-amz-security-token": true,
+	"cookie":               true,
+	"set-cookie":           true,
+}
+
+// redactedQueryParams are the lowercase names of the query parameters of presigned document URLs.
+var redactedQueryParams = map[string]bool{
+	"x-amz-credential":     true,
+	"x-amz-security-token": true,
+	"x-amz-signature":      true,
+}
+
+// redactedFields are the lowercase names of JSON fields with secrets or personally identifiable information.
+var redactedFields = map[string]bool{
+	"access_token":        true,
+	"refresh_token":       true,
+	"client_secret":       true,
+	"restricteddatatoken": true,
+	"buyeremail":          true,
+	"buyername":           true,
+	"buyercounty":         true,
+	"buyertaxinfo":        true,
+	"email":               true,
+	"name":                true,
+	"phone":               true,
+	"addressline1":        true,
+	"addressline2":        true,
+	"addressline3":        true,
+	"city":                true,
+	"county":              true,
+	"district":            true,
+	"postalcode":          true,
+}
+
+// DebugTransport logs requests and responses with the debug level of Log, which defaults to slog.Default(). Access tokens, restricted
+// data tokens, LWA secrets, signatures of presigned URLs and known PII fields are redacted. Only JSON
+// bodies up to 64 KiB are logged, other bodies like report documents are omitted.
+type DebugTransport struct {
+	// Transport sends the requests. Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+	Log       *slog.Logger
+}
+
+func (d *DebugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, reqBody, err := peekRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	log := d.Log
+	if log == nil {
+		log = slog.Default()
+	}
+	log.Debug("SP-API request", "method", req.Method, "url", redactURL(req.URL), "header", redactHeader(req.Header), "body", redactBody(req.Header, reqBody))
+
+	transport := d.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		log.Debug("SP-API request failed", "method", req.Method, "url", redactURL(req.URL), "error", err)
+		return nil, err
+	}
+
+	respBody, err := peekResponseBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("SP-API response", "status", resp.Status, "header", redactHeader(resp.Header), "body", redactBody(resp.Header, decodeContentEncoding(resp.Header, respBody)))
+	return resp, nil
+}
+
+// peekRequestBody returns the request body and a clone of the request with an unread copy of the body.
+func peekRequestBody(req *http.Request) (*http.Request, []byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = io.NopCloser(bytes.NewReader(body))
+	return clone, body, nil
+}
+
+// peekResponseBody reads up to maxDebugBodySize+1 bytes of the response body and puts them back in
+// front of the remaining body, so that larger bodies can be detected without reading them completely.
+func peekResponseBody(resp *http.Response) ([]byte, error) {
+	head, err := io.ReadAll(io.LimitReader(resp.Body, maxDebugBodySize+1))
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), resp.Body), resp.Body}
+	return head, nil
+}
+
+// decodeContentEncoding decompresses the head of a gzip encoded body, since DebugTransport sits
+// below the decompression of Client. A head cut off by peekResponseBody decompresses to a body
+// larger than maxDebugBodySize, or at least to an invalid one, so neither is logged.
+func decodeContentEncoding(header http.Header, head []byte) []byte {
+	if len(head) == 0 || !strings.EqualFold(header.Get("Content-Encoding"), "gzip") {
+		return head
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(head))
+	if err != nil {
+		return head
+	}
+	body, _ := io.ReadAll(io.LimitReader(gz, maxDebugBodySize+1))
+	return body
+}
+
+func redactURL(u *url.URL) string {
+	query := u.Query()
+	for key := range query {
+		if redactedQueryParams[strings.ToLower(key)] {
+			query.Set(key, redacted)
+		}
+	}
+	redactedURL := *u
+	redactedURL.RawQuery = query.Encode()
+	return redactedURL.String()
+}
+
+func redactHeader(header http.Header) string {
+	var buf bytes.Buffer
+	_ = redactHeaderValues(header).Write(&buf)
+	return buf.String()
+}
+
+// redactBody returns the JSON body with redacted fields, or a note why the body is omitted.
+// Bodies without Content-Type are logged if they are valid JSON.
+func redactBody(header http.Header, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if len(body) > maxDebugBodySize {
+		return fmt.Sprintf("<body larger than %d bytes omitted>", maxDebugBodySize)
+	}
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	if mediaType != "" && mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json") {
+		return fmt.Sprintf("<body of type %q omitted>", mediaType)
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return "<invalid JSON body omitted>"
+	}
+	redactedBody, _ := json.Marshal(redactValue(value))
+	return string(redactedBody)
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if redactedFields[strings.ToLower(key)] {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(field)
+			}
+		}
+	case []any:
+		for i, elem := range v {
+			v[i] = redactValue(elem)
+		}
+	}
+	return value
+}
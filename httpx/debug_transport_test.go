@@ -0,0 +1,142 @@
+package httpx
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestDebugTransport(t *testing.T) {
+	var logs bytes.Buffer
+	responseBody := `{"payload":{"AmazonOrderId":"902-3159896-1390916","BuyerInfo":{"BuyerEmail":"buyer@example.com","BuyerName":"Jane Doe"}}}`
+	transport := &DebugTransport{
+		Log: slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			assert.Equal(t, `{"client_secret":"SECRET"}`, string(body), "the request body should be sent unchanged")
+			return &http.Response{
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(responseBody)),
+			}, nil
+		}),
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, "https://sellingpartnerapi-eu.amazon.com/orders/v0/orders?X-Amz-Signature=abc", strings.NewReader(`{"client_secret":"SECRET"}`))
+	req.Header.Set(constants.AccessTokenHeader, "Atza|ACCESS-TOKEN")
+	resp, err := transport.RoundTrip(req)
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, responseBody, string(body), "the response body should be returned unchanged")
+
+	for _, secret := range []string{"Atza|ACCESS-TOKEN", "SECRET", "abc", "buyer@example.com", "Jane Doe"} {
+		assert.NotContains(t, logs.String(), secret)
+	}
+	assert.Contains(t, logs.String(), "902-3159896-1390916")
+}
+
+func TestDebugTransport_OmitsDocuments(t *testing.T) {
+	var logs bytes.Buffer
+	transport := &DebugTransport{
+		Log: slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {"text/tab-separated-values"}},
+				Body:       io.NopCloser(strings.NewReader("order-id\tbuyer-name\n1\tJane Doe\n")),
+			}, nil
+		}),
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://tortuga-prod-eu.s3-eu-west-1.amazonaws.com/report", nil)
+	_, err := transport.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.NotContains(t, logs.String(), "Jane Doe")
+	assert.Contains(t, logs.String(), "text/tab-separated-values")
+}
+
+func TestDebugTransport_LogsCompressedBodies(t *testing.T) {
+	var logs bytes.Buffer
+	responseBody := `{"payload":{"AmazonOrderId":"902-3159896-1390916","BuyerInfo":{"BuyerEmail":"buyer@example.com"}}}`
+	c, err := NewClient(ClientConfig{
+		HTTPClient: &http.Client{Transport: &DebugTransport{
+			Log: slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "gzip", req.Header.Get("Accept-Encoding"))
+				var compressed bytes.Buffer
+				gz := gzip.NewWriter(&compressed)
+				_, _ = gz.Write([]byte(responseBody))
+				_ = gz.Close()
+				return &http.Response{
+					Status:     "200 OK",
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": {"application/json"}, "Content-Encoding": {"gzip"}},
+					Body:       io.NopCloser(&compressed),
+				}, nil
+			}),
+		}},
+		TokenProvider: TokenProviderFunc(func(ctx context.Context) (string, error) {
+			return "ACCESS-TOKEN", nil
+		}),
+	})
+	assert.NoError(t, err)
+	defer c.Close(context.Background())
+
+	req, _ := http.NewRequest(http.MethodGet, "https://sellingpartnerapi-eu.amazon.com/orders/v0/orders/902-3159896-1390916", nil)
+	resp, err := c.Do(req)
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	assert.Equal(t, responseBody, string(body))
+
+	assert.Contains(t, logs.String(), "902-3159896-1390916")
+	assert.NotContains(t, logs.String(), "buyer@example.com")
+	assert.NotContains(t, logs.String(), "invalid JSON")
+}
+
+type recordingLegacyLogger struct {
+	lines []string
+}
+
+func (r *recordingLegacyLogger) Errorf(format string, v ...interface{}) {
+	r.lines = append(r.lines, "ERROR "+fmt.Sprintf(format, v...))
+}
+func (r *recordingLegacyLogger) Warnf(format string, v ...interface{}) {
+	r.lines = append(r.lines, "WARN "+fmt.Sprintf(format, v...))
+}
+func (r *recordingLegacyLogger) Infof(format string, v ...interface{}) {
+	r.lines = append(r.lines, "INFO "+fmt.Sprintf(format, v...))
+}
+func (r *recordingLegacyLogger) Debugf(format string, v ...interface{}) {
+	r.lines = append(r.lines, "DEBUG "+fmt.Sprintf(format, v...))
+}
+
+func TestNewLegacyLogger(t *testing.T) {
+	legacy := &recordingLegacyLogger{}
+	log := NewLegacyLogger(legacy).With("seller", "A1")
+	log.Error("Failed to store access-token", "error", errors.New("disk full"))
+	log.WithGroup("req").Debug("SP-API request", "method", http.MethodGet)
+
+	assert.Equal(t, []string{
+		"ERROR Failed to store access-token seller=A1 error=disk full",
+		"DEBUG SP-API request seller=A1 req.method=GET",
+	}, legacy.lines)
+}
@@ -0,0 +1,14 @@
+package httpx
+
+import "github.com/fond-of-vertigo/amazon-sp-api/apis"
+
+var _ apis.DeprecationReporter = (*Client)(nil)
+
+// ReportDeprecation logs a warning the first time the client calls a deprecated operation, so
+// that migrations to a newer API version aren't missed without flooding the logs.
+func (h *Client) ReportDeprecation(operation, notice string) {
+	if _, reported := h.deprecations.LoadOrStore(operation, struct{}{}); reported {
+		return
+	}
+	h.log.Warn("SP-API operation is deprecated", "operation", operation, "notice", notice)
+}
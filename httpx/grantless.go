@@ -0,0 +1,102 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+// grantlessTokenUpdater fetches access tokens for grantless operations with the LWA
+// client-credentials flow. Tokens are cached per scope until they are about to expire.
+type grantlessTokenUpdater struct {
+	credentials CredentialsFunc
+	httpClient  HTTPRequester
+	tokenURL    string
+	timing      refreshTiming
+
+	mu     sync.Mutex
+	tokens map[constants.Scope]grantlessToken
+}
+
+type grantlessToken struct {
+	accessToken string
+	expiresAt   time.Time
+}
+
+// nowFunc as variable for mocking
+var nowFunc = time.Now
+
+func newGrantlessTokenUpdater(config TokenUpdaterConfig) *grantlessTokenUpdater {
+	return &grantlessTokenUpdater{
+		credentials: config.credentials(),
+		httpClient:  config.HTTPClient,
+		tokenURL:    config.tokenURL(),
+		timing:      config.refreshTiming(),
+		tokens:      map[constants.Scope]grantlessToken{},
+	}
+}
+
+// GetAccessToken returns a valid access token for the given scope, fetching a new one if necessary.
+func (g *grantlessTokenUpdater) GetAccessToken(ctx context.Context, scope constants.Scope) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if token, ok := g.tokens[scope]; ok && nowFunc().Before(token.expiresAt) {
+		return token.accessToken, nil
+	}
+
+	resp, err := g.doTokenRequest(ctx, scope)
+	if err != nil {
+		return "", err
+	}
+	g.tokens[scope] = grantlessToken{
+		accessToken: resp.AccessToken,
+		expiresAt:   nowFunc().Add(g.timing.durationBetweenTokenRequests(resp)),
+	}
+	return resp.AccessToken, nil
+}
+
+func (g *grantlessTokenUpdater) doTokenRequest(ctx context.Context, scope constants.Scope) (*AccessTokenResponse, error) {
+	creds, err := g.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body, _ := json.Marshal(map[string]string{
+		"grant_type":    "client_credentials",
+		"scope":         string(scope),
+		"client_id":     creds.ClientID,
+		"client_secret": creds.ClientSecret,
+	})
+	resp, err := postTokenRequest(ctx, g.httpClient, g.tokenURL, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	tkn := &AccessTokenResponse{}
+	if err = json.Unmarshal(respBody, tkn); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, &LWAError{StatusCode: resp.StatusCode, Description: string(respBody)}
+		}
+		return nil, fmt.Errorf("client credentials response parse failed. Body: %s", string(respBody))
+	}
+	if err = newLWAError(resp.StatusCode, tkn); err != nil {
+		return nil, err
+	}
+	if tkn.AccessToken == "" {
+		return nil, errors.New("client credentials response did not contain access token")
+	}
+	return tkn, nil
+}
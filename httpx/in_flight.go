@@ -0,0 +1,99 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+)
+
+// ErrClientClosed is returned by Do and DoPresigned after Close was called.
+var ErrClientClosed = errors.New("client is closed")
+
+// inFlight counts the requests of a client from sending until their response body is closed, so
+// Close can wait for them. The zero value is ready to use.
+type inFlight struct {
+	mu      sync.Mutex
+	count   int
+	closing bool
+	// drained is closed once the client is closing and no request is in flight anymore.
+	drained chan struct{}
+	// abort cancels the contexts of the requests in flight.
+	abort       context.Context
+	cancelAbort context.CancelFunc
+}
+
+// initLocked creates the channel and context on first use.
+func (f *inFlight) initLocked() {
+	if f.drained == nil {
+		f.drained = make(chan struct{})
+		f.abort, f.cancelAbort = context.WithCancel(context.Background())
+	}
+}
+
+// start counts a new request and returns the context cancelled by cancel, or false if the client is closing.
+func (f *inFlight) start() (context.Context, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.initLocked()
+	if f.closing {
+		return nil, false
+	}
+	f.count++
+	return f.abort, true
+}
+
+func (f *inFlight) done() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.count--
+	if f.closing && f.count == 0 {
+		close(f.drained)
+	}
+}
+
+// close rejects new requests and returns a channel which is closed once the requests in flight finished.
+func (f *inFlight) close() <-chan struct{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.initLocked()
+	if !f.closing {
+		f.closing = true
+		if f.count == 0 {
+			close(f.drained)
+		}
+	}
+	return f.drained
+}
+
+// cancel cancels the requests in flight.
+func (f *inFlight) cancel() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.initLocked()
+	f.cancelAbort()
+}
+
+// track sends the request with do and counts it as in flight until the response body is closed.
+// The request is cancelled if Close gives up waiting for it.
+func (h *Client) track(req *http.Request, do func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	abort, ok := h.inFlight.start()
+	if !ok {
+		return nil, ErrClientClosed
+	}
+	ctx, cancel := context.WithCancel(req.Context())
+	stopAbort := context.AfterFunc(abort, cancel)
+	finish := func() {
+		stopAbort()
+		cancel()
+		h.inFlight.done()
+	}
+
+	resp, err := do(req.WithContext(ctx))
+	if err != nil {
+		finish()
+		return nil, err
+	}
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: finish}
+	return resp, nil
+}
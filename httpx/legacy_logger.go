@@ -0,0 +1,89 @@
+package httpx
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// LegacyLogger is the printf-style logger the SDK used before log/slog, e.g. a logger.Logger of
+// github.com/fond-of-vertigo/logger.
+type LegacyLogger interface {
+	Errorf(format string, v ...interface{})
+	Warnf(format string, v ...interface{})
+	Infof(format string, v ...interface{})
+	Debugf(format string, v ...interface{})
+}
+
+// NewLegacyLogger returns an slog.Logger writing to a LegacyLogger, so existing loggers can still
+// be passed as Config.Log. Attributes are appended to the message as key=value pairs. Filtering by
+// level is left to the LegacyLogger.
+func NewLegacyLogger(l LegacyLogger) *slog.Logger {
+	return slog.New(&legacyHandler{log: l})
+}
+
+type legacyHandler struct {
+	log    LegacyLogger
+	attrs  string
+	prefix string
+}
+
+func (h *legacyHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (h *legacyHandler) Handle(_ context.Context, record slog.Record) error {
+	var msg strings.Builder
+	msg.WriteString(record.Message)
+	msg.WriteString(h.attrs)
+	record.Attrs(func(attr slog.Attr) bool {
+		writeAttr(&msg, h.prefix, attr)
+		return true
+	})
+
+	switch {
+	case record.Level >= slog.LevelError:
+		h.log.Errorf("%s", msg.String())
+	case record.Level >= slog.LevelWarn:
+		h.log.Warnf("%s", msg.String())
+	case record.Level >= slog.LevelInfo:
+		h.log.Infof("%s", msg.String())
+	default:
+		h.log.Debugf("%s", msg.String())
+	}
+	return nil
+}
+
+func (h *legacyHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var msg strings.Builder
+	msg.WriteString(h.attrs)
+	for _, attr := range attrs {
+		writeAttr(&msg, h.prefix, attr)
+	}
+	return &legacyHandler{log: h.log, attrs: msg.String(), prefix: h.prefix}
+}
+
+func (h *legacyHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &legacyHandler{log: h.log, attrs: h.attrs, prefix: h.prefix + name + "."}
+}
+
+func writeAttr(msg *strings.Builder, prefix string, attr slog.Attr) {
+	value := attr.Value.Resolve()
+	if value.Kind() == slog.KindGroup {
+		if attr.Key != "" {
+			prefix += attr.Key + "."
+		}
+		for _, groupAttr := range value.Group() {
+			writeAttr(msg, prefix, groupAttr)
+		}
+		return
+	}
+	if attr.Equal(slog.Attr{}) {
+		return
+	}
+	fmt.Fprintf(msg, " %s%s=%v", prefix, attr.Key, value.Any())
+}
@@ -0,0 +1,61 @@
+package httpx
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ErrInvalidGrant matches (with errors.Is) LWA errors caused by a revoked or invalid refresh token.
+// The seller has to authorize the application again.
+var ErrInvalidGrant = errors.New("invalid_grant")
+
+// LWAError is an error response of the LWA token endpoint.
+type LWAError struct {
+	StatusCode  int
+	Code        string
+	Description string
+}
+
+func (e *LWAError) Error() string {
+	return fmt.Sprintf("LWA token request failed with statuscode=%d: %s: %s", e.StatusCode, e.Code, e.Description)
+}
+
+func (e *LWAError) Is(target error) bool {
+	return target == ErrInvalidGrant && e.Code == "invalid_grant"
+}
+
+// IsTerminal reports whether retrying the token request cannot succeed without new credentials.
+func (e *LWAError) IsTerminal() bool {
+	switch e.Code {
+	case "invalid_grant", "invalid_client", "unauthorized_client", "invalid_request", "unsupported_grant_type", "invalid_scope":
+		return true
+	}
+	return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnauthorized
+}
+
+// newLWAError returns an LWAError if the token endpoint responded with an error, otherwise nil.
+func newLWAError(statusCode int, resp *AccessTokenResponse) error {
+	if statusCode == http.StatusOK && resp.Error == "" {
+		return nil
+	}
+	return &LWAError{StatusCode: statusCode, Code: resp.Error, Description: resp.ErrorDescription}
+}
+
+// isTransientTokenError reports whether a failed token request should be retried:
+// network errors, throttling and server errors of the token endpoint.
+func isTransientTokenError(err error) bool {
+	var lwaErr *LWAError
+	if errors.As(err, &lwaErr) {
+		return lwaErr.StatusCode == http.StatusTooManyRequests || lwaErr.StatusCode >= http.StatusInternalServerError
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// isTerminalTokenError reports whether the token can't be refreshed anymore without new credentials.
+func isTerminalTokenError(err error) bool {
+	var lwaErr *LWAError
+	return errors.As(err, &lwaErr) && lwaErr.IsTerminal()
+}
@@ -0,0 +1,50 @@
+package httpx
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+)
+
+// MetricsRecorder receives metrics of the requests sent by the Client and of the token refreshes,
+// e.g. to alert on error or throttle rates. See PrometheusMetrics for a reference implementation.
+// Implementations must be safe for concurrent use.
+type MetricsRecorder interface {
+	// ObserveRequest is called after each request with the status code of the response, or 0 if
+	// the request failed without response. operation is e.g. "orders.GetOrders", or empty for
+	// requests which weren't created by apis.Call like document downloads.
+	ObserveRequest(operation string, statusCode int, duration time.Duration)
+	// ObserveThrottle is called for each request answered with 429 Too Many Requests.
+	ObserveThrottle(operation string)
+	// ObserveTokenRefresh is called after each request of a new access token with its error or nil.
+	ObserveTokenRefresh(err error)
+}
+
+// doObserved sends the request and records it in the RateLimitStats, the AuditSink and the MetricsRecorder of the client.
+func (h *Client) doObserved(req *http.Request) (*http.Response, error) {
+	operation := apis.OperationFromContext(req.Context())
+	start := nowFunc()
+	resp, err := h.httpClient.Do(req)
+	statusCode := 0
+	if err == nil {
+		statusCode = resp.StatusCode
+	}
+	throttled := statusCode == http.StatusTooManyRequests
+	h.audit(req, operation, start, resp, err)
+
+	h.rateLimitStats.update(operation, func(stats *RateLimitStats) {
+		stats.Requests++
+		if throttled {
+			stats.Throttles++
+			stats.LastThrottledAt = nowFunc()
+		}
+	})
+	if h.metrics != nil {
+		h.metrics.ObserveRequest(operation, statusCode, nowFunc().Sub(start))
+		if throttled {
+			h.metrics.ObserveThrottle(operation)
+		}
+	}
+	return resp, err
+}
@@ -0,0 +1,154 @@
+package httpx
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultDurationBuckets are the upper bounds in seconds of the request duration histogram.
+var DefaultDurationBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// PrometheusMetrics is a MetricsRecorder which serves the metrics in the Prometheus text format:
+//
+//	spapi_requests_total{operation, status_class}
+//	spapi_request_duration_seconds{operation} (histogram)
+//	spapi_throttles_total{operation}
+//	spapi_token_refreshes_total{result}
+//
+// Register it as handler of the metrics endpoint scraped by Prometheus, or next to the handler of
+// another registry. Share one PrometheusMetrics between the clients of all sellers.
+type PrometheusMetrics struct {
+	mu             sync.Mutex
+	buckets        []float64
+	requests       map[[2]string]uint64
+	durations      map[string]*histogram
+	throttles      map[string]uint64
+	tokenRefreshes map[string]uint64
+}
+
+type histogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// NewPrometheusMetrics returns PrometheusMetrics with the DefaultDurationBuckets.
+func NewPrometheusMetrics() *PrometheusMetrics {
+	return &PrometheusMetrics{
+		buckets:        DefaultDurationBuckets,
+		requests:       map[[2]string]uint64{},
+		durations:      map[string]*histogram{},
+		throttles:      map[string]uint64{},
+		tokenRefreshes: map[string]uint64{},
+	}
+}
+
+func (p *PrometheusMetrics) ObserveRequest(operation string, statusCode int, duration time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests[[2]string{operation, statusClass(statusCode)}]++
+
+	h, ok := p.durations[operation]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(p.buckets))}
+		p.durations[operation] = h
+	}
+	seconds := duration.Seconds()
+	for i, bound := range p.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += seconds
+}
+
+func (p *PrometheusMetrics) ObserveThrottle(operation string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.throttles[operation]++
+}
+
+func (p *PrometheusMetrics) ObserveTokenRefresh(err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.tokenRefreshes[result]++
+}
+
+// statusClass returns e.g. "2xx" for 200, or "error" for requests without response.
+func statusClass(statusCode int) string {
+	if statusCode == 0 {
+		return "error"
+	}
+	return fmt.Sprintf("%dxx", statusCode/100)
+}
+
+func (p *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = p.WriteText(w)
+}
+
+// WriteText writes the metrics in the Prometheus text format.
+func (p *PrometheusMetrics) WriteText(w io.Writer) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var b strings.Builder
+	b.WriteString("# HELP spapi_requests_total SP-API requests by operation and status class.\n")
+	b.WriteString("# TYPE spapi_requests_total counter\n")
+	requestKeys := make([][2]string, 0, len(p.requests))
+	for key := range p.requests {
+		requestKeys = append(requestKeys, key)
+	}
+	sort.Slice(requestKeys, func(i, j int) bool {
+		return requestKeys[i][0] < requestKeys[j][0] || requestKeys[i][0] == requestKeys[j][0] && requestKeys[i][1] < requestKeys[j][1]
+	})
+	for _, key := range requestKeys {
+		fmt.Fprintf(&b, "spapi_requests_total{operation=%q,status_class=%q} %d\n", key[0], key[1], p.requests[key])
+	}
+
+	b.WriteString("# HELP spapi_request_duration_seconds Duration of SP-API requests by operation.\n")
+	b.WriteString("# TYPE spapi_request_duration_seconds histogram\n")
+	for _, operation := range sortedKeys(p.durations) {
+		h := p.durations[operation]
+		for i, bound := range p.buckets {
+			fmt.Fprintf(&b, "spapi_request_duration_seconds_bucket{operation=%q,le=\"%g\"} %d\n", operation, bound, h.counts[i])
+		}
+		fmt.Fprintf(&b, "spapi_request_duration_seconds_bucket{operation=%q,le=\"+Inf\"} %d\n", operation, h.count)
+		fmt.Fprintf(&b, "spapi_request_duration_seconds_sum{operation=%q} %g\n", operation, h.sum)
+		fmt.Fprintf(&b, "spapi_request_duration_seconds_count{operation=%q} %d\n", operation, h.count)
+	}
+
+	b.WriteString("# HELP spapi_throttles_total SP-API requests answered with 429 Too Many Requests.\n")
+	b.WriteString("# TYPE spapi_throttles_total counter\n")
+	for _, operation := range sortedKeys(p.throttles) {
+		fmt.Fprintf(&b, "spapi_throttles_total{operation=%q} %d\n", operation, p.throttles[operation])
+	}
+
+	b.WriteString("# HELP spapi_token_refreshes_total LWA access token requests by result.\n")
+	b.WriteString("# TYPE spapi_token_refreshes_total counter\n")
+	for _, result := range sortedKeys(p.tokenRefreshes) {
+		fmt.Fprintf(&b, "spapi_token_refreshes_total{result=%q} %d\n", result, p.tokenRefreshes[result])
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,50 @@
+package httpx
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_DoRecordsMetrics(t *testing.T) {
+	metrics := NewPrometheusMetrics()
+	statusCodes := []int{http.StatusOK, http.StatusTooManyRequests}
+	h := &Client{
+		tokenProvider: &mockTokenUpdater{ReturnAccessToken: "ACCESS-TOKEN"},
+		metrics:       metrics,
+		httpClient: requesterFunc(func(req *http.Request) (*http.Response, error) {
+			statusCode := statusCodes[0]
+			statusCodes = statusCodes[1:]
+			return &http.Response{StatusCode: statusCode, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+		}),
+	}
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest(http.MethodGet, "example.com", nil)
+		if _, err := h.Do(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	metrics.ObserveTokenRefresh(errors.New("invalid_grant"))
+	metrics.ObserveRequest("orders.GetOrders", 200, 300*time.Millisecond)
+
+	var out strings.Builder
+	if err := metrics.WriteText(&out); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`spapi_requests_total{operation="",status_class="2xx"} 1`,
+		`spapi_requests_total{operation="",status_class="4xx"} 1`,
+		`spapi_throttles_total{operation=""} 1`,
+		`spapi_token_refreshes_total{result="error"} 1`,
+		`spapi_request_duration_seconds_bucket{operation="orders.GetOrders",le="0.25"} 0`,
+		`spapi_request_duration_seconds_bucket{operation="orders.GetOrders",le="0.5"} 1`,
+		`spapi_request_duration_seconds_count{operation="orders.GetOrders"} 1`,
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("metrics don't contain %q:\n%s", want, out.String())
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package httpx
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimitStats shows how close the calls of an operation run to its quota.
+type RateLimitStats struct {
+	// Limit is the calls per second last reported by SP-API in the x-amzn-RateLimit-Limit header,
+	// or 0 if no response contained it yet.
+	Limit float64
+	// LimitObservedAt is the time the Limit was reported.
+	LimitObservedAt time.Time
+	// Requests is the number of requests sent, including retries.
+	Requests uint64
+	// Throttles is the number of requests answered with 429 Too Many Requests.
+	Throttles uint64
+	// LastThrottledAt is the time of the last 429 response.
+	LastThrottledAt time.Time
+}
+
+// rateLimitStats collects the RateLimitStats per operation.
+type rateLimitStats struct {
+	mu    sync.Mutex
+	stats map[string]*RateLimitStats
+}
+
+func (r *rateLimitStats) update(operation string, update func(stats *RateLimitStats)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stats == nil {
+		r.stats = map[string]*RateLimitStats{}
+	}
+	stats, ok := r.stats[operation]
+	if !ok {
+		stats = &RateLimitStats{}
+		r.stats[operation] = stats
+	}
+	update(stats)
+}
+
+func (r *rateLimitStats) snapshot() map[string]RateLimitStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	snapshot := make(map[string]RateLimitStats, len(r.stats))
+	for operation, stats := range r.stats {
+		snapshot[operation] = *stats
+	}
+	return snapshot
+}
+
+// RateLimitStats returns the observed rate limits and throttles per operation since the client was
+// created, e.g. "orders.GetOrders". Requests which weren't created by apis.Call are listed under "".
+func (h *Client) RateLimitStats() map[string]RateLimitStats {
+	return h.rateLimitStats.snapshot()
+}
@@ -0,0 +1,133 @@
+package httpx
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the calls of each SP-API operation before they are sent. Implement it to
+// enforce the quotas across all replicas of a deployment, e.g. with a limiter backed by Redis or
+// DynamoDB. SP-API rate limits apply per selling partner and application, so key them by seller.
+type RateLimiter interface {
+	// Acquire blocks until the operation may be called according to limit or ctx is done.
+	Acquire(ctx context.Context, operation string, limit RateLimit) error
+}
+
+// RateLimit is the usage plan of an operation: a call is allowed every Interval, up to Burst calls at once.
+type RateLimit struct {
+	Interval time.Duration
+	Burst    int
+}
+
+// RateLimitObserver can be implemented by a RateLimiter to adjust the limits to the calls per second
+// reported by SP-API in the x-amzn-RateLimit-Limit header.
+type RateLimitObserver interface {
+	Observe(operation string, callsPerSecond float64)
+}
+
+// LocalRateLimiter limits the calls of each SP-API operation with a token bucket per process. It
+// is safe for concurrent use, so goroutines sharing a client wait for their turn instead of
+// running into 429 errors. Use one LocalRateLimiter per seller.
+type LocalRateLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+	// observed is the interval of the rate limit reported by SP-API, which replaces the static interval.
+	observed time.Duration
+}
+
+func NewRateLimiter() *LocalRateLimiter {
+	return &LocalRateLimiter{buckets: map[string]*tokenBucket{}}
+}
+
+// Acquire blocks until the operation may be called or ctx is done. A token is added to the bucket
+// of the operation every limit.Interval, up to limit.Burst tokens.
+func (l *LocalRateLimiter) Acquire(ctx context.Context, operation string, limit RateLimit) error {
+	wait := l.reserve(operation, limit.Interval, limit.Burst)
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.cancel(operation)
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token from the bucket of the operation and returns how long to wait until it is available.
+func (l *LocalRateLimiter) reserve(operation string, interval time.Duration, burst int) time.Duration {
+	if interval <= 0 {
+		return 0
+	}
+	burst = max(burst, 1)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := nowFunc()
+	bucket := l.bucket(operation, burst, now)
+	if bucket.observed > 0 {
+		interval = bucket.observed
+	}
+	refilled := float64(now.Sub(bucket.last)) / float64(interval)
+	bucket.tokens = min(bucket.tokens+refilled, float64(burst))
+	bucket.last = now
+
+	bucket.tokens--
+	if bucket.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-bucket.tokens * float64(interval))
+}
+
+func (l *LocalRateLimiter) bucket(operation string, burst int, now time.Time) *tokenBucket {
+	bucket, ok := l.buckets[operation]
+	if !ok {
+		bucket = &tokenBucket{tokens: float64(burst), last: now}
+		l.buckets[operation] = bucket
+	}
+	return bucket
+}
+
+// Observe adjusts the rate limit of the operation to the calls per second reported by SP-API in the
+// x-amzn-RateLimit-Limit header, since the actual limits vary by selling partner and application.
+func (l *LocalRateLimiter) Observe(operation string, callsPerSecond float64) {
+	if callsPerSecond <= 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.bucket(operation, 1, nowFunc()).observed = time.Duration(float64(time.Second) / callsPerSecond)
+}
+
+// ObservedLimits returns the calls per second of each operation as last reported by SP-API.
+func (l *LocalRateLimiter) ObservedLimits() map[string]float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limits := map[string]float64{}
+	for operation, bucket := range l.buckets {
+		if bucket.observed > 0 {
+			limits[operation] = float64(time.Second) / float64(bucket.observed)
+		}
+	}
+	return limits
+}
+
+// cancel returns the token of a call which wasn't sent.
+func (l *LocalRateLimiter) cancel(operation string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if bucket, ok := l.buckets[operation]; ok {
+		bucket.tokens++
+	}
+}
@@ -0,0 +1,53 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_reserve(t *testing.T) {
+	defer func(f func() time.Time) { nowFunc = f }(nowFunc)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	nowFunc = func() time.Time { return now }
+
+	l := NewRateLimiter()
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, time.Duration(0), l.reserve("orders.GetOrders", time.Minute, 3), "call %d is within the burst", i)
+	}
+	assert.Equal(t, time.Minute, l.reserve("orders.GetOrders", time.Minute, 3))
+	assert.Equal(t, 2*time.Minute, l.reserve("orders.GetOrders", time.Minute, 3))
+	assert.Equal(t, time.Duration(0), l.reserve("orders.GetOrder", time.Minute, 3), "operations are limited separately")
+
+	now = now.Add(5 * time.Minute)
+	assert.Equal(t, time.Duration(0), l.reserve("orders.GetOrders", time.Minute, 3))
+}
+
+func TestRateLimiter_AcquireCancelled(t *testing.T) {
+	l := NewRateLimiter()
+	assert.NoError(t, l.Acquire(context.Background(), "reports.CreateReport", RateLimit{Interval: time.Hour, Burst: 1}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := l.Acquire(ctx, "reports.CreateReport", RateLimit{Interval: time.Hour, Burst: 1})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Acquire() error = %v, want context.DeadlineExceeded", err)
+	}
+	assert.Greater(t, l.reserve("reports.CreateReport", time.Hour, 1), 59*time.Minute, "the cancelled call should return its token")
+}
+
+func TestRateLimiter_Observe(t *testing.T) {
+	defer func(f func() time.Time) { nowFunc = f }(nowFunc)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	nowFunc = func() time.Time { return now }
+
+	l := NewRateLimiter()
+	assert.Equal(t, time.Duration(0), l.reserve("orders.GetOrders", time.Minute, 1))
+	l.Observe("orders.GetOrders", 0.5)
+
+	assert.Equal(t, 2*time.Second, l.reserve("orders.GetOrders", time.Minute, 1), "the observed limit replaces the static one")
+	assert.Equal(t, map[string]float64{"orders.GetOrders": 0.5}, l.ObservedLimits())
+}
@@ -0,0 +1,253 @@
+package httpx
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// RecorderMode selects whether a RecordingTransport records or replays interactions.
+type RecorderMode int
+
+const (
+	// ModeReplay answers requests from the fixture file without sending them.
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends requests with the Transport and writes the interactions to the fixture file.
+	ModeRecord
+)
+
+// Interaction is a request and its response as stored in the fixture file of a RecordingTransport.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request with redacted credentials.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+	// BodyBase64 holds bodies which are not valid UTF-8, e.g. compressed documents.
+	BodyBase64 []byte `json:"bodyBase64,omitempty"`
+}
+
+// RecordedResponse is a decompressed response with redacted credentials and PII.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	// BodyBase64 holds bodies which are not valid UTF-8, e.g. compressed documents.
+	BodyBase64 []byte `json:"bodyBase64,omitempty"`
+}
+
+// RecordingTransport records SP-API interactions to a JSON fixture file and replays them, so tests
+// run against real responses without network access, credentials or sandbox availability.
+//
+// Access tokens, restricted data tokens, LWA secrets, signatures of presigned URLs and the PII
+// fields redacted by DebugTransport are replaced in JSON and form bodies before writing the file.
+// Other bodies like report documents are stored as they are. In ModeReplay a request is answered
+// with the first unused interaction of the same method and redacted URL.
+type RecordingTransport struct {
+	// Transport sends the requests in ModeRecord. Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	path         string
+	mode         RecorderMode
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewRecordingTransport returns a RecordingTransport for the fixture file at path. In ModeReplay
+// the file is read immediately, in ModeRecord it is replaced by the recorded interactions.
+func NewRecordingTransport(path string, mode RecorderMode) (*RecordingTransport, error) {
+	t := &RecordingTransport{path: path, mode: mode}
+	if mode == ModeRecord {
+		return t, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading fixture failed: %w", err)
+	}
+	if err = json.Unmarshal(data, &t.interactions); err != nil {
+		return nil, fmt.Errorf("parsing fixture %s failed: %w", path, err)
+	}
+	t.used = make([]bool, len(t.interactions))
+	return t, nil
+}
+
+// Interactions returns the interactions recorded or loaded so far.
+func (t *RecordingTransport) Interactions() []Interaction {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]Interaction(nil), t.interactions...)
+}
+
+func (t *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.mode == ModeReplay {
+		return t.replay(req)
+	}
+	return t.record(req)
+}
+
+func (t *RecordingTransport) replay(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+	requestURL := redactURL(req.URL)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, interaction := range t.interactions {
+		if t.used[i] || interaction.Request.Method != req.Method || interaction.Request.URL != requestURL {
+			continue
+		}
+		t.used[i] = true
+		body := []byte(interaction.Response.Body)
+		if interaction.Response.BodyBase64 != nil {
+			body = interaction.Response.BodyBase64
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("no recorded interaction left for %s %s in %s", req.Method, requestURL, t.path)
+}
+
+func (t *RecordingTransport) record(req *http.Request) (*http.Response, error) {
+	req, reqBody, err := peekRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := readDecompressedBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	resp.ContentLength = int64(len(respBody))
+
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    redactURL(req.URL),
+			Header: redactHeaderValues(req.Header),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     redactHeaderValues(resp.Header),
+		},
+	}
+	interaction.Request.Body, interaction.Request.BodyBase64 = encodeRecordedBody(req.Header, reqBody)
+	interaction.Response.Body, interaction.Response.BodyBase64 = encodeRecordedBody(resp.Header, respBody)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.interactions = append(t.interactions, interaction)
+	if err = t.save(); err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+	return resp, nil
+}
+
+// save writes all interactions to the fixture file. t.mu must be held.
+func (t *RecordingTransport) save() error {
+	data, err := json.MarshalIndent(t.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(t.path, data, 0o644); err != nil {
+		return fmt.Errorf("writing fixture failed: %w", err)
+	}
+	return nil
+}
+
+// readDecompressedBody reads the response body and removes a gzip Content-Encoding, so the fixture
+// holds the plain body and can be redacted.
+func readDecompressedBody(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+	var body io.Reader = resp.Body
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		gz, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		body = gz
+		resp.Header.Del("Content-Encoding")
+		resp.Header.Del("Content-Length")
+		resp.Uncompressed = true
+	}
+	return io.ReadAll(body)
+}
+
+func redactHeaderValues(header http.Header) http.Header {
+	clone := header.Clone()
+	for key := range clone {
+		if redactedHeaders[strings.ToLower(key)] {
+			clone.Set(key, redacted)
+		}
+	}
+	return clone
+}
+
+// encodeRecordedBody redacts JSON and form bodies and returns bodies which are not valid UTF-8
+// as base64.
+func encodeRecordedBody(header http.Header, body []byte) (string, []byte) {
+	if len(body) == 0 {
+		return "", nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			for key := range form {
+				if redactedFields[strings.ToLower(key)] {
+					form.Set(key, redacted)
+				}
+			}
+			return form.Encode(), nil
+		}
+	case mediaType == "" || mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		var value any
+		if err := json.Unmarshal(body, &value); err == nil {
+			redactedBody, _ := json.Marshal(redactValue(value))
+			return string(redactedBody), nil
+		}
+	}
+
+	if utf8.Valid(body) {
+		return string(body), nil
+	}
+	return "", body
+}
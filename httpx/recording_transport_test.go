@@ -0,0 +1,88 @@
+package httpx
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingTransport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "orders.json")
+	responseBody := `{"payload":{"AmazonOrderId":"902-3159896-1390916","BuyerInfo":{"BuyerEmail":"buyer@example.com"}}}`
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	_, _ = gz.Write([]byte(responseBody))
+	_ = gz.Close()
+
+	recorder, err := NewRecordingTransport(path, ModeRecord)
+	require.NoError(t, err)
+	recorder.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}, "Content-Encoding": {"gzip"}},
+			Body:       io.NopCloser(bytes.NewReader(compressed.Bytes())),
+		}, nil
+	})
+	req, _ := http.NewRequest(http.MethodGet, "https://sellingpartnerapi-eu.amazon.com/orders/v0/orders/902-3159896-1390916?X-Amz-Signature=SECRET-SIGNATURE", nil)
+	req.Header.Set(constants.AccessTokenHeader, "Atza|ACCESS-TOKEN")
+	resp, err := recorder.RoundTrip(req)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, responseBody, string(body), "the live response should be returned decompressed and unredacted")
+
+	fixture, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(fixture), "ACCESS-TOKEN")
+	assert.NotContains(t, string(fixture), "SECRET-SIGNATURE")
+	assert.NotContains(t, string(fixture), "buyer@example.com")
+
+	replayer, err := NewRecordingTransport(path, ModeReplay)
+	require.NoError(t, err)
+	replayer.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatal("a replayed request should not be sent")
+		return nil, nil
+	})
+	req, _ = http.NewRequest(http.MethodGet, "https://sellingpartnerapi-eu.amazon.com/orders/v0/orders/902-3159896-1390916?X-Amz-Signature=xyz", nil)
+	resp, err = replayer.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("Content-Encoding"))
+	body, _ = io.ReadAll(resp.Body)
+	assert.True(t, strings.Contains(string(body), `"BuyerEmail":"[REDACTED]"`), "got %s", body)
+
+	_, err = replayer.RoundTrip(req)
+	assert.ErrorContains(t, err, "no recorded interaction left")
+}
+
+func TestRecordingTransport_RedactsFormBody(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	recorder, err := NewRecordingTransport(path, ModeRecord)
+	require.NoError(t, err)
+	recorder.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		assert.Contains(t, string(body), "refresh_token=Atzr%7CREFRESH", "the request body should be sent unchanged")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"access_token":"Atza|ACCESS-TOKEN","expires_in":3600}`)),
+		}, nil
+	})
+	req, _ := http.NewRequest(http.MethodPost, DefaultTokenURL, strings.NewReader("grant_type=refresh_token&refresh_token=Atzr%7CREFRESH&client_secret=SECRET"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_, err = recorder.RoundTrip(req)
+	require.NoError(t, err)
+
+	interactions := recorder.Interactions()
+	require.Len(t, interactions, 1)
+	assert.Equal(t, "client_secret=%5BREDACTED%5D&grant_type=refresh_token&refresh_token=%5BREDACTED%5D", interactions[0].Request.Body)
+	assert.Equal(t, `{"access_token":"[REDACTED]","expires_in":3600}`, interactions[0].Response.Body)
+}
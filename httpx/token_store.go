@@ -0,0 +1,109 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// StoredToken is an LWA access token with its expiry, as persisted by a TokenStore.
+type StoredToken struct {
+	AccessToken string    `json:"accessToken"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+	// CredentialsHash identifies the client ID and refresh token the access token was fetched with.
+	// Stored tokens of other credentials are ignored, e.g. after switching the seller of a token file.
+	CredentialsHash string `json:"credentialsHash,omitempty"`
+}
+
+// TokenStore persists the access token, so that short-lived processes can reuse a still valid
+// token instead of requesting a new one on every start.
+type TokenStore interface {
+	// Load returns the stored token or nil if no token was stored yet.
+	Load() (*StoredToken, error)
+	Save(token StoredToken) error
+}
+
+// TokenStoreLocker can be implemented by a TokenStore shared between processes, e.g. backed by Redis,
+// so that replicas using the same refresh token share one access token. Before a new access token is
+// requested, the token store is locked and checked for a token another replica fetched in the meantime.
+type TokenStoreLocker interface {
+	TokenStore
+	// Lock blocks until the lock is acquired or ctx is done. The returned unlock function releases it.
+	Lock(ctx context.Context) (unlock func(), err error)
+}
+
+// MemoryTokenStore keeps the token in memory. It is used if no TokenStore is configured.
+type MemoryTokenStore struct {
+	mu    sync.Mutex
+	token *StoredToken
+}
+
+func (s *MemoryTokenStore) Load() (*StoredToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == nil {
+		return nil, nil
+	}
+	token := *s.token
+	return &token, nil
+}
+
+func (s *MemoryTokenStore) Save(token StoredToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = &token
+	return nil
+}
+
+// FileTokenStore stores the token as JSON file at Path. The file is readable by the owner only.
+type FileTokenStore struct {
+	Path string
+}
+
+// NewFileTokenStore returns a FileTokenStore storing the token at path.
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{Path: path}
+}
+
+func (s *FileTokenStore) Load() (*StoredToken, error) {
+	content, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	token := &StoredToken{}
+	if err = json.Unmarshal(content, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// Save replaces the file atomically, so that concurrent processes never read a partial token.
+func (s *FileTokenStore) Save(token StoredToken) error {
+	content, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), ".token-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.Path)
+}
@@ -0,0 +1,143 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileTokenStore(t *testing.T) {
+	store := NewFileTokenStore(filepath.Join(t.TempDir(), "token.json"))
+
+	token, err := store.Load()
+	assert.NoError(t, err)
+	assert.Nil(t, token)
+
+	expiresAt := time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC)
+	assert.NoError(t, store.Save(StoredToken{AccessToken: "ACCESS-TOKEN", ExpiresAt: expiresAt}))
+
+	token, err = store.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, &StoredToken{AccessToken: "ACCESS-TOKEN", ExpiresAt: expiresAt}, token)
+}
+
+func TestPeriodicTokenUpdater_ReusesStoredToken(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	nowFunc = func() time.Time { return now }
+	defer func() { nowFunc = time.Now }()
+
+	creds := Credentials{ClientID: "clientID", ClientSecret: "clientSecret", RefreshToken: "refreshToken"}
+	store := &MemoryTokenStore{}
+	assert.NoError(t, store.Save(StoredToken{AccessToken: "STORED-TOKEN", ExpiresAt: now.Add(time.Hour), CredentialsHash: creds.hash()}))
+	httpClient := &mockHTTPClient{TB: t}
+	tu := newTokenUpdater(TokenUpdaterConfig{
+		RefreshToken: creds.RefreshToken,
+		ClientID:     creds.ClientID,
+		ClientSecret: creds.ClientSecret,
+		HTTPClient:   httpClient,
+		Logger:       slog.New(slog.NewTextHandler(io.Discard, nil)),
+		TokenStore:   store,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped, err := tu.RunInBackground(ctx)
+	cancel()
+	<-stopped
+
+	assert.NoError(t, err)
+	assert.Equal(t, "STORED-TOKEN", tu.GetAccessToken())
+	assert.Equal(t, 0, httpClient.PostCallCount, "a valid stored token should not be refreshed")
+}
+
+func TestPeriodicTokenUpdater_IgnoresStoredTokenOfOtherCredentials(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	nowFunc = func() time.Time { return now }
+	defer func() { nowFunc = time.Now }()
+
+	otherSeller := Credentials{ClientID: "clientID", RefreshToken: "otherRefreshToken"}
+	store := &MemoryTokenStore{}
+	assert.NoError(t, store.Save(StoredToken{AccessToken: "STORED-TOKEN", ExpiresAt: now.Add(time.Hour), CredentialsHash: otherSeller.hash()}))
+	respBody, _ := json.Marshal(AccessTokenResponse{AccessToken: "ACCESS-TOKEN", ExpiresIn: 3600})
+	tu := newTokenUpdater(TokenUpdaterConfig{
+		RefreshToken: "refreshToken",
+		ClientID:     "clientID",
+		ClientSecret: "clientSecret",
+		HTTPClient: &mockHTTPClient{
+			TB:               t,
+			URL:              DefaultTokenURL,
+			BodyType:         "application/json",
+			Body:             makeRequestBody("refreshToken", "clientID", "clientSecret"),
+			MockResponseBody: respBody,
+		},
+		Logger:     slog.New(slog.NewTextHandler(io.Discard, nil)),
+		TokenStore: store,
+	})
+
+	_, err := tu.doInitialFetch(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ACCESS-TOKEN", tu.GetAccessToken(), "the token of another seller must not be reused")
+	stored, err := store.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "ACCESS-TOKEN", stored.AccessToken)
+	assert.Equal(t, Credentials{ClientID: "clientID", RefreshToken: "refreshToken"}.hash(), stored.CredentialsHash)
+}
+
+type lockingTokenStore struct {
+	MemoryTokenStore
+	lock  sync.Mutex
+	locks int
+}
+
+func (s *lockingTokenStore) Lock(_ context.Context) (func(), error) {
+	s.lock.Lock()
+	s.locks++
+	return s.lock.Unlock, nil
+}
+
+func TestPeriodicTokenUpdater_SharesTokenOfStore(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	nowFunc = func() time.Time { return now }
+	defer func() { nowFunc = time.Now }()
+
+	store := &lockingTokenStore{}
+	tokenRequests := 0
+	newReplica := func() *PeriodicTokenUpdater {
+		return newTokenUpdater(TokenUpdaterConfig{
+			HTTPClient: requesterFunc(func(req *http.Request) (*http.Response, error) {
+				tokenRequests++
+				respBody, _ := json.Marshal(AccessTokenResponse{AccessToken: fmt.Sprintf("TOKEN-%d", tokenRequests), ExpiresIn: 3600})
+				resp := httptest.NewRecorder()
+				_, _ = resp.Write(respBody)
+				return resp.Result(), nil
+			}),
+			Logger:     slog.New(slog.NewTextHandler(io.Discard, nil)),
+			TokenStore: store,
+		})
+	}
+	first, second := newReplica(), newReplica()
+
+	_, err := first.refreshToken(context.Background(), "")
+	assert.NoError(t, err)
+	_, err = second.refreshToken(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Equal(t, "TOKEN-1", second.GetAccessToken(), "the second replica should use the token of the first")
+
+	_, err = second.refreshToken(context.Background(), second.GetAccessToken())
+	assert.NoError(t, err)
+	_, err = first.refreshToken(context.Background(), first.GetAccessToken())
+	assert.NoError(t, err)
+	assert.Equal(t, "TOKEN-2", first.GetAccessToken())
+	assert.Equal(t, 2, tokenRequests)
+	assert.Equal(t, 4, store.locks)
+}
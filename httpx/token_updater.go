@@ -2,34 +2,88 @@ package httpx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/fond-of-vertigo/amazon-sp-api/constants"
-	"github.com/fond-of-vertigo/logger"
 )
 
-const tokenURL = "https://api.amazon.com/auth/o2/token"
+// DefaultTokenURL is the LWA token endpoint used if TokenUpdaterConfig.TokenURL is empty.
+const DefaultTokenURL = "https://api.amazon.com/auth/o2/token"
 
 type TokenUpdaterConfig struct {
 	RefreshToken string
 	ClientID     string
 	ClientSecret string
 	HTTPClient   HTTPRequester
-	Logger       logger.Logger
+	// Logger defaults to slog.Default().
+	Logger *slog.Logger
+	// TokenStore persists the access token between runs. Defaults to a MemoryTokenStore.
+	TokenStore TokenStore
+	// TokenURL overrides the LWA token endpoint, e.g. for tests or proxies. Defaults to DefaultTokenURL.
+	TokenURL string
+	// ExpiryDelta is the time before expiry at which the access token is refreshed. Defaults to constants.ExpiryDelta.
+	ExpiryDelta time.Duration
+	// RefreshJitter refreshes the access token up to RefreshJitter earlier, chosen at random for each refresh,
+	// so workers sharing the same credentials don't refresh at the same instant. Defaults to no jitter.
+	RefreshJitter time.Duration
+	// Credentials supplies the credentials at every token request instead of RefreshToken, ClientID and
+	// ClientSecret, which allows rotating secrets without restarting the process. Rejected credentials
+	// are retried every constants.MaxTokenUpdaterBackoffTime instead of stopping the token updater.
+	Credentials CredentialsFunc
+	// Metrics receives the results of the token requests. Optional.
+	Metrics MetricsRecorder
+}
+
+func (c TokenUpdaterConfig) tokenURL() string {
+	if c.TokenURL == "" {
+		return DefaultTokenURL
+	}
+	return c.TokenURL
+}
+
+func (c TokenUpdaterConfig) logger() *slog.Logger {
+	if c.Logger == nil {
+		return slog.Default()
+	}
+	return c.Logger
+}
+
+func (c TokenUpdaterConfig) refreshTiming() refreshTiming {
+	timing := refreshTiming{expiryDelta: c.ExpiryDelta, jitter: c.RefreshJitter}
+	if timing.expiryDelta <= 0 {
+		timing.expiryDelta = constants.ExpiryDelta
+	}
+	return timing
 }
 
 type PeriodicTokenUpdater struct {
-	accessToken  atomic.Pointer[string]
-	refreshToken string
-	clientID     string
-	clientSecret string
-	httpClient   HTTPRequester
-	log          logger.Logger
+	accessToken atomic.Pointer[string]
+	terminalErr atomic.Pointer[error]
+	// refreshMu serializes the token requests of RefreshAccessToken and the background goroutine.
+	refreshMu   sync.Mutex
+	statusMu    sync.RWMutex
+	expiresAt   time.Time
+	lastErr     error
+	credentials CredentialsFunc
+	// rotatesCredentials is set if the credentials come from TokenUpdaterConfig.Credentials, so
+	// that terminal errors may be resolved by rotated credentials.
+	rotatesCredentials bool
+	httpClient         HTTPRequester
+	tokenURL           string
+	tokenStore         TokenStore
+	timing             refreshTiming
+	metrics            MetricsRecorder
+	log                *slog.Logger
 }
 
 type AccessTokenResponse struct {
@@ -42,12 +96,19 @@ type AccessTokenResponse struct {
 }
 
 func newTokenUpdater(config TokenUpdaterConfig) *PeriodicTokenUpdater {
+	tokenStore := config.TokenStore
+	if tokenStore == nil {
+		tokenStore = &MemoryTokenStore{}
+	}
 	return &PeriodicTokenUpdater{
-		refreshToken: config.RefreshToken,
-		clientID:     config.ClientID,
-		clientSecret: config.ClientSecret,
-		log:          config.Logger,
-		httpClient:   config.HTTPClient,
+		credentials:        config.credentials(),
+		rotatesCredentials: config.Credentials != nil,
+		log:                config.logger(),
+		httpClient:         config.HTTPClient,
+		tokenURL:           config.tokenURL(),
+		tokenStore:         tokenStore,
+		timing:             config.refreshTiming(),
+		metrics:            config.Metrics,
 	}
 }
 
@@ -60,74 +121,289 @@ func (t *PeriodicTokenUpdater) GetAccessToken() string {
 	return *token
 }
 
-// RunInBackground starts a goroutine that fetches a new access token periodically
-// and stores it in the client. The goroutine is stopped when the returned cancel function is called.
-func (t *PeriodicTokenUpdater) RunInBackground() (cancel func(), err error) {
-	durationNextFetch, err := t.doInitialFetch()
-	if err != nil {
-		return func() {}, err
+// AccessToken implements TokenProvider. It returns Err instead of the current access token,
+// if the token can't be refreshed anymore.
+func (t *PeriodicTokenUpdater) AccessToken(_ context.Context) (string, error) {
+	if err := t.Err(); err != nil {
+		return "", err
 	}
+	return t.GetAccessToken(), nil
+}
+
+// Err returns the error which stopped the token updater, e.g. an LWAError matching ErrInvalidGrant
+// if the refresh token was revoked. The current access token can't be refreshed anymore in that case.
+// With TokenUpdaterConfig.Credentials the updater keeps retrying instead, since the credentials may
+// be rotated meanwhile, and Err returns nil again once a refresh succeeds.
+func (t *PeriodicTokenUpdater) Err() error {
+	err := t.terminalErr.Load()
+	if err == nil {
+		return nil
+	}
+	return *err
+}
+
+// ExpiresAt returns when the current access token expires, or the zero time if no token was fetched yet.
+func (t *PeriodicTokenUpdater) ExpiresAt() time.Time {
+	t.statusMu.RLock()
+	defer t.statusMu.RUnlock()
+	return t.expiresAt
+}
 
-	ticker := time.NewTicker(durationNextFetch)
-	done := make(chan bool)
+// IsValid reports whether the current access token can be used, i.e. it is not expired and the
+// token updater didn't stop with a terminal error.
+func (t *PeriodicTokenUpdater) IsValid() bool {
+	return t.Err() == nil && t.GetAccessToken() != "" && nowFunc().Before(t.ExpiresAt())
+}
+
+// LastRefreshError returns the error of the last token request, or nil if it succeeded.
+// Unlike Err, it also reports transient errors which are retried.
+func (t *PeriodicTokenUpdater) LastRefreshError() error {
+	t.statusMu.RLock()
+	defer t.statusMu.RUnlock()
+	return t.lastErr
+}
+
+func (t *PeriodicTokenUpdater) setStatus(expiresAt time.Time, err error) {
+	t.statusMu.Lock()
+	defer t.statusMu.Unlock()
+	if err == nil {
+		t.expiresAt = expiresAt
+	}
+	t.lastErr = err
+}
+
+// RefreshAccessToken implements TokenRefresher. It fetches a new access token, unless another
+// caller already replaced staleToken in the meantime.
+func (t *PeriodicTokenUpdater) RefreshAccessToken(ctx context.Context, staleToken string) error {
+	t.refreshMu.Lock()
+	defer t.refreshMu.Unlock()
+
+	if err := t.Err(); err != nil && !t.rotatesCredentials {
+		return err
+	}
+	if t.GetAccessToken() != staleToken {
+		return nil
+	}
+
+	t.log.Info("Forcing refresh of rejected access-token")
+	_, err := t.refreshToken(ctx, staleToken)
+	if isTerminalTokenError(err) {
+		t.terminalErr.Store(&err)
+	} else if err == nil {
+		t.terminalErr.Store(nil)
+	}
+	return err
+}
+
+// RunInBackground fetches the first access token and starts a goroutine that fetches a new access token
+// periodically. The goroutine stops when ctx is done; the returned channel is closed once it has stopped.
+func (t *PeriodicTokenUpdater) RunInBackground(ctx context.Context) (stopped <-chan struct{}, err error) {
+	done := make(chan struct{})
+	durationNextFetch, err := t.doInitialFetch(ctx)
+	if err != nil {
+		close(done)
+		return done, err
+	}
 
 	go func() {
+		defer close(done)
+		ticker := time.NewTicker(durationNextFetch)
+		defer ticker.Stop()
+		backoff := constants.DefaultTokenUpdaterBackoffTime
+
 		for {
 			select {
-			case <-done:
-				t.log.Infof("Stopped goroutine of token-updater.")
+			case <-ctx.Done():
+				t.log.Info("Stopped goroutine of token-updater")
 				return
 			case <-ticker.C:
-				token, err := t.doTokenRequest()
-				if err != nil {
-					t.log.Errorf("Failed to fetch new access-tokenAPI: %s", err.Error())
-					ticker.Reset(constants.DefaultTokenUpdaterBackoffTime)
-					continue
+				var next time.Duration
+				var stop bool
+				next, backoff, stop = t.refreshInBackground(ctx, backoff)
+				if stop {
+					return
 				}
-				t.accessToken.Store(&token.AccessToken)
-				durationToWait := durationBetweenTokenRequests(token)
-				ticker.Reset(durationToWait)
+				ticker.Reset(next)
 			}
 		}
 	}()
 
-	cancelFunc := func() {
-		ticker.Stop()
-		done <- true
+	return done, nil
+}
+
+// refreshInBackground refreshes the access token for the goroutine of RunInBackground. It returns
+// when to refresh next, the backoff after the following failure and whether to stop because the
+// access token cannot be refreshed anymore. It holds refreshMu like RefreshAccessToken, so that a
+// forced refresh and the periodic one never request tokens at the same time.
+func (t *PeriodicTokenUpdater) refreshInBackground(ctx context.Context, backoff time.Duration) (next, nextBackoff time.Duration, stop bool) {
+	t.refreshMu.Lock()
+	defer t.refreshMu.Unlock()
+
+	durationToWait, err := t.refreshToken(ctx, t.GetAccessToken())
+	switch {
+	case err == nil:
+		if t.Err() != nil {
+			t.log.Info("Resumed token-updater, access-token was refreshed with rotated credentials")
+			t.terminalErr.Store(nil)
+		}
+		return durationToWait, constants.DefaultTokenUpdaterBackoffTime, false
+	case isTerminalTokenError(err) && !t.rotatesCredentials:
+		t.log.Error("Stopped token-updater, access-token cannot be refreshed", "error", err)
+		t.terminalErr.Store(&err)
+		return 0, 0, true
+	case isTerminalTokenError(err):
+		// e.g. a secret rotated in Secrets Manager before the app registration caught up
+		t.log.Error("Access-token cannot be refreshed with the current credentials", "retryIn", constants.MaxTokenUpdaterBackoffTime, "error", err)
+		t.terminalErr.Store(&err)
+		return constants.MaxTokenUpdaterBackoffTime, constants.MaxTokenUpdaterBackoffTime, false
+	}
+	t.log.Error("Failed to fetch new access-token", "retryIn", backoff, "error", err)
+	return backoff, min(2*backoff, constants.MaxTokenUpdaterBackoffTime), false
+}
+
+func (t *PeriodicTokenUpdater) doInitialFetch(ctx context.Context) (time.Duration, error) {
+	if durationNextFetch, ok := t.useStoredToken(ctx, ""); ok {
+		return durationNextFetch, nil
 	}
-	return cancelFunc, nil
 
+	t.log.Debug("Fetching first access-token")
+	backoff := constants.DefaultTokenRequestRetryBackoff
+	for attempt := 1; ; attempt++ {
+		durationNextFetch, err := t.refreshToken(ctx, "")
+		if err == nil {
+			return durationNextFetch, nil
+		}
+		if !isTransientTokenError(err) || attempt >= constants.MaxTokenRequestAttempts {
+			return constants.DefaultTokenUpdaterBackoffTime, err
+		}
+
+		t.log.Warn("Failed to fetch first access-token", "retryIn", backoff, "error", err)
+		select {
+		case <-ctx.Done():
+			return constants.DefaultTokenUpdaterBackoffTime, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// refreshToken replaces staleToken with a newer token of the token store, e.g. fetched by another replica
+// sharing the store, and only requests a new access token if there is none. The store is locked meanwhile
+// if it implements TokenStoreLocker.
+func (t *PeriodicTokenUpdater) refreshToken(ctx context.Context, staleToken string) (time.Duration, error) {
+	if locker, ok := t.tokenStore.(TokenStoreLocker); ok {
+		unlock, err := locker.Lock(ctx)
+		if err != nil {
+			err = fmt.Errorf("failed to lock token store: %w", err)
+			t.setStatus(time.Time{}, err)
+			return 0, err
+		}
+		defer unlock()
+	}
+
+	if durationNextFetch, ok := t.useStoredToken(ctx, staleToken); ok {
+		return durationNextFetch, nil
+	}
+	return t.fetchToken(ctx)
 }
 
-func (t *PeriodicTokenUpdater) doInitialFetch() (time.Duration, error) {
-	t.log.Debugf("Fetching first access-tokenAPI")
-	token, err := t.doTokenRequest()
+// useStoredToken uses the token of the token store if it differs from staleToken, is still valid and
+// was fetched with the current credentials. It returns the duration until the next fetch.
+func (t *PeriodicTokenUpdater) useStoredToken(ctx context.Context, staleToken string) (time.Duration, bool) {
+	stored, err := t.tokenStore.Load()
 	if err != nil {
-		return constants.DefaultTokenUpdaterBackoffTime, err
+		t.log.Error("Failed to load stored access-token", "error", err)
+		return 0, false
+	}
+	if stored == nil || stored.AccessToken == "" || stored.AccessToken == staleToken {
+		return 0, false
+	}
+	validFor := stored.ExpiresAt.Sub(nowFunc())
+	if validFor <= t.timing.expiryDelta {
+		return 0, false
+	}
+	creds, err := t.credentials(ctx)
+	if err != nil {
+		t.log.Error("Failed to check stored access-token", "error", err)
+		return 0, false
+	}
+	if stored.CredentialsHash != creds.hash() {
+		t.log.Debug("Ignoring stored access-token of other credentials")
+		return 0, false
+	}
+
+	t.log.Debug("Reusing stored access-token")
+	t.accessToken.Store(&stored.AccessToken)
+	t.setStatus(stored.ExpiresAt, nil)
+	return t.timing.withJitter(validFor - t.timing.expiryDelta), true
+}
+
+// fetchToken requests a new access token, stores it and returns the duration until the next fetch.
+func (t *PeriodicTokenUpdater) fetchToken(ctx context.Context) (time.Duration, error) {
+	creds, err := t.credentials(ctx)
+	var token *AccessTokenResponse
+	if err == nil {
+		token, err = t.doTokenRequest(ctx, creds)
+	}
+	if t.metrics != nil {
+		t.metrics.ObserveTokenRefresh(err)
+	}
+	if err != nil {
+		t.setStatus(time.Time{}, err)
+		return 0, err
 	}
 	t.accessToken.Store(&token.AccessToken)
-	durationNextFetch := durationBetweenTokenRequests(token)
-	return durationNextFetch, nil
+
+	stored := StoredToken{
+		AccessToken:     token.AccessToken,
+		ExpiresAt:       nowFunc().Add(time.Duration(token.ExpiresIn) * time.Second),
+		CredentialsHash: creds.hash(),
+	}
+	t.setStatus(stored.ExpiresAt, nil)
+	if err = t.tokenStore.Save(stored); err != nil {
+		t.log.Error("Failed to store access-token", "error", err)
+	}
+	return t.timing.withJitter(t.timing.durationBetweenTokenRequests(token)), nil
+}
+
+// refreshTiming decides when an access token is refreshed.
+type refreshTiming struct {
+	expiryDelta time.Duration
+	jitter      time.Duration
+}
+
+// jitterFunc as variable for mocking, returns a random duration in [0, n)
+var jitterFunc = func(n time.Duration) time.Duration {
+	return time.Duration(rand.Int63n(int64(n)))
+}
+
+func (r refreshTiming) durationBetweenTokenRequests(token *AccessTokenResponse) time.Duration {
+	expiresIn := time.Duration(token.ExpiresIn) * time.Second
+	if expiresIn <= r.expiryDelta {
+		return expiresIn
+	}
+	return expiresIn - r.expiryDelta
 }
 
-func durationBetweenTokenRequests(token *AccessTokenResponse) time.Duration {
-	expiryDeltaSeconds := int(constants.ExpiryDelta.Seconds())
-	if token.ExpiresIn <= expiryDeltaSeconds {
-		return time.Duration(token.ExpiresIn) * time.Second
+// withJitter shortens d by a random duration up to the configured jitter, but at most by half of d.
+func (r refreshTiming) withJitter(d time.Duration) time.Duration {
+	maxJitter := min(r.jitter, d/2)
+	if maxJitter <= 0 {
+		return d
 	}
-	return time.Duration(token.ExpiresIn-expiryDeltaSeconds) * time.Second
+	return d - jitterFunc(maxJitter)
 }
 
-func (t *PeriodicTokenUpdater) doTokenRequest() (*AccessTokenResponse, error) {
-	body := makeRequestBody(t.refreshToken, t.clientID, t.clientSecret)
-	resp, err := t.httpClient.Post(tokenURL, "application/json", bytes.NewBuffer(body))
+func (t *PeriodicTokenUpdater) doTokenRequest(ctx context.Context, creds Credentials) (*AccessTokenResponse, error) {
+	body := makeRequestBody(creds.RefreshToken, creds.ClientID, creds.ClientSecret)
+	resp, err := postTokenRequest(ctx, t.httpClient, t.tokenURL, body)
 	if err != nil {
 		return nil, err
 	}
 
 	defer func(Body io.ReadCloser) {
 		if err := Body.Close(); err != nil {
-			t.log.Errorf(err.Error())
+			t.log.Error("Failed to close token response body", "error", err)
 		}
 	}(resp.Body)
 
@@ -138,6 +414,12 @@ func (t *PeriodicTokenUpdater) doTokenRequest() (*AccessTokenResponse, error) {
 
 	tkn, err := t.parseAccessTokenResponse(respBody)
 	if err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, &LWAError{StatusCode: resp.StatusCode, Description: string(respBody)}
+		}
+		return nil, err
+	}
+	if err = newLWAError(resp.StatusCode, tkn); err != nil {
 		return nil, err
 	}
 
@@ -155,6 +437,16 @@ func (t *PeriodicTokenUpdater) parseAccessTokenResponse(body []byte) (*AccessTok
 	return parsedResp, nil
 }
 
+// postTokenRequest sends the JSON encoded body to the LWA token endpoint.
+func postTokenRequest(ctx context.Context, httpClient HTTPRequester, tokenURL string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return httpClient.Do(req)
+}
+
 func makeRequestBody(refreshToken, clientID, clientSecret string) []byte {
 	body, _ := json.Marshal(map[string]string{
 		"grant_type":    "refresh_token",
@@ -1,13 +1,19 @@
 package httpx
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
-	"github.com/fond-of-vertigo/logger"
+	"fmt"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
 	"github.com/stretchr/testify/assert"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -19,23 +25,24 @@ type mockHTTPClient struct {
 	Body             []byte
 	PostCallCount    int
 	MockResponseBody []byte
+	MockStatusCode   int
 }
 
-func (m *mockHTTPClient) Do(_ *http.Request) (*http.Response, error) {
-	return nil, nil
-}
-
-func (m *mockHTTPClient) Post(url string, bodyType string, body io.Reader) (*http.Response, error) {
+func (m *mockHTTPClient) Do(req *http.Request) (*http.Response, error) {
 	m.PostCallCount++
-	assert.Equal(m, m.URL, url)
-	assert.Equal(m, m.BodyType, bodyType)
+	assert.Equal(m, http.MethodPost, req.Method)
+	assert.Equal(m, m.URL, req.URL.String())
+	assert.Equal(m, m.BodyType, req.Header.Get("Content-Type"))
 
-	assert.NotNil(m, body)
-	acutalBody, err := io.ReadAll(body)
+	assert.NotNil(m, req.Body)
+	acutalBody, err := io.ReadAll(req.Body)
 	assert.NoError(m, err)
 	assert.Equal(m, m.Body, acutalBody)
 
 	resp := httptest.NewRecorder()
+	if m.MockStatusCode != 0 {
+		resp.WriteHeader(m.MockStatusCode)
+	}
 	_, err = resp.Write(m.MockResponseBody)
 	assert.NoError(m, err)
 	return resp.Result(), nil
@@ -90,16 +97,17 @@ func TestPeriodicTokenUpdater_RunInBackground(t *testing.T) {
 				ClientSecret: tt.args.ClientSecret,
 				HTTPClient: &mockHTTPClient{
 					TB:               t,
-					URL:              tokenURL,
+					URL:              DefaultTokenURL,
 					BodyType:         "application/json",
 					Body:             makeRequestBody(tt.args.RefreshToken, tt.args.ClientID, tt.args.ClientSecret),
 					MockResponseBody: respBody,
 				},
-				Logger: logger.New(logger.LvlTrace),
+				Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
 			})
 
 			//  when
-			cancel, err := tu.RunInBackground()
+			ctx, cancel := context.WithCancel(context.Background())
+			stopped, err := tu.RunInBackground(ctx)
 
 			// then
 			if tt.WantError != nil {
@@ -113,6 +121,7 @@ func TestPeriodicTokenUpdater_RunInBackground(t *testing.T) {
 			// wait for the next update
 			time.Sleep((time.Duration(tt.args.MockTokenResponse.ExpiresIn) * time.Second) - time.Duration(500)*time.Millisecond)
 			cancel()
+			<-stopped
 
 			assert.Equal(t, tt.args.MockTokenResponse.AccessToken, tu.GetAccessToken())
 			time.Sleep(time.Duration(tt.args.MockTokenResponse.ExpiresIn) * time.Second)
@@ -121,3 +130,270 @@ func TestPeriodicTokenUpdater_RunInBackground(t *testing.T) {
 		})
 	}
 }
+
+func TestGrantlessTokenUpdater_GetAccessToken(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	nowFunc = func() time.Time { return now }
+	defer func() { nowFunc = time.Now }()
+
+	respBody, _ := json.Marshal(AccessTokenResponse{AccessToken: "GRANTLESS-TOKEN", ExpiresIn: 3600})
+	httpClient := &mockHTTPClient{
+		TB:               t,
+		URL:              DefaultTokenURL,
+		BodyType:         "application/json",
+		Body:             []byte(`{"client_id":"ID","client_secret":"SECRET","grant_type":"client_credentials","scope":"sellingpartnerapi::client_credential:rotation"}`),
+		MockResponseBody: respBody,
+	}
+	g := newGrantlessTokenUpdater(TokenUpdaterConfig{ClientID: "ID", ClientSecret: "SECRET", HTTPClient: httpClient})
+
+	token, err := g.GetAccessToken(context.Background(), constants.ScopeClientCredentialRotation)
+	assert.NoError(t, err)
+	assert.Equal(t, "GRANTLESS-TOKEN", token)
+
+	_, err = g.GetAccessToken(context.Background(), constants.ScopeClientCredentialRotation)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, httpClient.PostCallCount, "cached token should be reused")
+
+	now = now.Add(time.Hour)
+	_, err = g.GetAccessToken(context.Background(), constants.ScopeClientCredentialRotation)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, httpClient.PostCallCount, "expired token should be refreshed")
+}
+
+func TestPeriodicTokenUpdater_CustomTokenURL(t *testing.T) {
+	respBody, _ := json.Marshal(AccessTokenResponse{AccessToken: "ACCESS-TOKEN", ExpiresIn: 3600})
+	tu := newTokenUpdater(TokenUpdaterConfig{
+		RefreshToken: "refreshToken",
+		ClientID:     "clientID",
+		ClientSecret: "clientSecret",
+		TokenURL:     "http://lwa.test/auth/o2/token",
+		HTTPClient: &mockHTTPClient{
+			TB:               t,
+			URL:              "http://lwa.test/auth/o2/token",
+			BodyType:         "application/json",
+			Body:             makeRequestBody("refreshToken", "clientID", "clientSecret"),
+			MockResponseBody: respBody,
+		},
+		Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+
+	_, err := tu.fetchToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "ACCESS-TOKEN", tu.GetAccessToken())
+}
+
+func TestPeriodicTokenUpdater_LWAError(t *testing.T) {
+	httpClient := &mockHTTPClient{
+		TB:               t,
+		URL:              DefaultTokenURL,
+		BodyType:         "application/json",
+		Body:             makeRequestBody("revoked", "clientID", "clientSecret"),
+		MockResponseBody: []byte(`{"error":"invalid_grant","error_description":"The request has an invalid grant parameter : refresh_token"}`),
+		MockStatusCode:   http.StatusBadRequest,
+	}
+	tu := newTokenUpdater(TokenUpdaterConfig{
+		RefreshToken: "revoked",
+		ClientID:     "clientID",
+		ClientSecret: "clientSecret",
+		HTTPClient:   httpClient,
+		Logger:       slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+
+	_, err := tu.RunInBackground(context.Background())
+
+	var lwaErr *LWAError
+	assert.ErrorAs(t, err, &lwaErr)
+	assert.True(t, errors.Is(err, ErrInvalidGrant))
+	assert.True(t, lwaErr.IsTerminal())
+	assert.Equal(t, 1, httpClient.PostCallCount, "terminal errors must not be retried")
+}
+
+func TestRefreshTiming(t *testing.T) {
+	defer func(f func(time.Duration) time.Duration) { jitterFunc = f }(jitterFunc)
+	jitterFunc = func(n time.Duration) time.Duration { return n - 1 }
+
+	token := &AccessTokenResponse{ExpiresIn: 3600}
+	tests := []struct {
+		name   string
+		config TokenUpdaterConfig
+		want   time.Duration
+	}{
+		{name: "Defaults", config: TokenUpdaterConfig{}, want: time.Hour - constants.ExpiryDelta},
+		{name: "ExpiryDelta", config: TokenUpdaterConfig{ExpiryDelta: 5 * time.Minute}, want: 55 * time.Minute},
+		{name: "RefreshJitter", config: TokenUpdaterConfig{RefreshJitter: time.Minute}, want: 58*time.Minute + 1},
+		{name: "RefreshJitterCapped", config: TokenUpdaterConfig{RefreshJitter: 2 * time.Hour}, want: 29*time.Minute + 30*time.Second + 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timing := tt.config.refreshTiming()
+			got := timing.withJitter(timing.durationBetweenTokenRequests(token))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPeriodicTokenUpdater_RotatedCredentials(t *testing.T) {
+	secrets := []string{"old-secret", "rotated-secret"}
+	calls := 0
+	var sentBodies [][]byte
+	updater := newTokenUpdater(TokenUpdaterConfig{
+		Credentials: func(ctx context.Context) (Credentials, error) {
+			secret := secrets[min(calls, len(secrets)-1)]
+			calls++
+			return Credentials{ClientID: "clientID", ClientSecret: secret, RefreshToken: "refreshToken"}, nil
+		},
+		HTTPClient: requesterFunc(func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			sentBodies = append(sentBodies, body)
+			respBody, _ := json.Marshal(AccessTokenResponse{AccessToken: "ACCESS-TOKEN", ExpiresIn: 3600})
+			resp := httptest.NewRecorder()
+			_, _ = resp.Write(respBody)
+			return resp.Result(), nil
+		}),
+		Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+
+	for range secrets {
+		if _, err := updater.fetchToken(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assert.Equal(t, [][]byte{
+		makeRequestBody("refreshToken", "clientID", "old-secret"),
+		makeRequestBody("refreshToken", "clientID", "rotated-secret"),
+	}, sentBodies)
+}
+
+func TestPeriodicTokenUpdater_RecoversWithRotatedCredentials(t *testing.T) {
+	secret := "revoked-secret"
+	newUpdater := func(config TokenUpdaterConfig) *PeriodicTokenUpdater {
+		config.HTTPClient = requesterFunc(func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			resp := httptest.NewRecorder()
+			if !bytes.Equal(body, makeRequestBody("refreshToken", "clientID", "rotated-secret")) {
+				resp.WriteHeader(http.StatusUnauthorized)
+				_, _ = resp.WriteString(`{"error":"invalid_client","error_description":"Client authentication failed"}`)
+				return resp.Result(), nil
+			}
+			respBody, _ := json.Marshal(AccessTokenResponse{AccessToken: "ACCESS-TOKEN", ExpiresIn: 3600})
+			_, _ = resp.Write(respBody)
+			return resp.Result(), nil
+		})
+		config.Logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+		return newTokenUpdater(config)
+	}
+
+	updater := newUpdater(TokenUpdaterConfig{
+		Credentials: func(ctx context.Context) (Credentials, error) {
+			return Credentials{ClientID: "clientID", ClientSecret: secret, RefreshToken: "refreshToken"}, nil
+		},
+	})
+	next, _, stop := updater.refreshInBackground(context.Background(), constants.DefaultTokenUpdaterBackoffTime)
+	assert.False(t, stop, "rotated credentials may resolve terminal errors")
+	assert.Equal(t, constants.MaxTokenUpdaterBackoffTime, next)
+	assert.Error(t, updater.Err())
+	_, err := updater.AccessToken(context.Background())
+	assert.Error(t, err)
+
+	secret = "rotated-secret"
+	next, backoff, stop := updater.refreshInBackground(context.Background(), constants.MaxTokenUpdaterBackoffTime)
+	assert.False(t, stop)
+	assert.Equal(t, time.Hour-constants.ExpiryDelta, next)
+	assert.Equal(t, constants.DefaultTokenUpdaterBackoffTime, backoff)
+	assert.NoError(t, updater.Err())
+	token, err := updater.AccessToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "ACCESS-TOKEN", token)
+
+	static := newUpdater(TokenUpdaterConfig{RefreshToken: "refreshToken", ClientID: "clientID", ClientSecret: "revoked-secret"})
+	_, _, stop = static.refreshInBackground(context.Background(), constants.DefaultTokenUpdaterBackoffTime)
+	assert.True(t, stop, "static credentials can't change without a restart")
+	assert.Error(t, static.Err())
+}
+
+func TestPeriodicTokenUpdater_SerializesForcedAndPeriodicRefreshes(t *testing.T) {
+	var tokenRequests, inFlight, maxInFlight atomic.Int32
+	updater := newTokenUpdater(TokenUpdaterConfig{
+		HTTPClient: requesterFunc(func(req *http.Request) (*http.Response, error) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			if n > maxInFlight.Load() {
+				maxInFlight.Store(n)
+			}
+			time.Sleep(10 * time.Millisecond)
+			respBody, _ := json.Marshal(AccessTokenResponse{AccessToken: fmt.Sprintf("TOKEN-%d", tokenRequests.Add(1)), ExpiresIn: 3600})
+			resp := httptest.NewRecorder()
+			_, _ = resp.Write(respBody)
+			return resp.Result(), nil
+		}),
+		Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	_, err := updater.fetchToken(context.Background())
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, _, _ = updater.refreshInBackground(context.Background(), constants.DefaultTokenUpdaterBackoffTime)
+		}()
+		go func() {
+			defer wg.Done()
+			_ = updater.RefreshAccessToken(context.Background(), updater.GetAccessToken())
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), maxInFlight.Load(), "token requests must not overlap")
+}
+
+func TestPeriodicTokenUpdater_CredentialsError(t *testing.T) {
+	secretsErr := errors.New("vault sealed")
+	updater := newTokenUpdater(TokenUpdaterConfig{
+		Credentials: func(ctx context.Context) (Credentials, error) {
+			return Credentials{}, secretsErr
+		},
+		Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+
+	_, err := updater.fetchToken(context.Background())
+	if !errors.Is(err, secretsErr) {
+		t.Errorf("fetchToken() error = %v, want %v", err, secretsErr)
+	}
+}
+
+func TestPeriodicTokenUpdater_Health(t *testing.T) {
+	defer func(f func() time.Time) { nowFunc = f }(nowFunc)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	nowFunc = func() time.Time { return now }
+
+	statusCode := http.StatusOK
+	updater := newTokenUpdater(TokenUpdaterConfig{
+		HTTPClient: requesterFunc(func(req *http.Request) (*http.Response, error) {
+			respBody, _ := json.Marshal(AccessTokenResponse{AccessToken: "ACCESS-TOKEN", ExpiresIn: 3600})
+			resp := httptest.NewRecorder()
+			resp.WriteHeader(statusCode)
+			_, _ = resp.Write(respBody)
+			return resp.Result(), nil
+		}),
+		Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	assert.False(t, updater.IsValid())
+	assert.True(t, updater.ExpiresAt().IsZero())
+
+	_, err := updater.fetchToken(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, updater.IsValid())
+	assert.Equal(t, now.Add(time.Hour), updater.ExpiresAt())
+	assert.NoError(t, updater.LastRefreshError())
+
+	statusCode = http.StatusServiceUnavailable
+	_, err = updater.fetchToken(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, err, updater.LastRefreshError())
+	assert.True(t, updater.IsValid(), "the previous token is still valid")
+
+	now = now.Add(2 * time.Hour)
+	assert.False(t, updater.IsValid())
+}
@@ -0,0 +1,38 @@
+package httpx
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+)
+
+const modulePath = "github.com/fond-of-vertigo/amazon-sp-api"
+
+// UserAgent returns the User-Agent sent with SP-API calls, e.g.
+// "amazon-sp-api-sdk-go/v1.2.0 (Language=Go/go1.21.5; Platform=linux/amd64) MyApp/2.1".
+// The application, if not empty, is appended to identify it to Amazon.
+func UserAgent(application string) string {
+	userAgent := fmt.Sprintf("amazon-sp-api-sdk-go/%s (Language=Go/%s; Platform=%s/%s)",
+		sdkVersion(), runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	if application != "" {
+		userAgent += " " + application
+	}
+	return userAgent
+}
+
+// sdkVersion returns the version of this module from the build info of the binary.
+func sdkVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			return dep.Version
+		}
+	}
+	if info.Main.Path == modulePath && info.Main.Version != "" {
+		return info.Main.Version
+	}
+	return "unknown"
+}
@@ -0,0 +1,153 @@
+// Package golden round-trips captured SP-API payloads through the model structs, so fields which
+// a model silently drops fail the tests of the API package. Fixtures are JSON files in the
+// testdata directory of the package, e.g. the response of a sandbox call or a redacted fixture of
+// httpx.RecordingTransport:
+//
+//	func TestGoldenFixtures(t *testing.T) {
+//		golden.RoundTrip[GetOrderResponse](t, "testdata/getOrder.json")
+//	}
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"testing"
+	"time"
+)
+
+// RoundTrip decodes the JSON file at path into a T, encodes it again and fails t for every field of
+// the file which is missing or changed after the round trip.
+func RoundTrip[T any](t testing.TB, path string) {
+	t.Helper()
+	fixture, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading fixture failed: %v", err)
+	}
+
+	var model T
+	if err = json.Unmarshal(fixture, &model); err != nil {
+		t.Fatalf("decoding %s into %T failed: %v", path, model, err)
+	}
+	encoded, err := json.Marshal(model)
+	if err != nil {
+		t.Fatalf("encoding %T failed: %v", model, err)
+	}
+
+	diffs, err := Diff(fixture, encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, diff := range diffs {
+		t.Errorf("%s: %s", path, diff)
+	}
+}
+
+// Diff returns the fields of want which are missing or changed in got, e.g.
+// "$.payload.Orders[0].BuyerInfo: dropped". Fields of got missing in want are ignored, because
+// models encode zero values of fields without omitempty. Null fields may be dropped, timestamps
+// are compared as instants.
+func Diff(want, got []byte) ([]string, error) {
+	var wantValue, gotValue any
+	if err := decode(want, &wantValue); err != nil {
+		return nil, fmt.Errorf("decoding fixture failed: %w", err)
+	}
+	if err := decode(got, &gotValue); err != nil {
+		return nil, fmt.Errorf("decoding round trip failed: %w", err)
+	}
+
+	var diffs []string
+	diff("$", wantValue, gotValue, &diffs)
+	return diffs, nil
+}
+
+func decode(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+func diff(path string, want, got any, diffs *[]string) {
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			*diffs = append(*diffs, fmt.Sprintf("%s: object became %s", path, describe(got)))
+			return
+		}
+		keys := make([]string, 0, len(w))
+		for key := range w {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			gotField, ok := g[key]
+			if !ok {
+				if w[key] != nil {
+					*diffs = append(*diffs, fmt.Sprintf("%s.%s: dropped", path, key))
+				}
+				continue
+			}
+			diff(path+"."+key, w[key], gotField, diffs)
+		}
+	case []any:
+		g, ok := got.([]any)
+		if !ok {
+			*diffs = append(*diffs, fmt.Sprintf("%s: array became %s", path, describe(got)))
+			return
+		}
+		if len(w) != len(g) {
+			*diffs = append(*diffs, fmt.Sprintf("%s: %d elements became %d", path, len(w), len(g)))
+			return
+		}
+		for i := range w {
+			diff(fmt.Sprintf("%s[%d]", path, i), w[i], g[i], diffs)
+		}
+	case json.Number:
+		g, ok := got.(json.Number)
+		if !ok || !equalNumbers(w, g) {
+			*diffs = append(*diffs, fmt.Sprintf("%s: %s became %s", path, w, describe(got)))
+		}
+	case string:
+		g, ok := got.(string)
+		if !ok || (w != g && !equalTimes(w, g)) {
+			*diffs = append(*diffs, fmt.Sprintf("%s: %q became %s", path, w, describe(got)))
+		}
+	default:
+		if want != got {
+			*diffs = append(*diffs, fmt.Sprintf("%s: %v became %s", path, want, describe(got)))
+		}
+	}
+}
+
+func equalNumbers(a, b json.Number) bool {
+	if a == b {
+		return true
+	}
+	x, errX := a.Float64()
+	y, errY := b.Float64()
+	return errX == nil && errY == nil && x == y
+}
+
+func equalTimes(a, b string) bool {
+	x, errX := time.Parse(time.RFC3339Nano, a)
+	y, errY := time.Parse(time.RFC3339Nano, b)
+	return errX == nil && errY == nil && x.Equal(y)
+}
+
+func describe(v any) string {
+	switch v := v.(type) {
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "an array"
+	case string:
+		return fmt.Sprintf("%q", v)
+	case nil:
+		return "null"
+	default:
+		return fmt.Sprint(v)
+	}
+}
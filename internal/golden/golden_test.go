@@ -0,0 +1,20 @@
+package golden
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	want := `{"id":"A","createdTime":"2024-01-02T03:04:05.000Z","amount":1.50,"items":[{"sku":"S1","qty":2}],"note":null}`
+	got := `{"id":"A","createdTime":"2024-01-02T03:04:05Z","amount":1.5,"items":[{"sku":"S1"}],"extra":""}`
+
+	diffs, err := Diff([]byte(want), []byte(got))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"$.items[0].qty: dropped"}, diffs)
+
+	diffs, err = Diff([]byte(`{"status":"DONE","items":[1,2]}`), []byte(`{"status":"done","items":[1]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"$.items: 2 elements became 1", `$.status: "DONE" became "done"`}, diffs)
+}
@@ -0,0 +1,273 @@
+// Command mockgen generates the mocks of package mocks from the Client interfaces of the API packages.
+//
+//	go run ./internal/mockgen -apis apis -out mocks
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const modulePath = "github.com/fond-of-vertigo/amazon-sp-api"
+
+func main() {
+	apisDir := flag.String("apis", "apis", "directory of the API packages")
+	outDir := flag.String("out", "mocks", "output directory of the mocks")
+	flag.Parse()
+
+	dirs, err := filepath.Glob(filepath.Join(*apisDir, "*"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	// later versions of an API are in subpackages like apis/finances/v2024
+	versionDirs, err := filepath.Glob(filepath.Join(*apisDir, "*", "v*"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, dir := range append(dirs, versionDirs...) {
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			continue
+		}
+		rel, err := filepath.Rel(*apisDir, dir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		src, err := generate(dir, filepath.ToSlash(rel))
+		if err != nil {
+			log.Fatalf("generating mock of %s failed: %v", dir, err)
+		}
+		if src == nil {
+			continue
+		}
+		fileName := strings.ReplaceAll(filepath.ToSlash(rel), "/", "_") + ".go"
+		if err = os.WriteFile(filepath.Join(*outDir, fileName), src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// generate returns the source of the mock of the Client interface in dir, or nil if there is none.
+// rel is the path of dir below apis, e.g. "orders" or "finances/v2024".
+func generate(dir, rel string) ([]byte, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for pkgName, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			iface := findClientInterface(file)
+			if iface == nil {
+				continue
+			}
+			// versioned packages share the name of the original one, e.g. finances, so their
+			// mocks import them as financesv2024 and are named FinancesV2024Client
+			alias := pkgName
+			if _, version, ok := strings.Cut(rel, "/"); ok {
+				alias += version
+			}
+			g := &generator{
+				pkgName:  alias,
+				mockName: mockName(rel),
+				imports:  fileImports(file),
+				used:     map[string]string{alias: modulePath + "/apis/" + rel},
+			}
+			return g.mock(fset, iface)
+		}
+	}
+	return nil, nil
+}
+
+func findClientInterface(file *ast.File) *ast.InterfaceType {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			if iface, ok := typeSpec.Type.(*ast.InterfaceType); ok && typeSpec.Name.Name == "Client" {
+				return iface
+			}
+		}
+	}
+	return nil
+}
+
+// fileImports maps the package names used in the file to their import paths.
+func fileImports(file *ast.File) map[string]string {
+	imports := map[string]string{}
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = path
+	}
+	return imports
+}
+
+// mockName returns the name of the mock of the package at rel, e.g. FinancesV2024Client for
+// "finances/v2024".
+func mockName(rel string) string {
+	var name strings.Builder
+	for _, element := range strings.Split(rel, "/") {
+		name.WriteString(strings.ToUpper(element[:1]) + element[1:])
+	}
+	return name.String() + "Client"
+}
+
+type generator struct {
+	// pkgName is the name the mock imports the API package with.
+	pkgName  string
+	mockName string
+	imports  map[string]string
+	// used are the imports of the mock by package name.
+	used map[string]string
+}
+
+func (g *generator) mock(fset *token.FileSet, iface *ast.InterfaceType) ([]byte, error) {
+	mockName := g.mockName
+
+	var fields, methods bytes.Buffer
+	for _, method := range iface.Methods.List {
+		name := method.Names[0].Name
+		funcType := g.qualify(method.Type).(*ast.FuncType)
+		params, args := g.params(funcType)
+		signature := g.print(fset, &ast.FuncType{Params: params, Results: funcType.Results})
+		signature = strings.TrimPrefix(signature, "func")
+
+		fmt.Fprintf(&fields, "\t%sFunc func%s\n", name, signature)
+		fmt.Fprintf(&methods, "\nfunc (m *%s) %s%s {\n", mockName, name, signature)
+		fmt.Fprintf(&methods, "\tif m.%sFunc == nil {\n\t\tpanic(\"mocks: %s.%s called without %sFunc\")\n\t}\n", name, mockName, name, name)
+		if funcType.Results == nil || len(funcType.Results.List) == 0 {
+			fmt.Fprintf(&methods, "\tm.%sFunc(%s)\n}\n", name, args)
+		} else {
+			fmt.Fprintf(&methods, "\treturn m.%sFunc(%s)\n}\n", name, args)
+		}
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Code generated by internal/mockgen. DO NOT EDIT.\n\npackage mocks\n\nimport (\n")
+	var std, others []string
+	names := map[string]string{}
+	for name, path := range g.used {
+		names[path] = name
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			others = append(others, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+	for _, path := range std {
+		fmt.Fprintf(&src, "\t%q\n", path)
+	}
+	src.WriteString("\n")
+	for _, path := range others {
+		if name := names[path]; name != path[strings.LastIndex(path, "/")+1:] {
+			fmt.Fprintf(&src, "\t%s %q\n", name, path)
+		} else {
+			fmt.Fprintf(&src, "\t%q\n", path)
+		}
+	}
+	src.WriteString(")\n\n")
+	fmt.Fprintf(&src, "// %s mocks %s.Client. Calling a method without its function panics.\n", mockName, g.pkgName)
+	fmt.Fprintf(&src, "type %s struct {\n%s}\n\nvar _ %s.Client = (*%s)(nil)\n", mockName, fields.String(), g.pkgName, mockName)
+	src.Write(methods.Bytes())
+	return format.Source(src.Bytes())
+}
+
+// params names unnamed parameters and returns them with the arguments to pass them on.
+func (g *generator) params(funcType *ast.FuncType) (*ast.FieldList, string) {
+	var args []string
+	params := &ast.FieldList{}
+	for i, field := range funcType.Params.List {
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+		}
+		for _, name := range names {
+			arg := name.Name
+			if _, variadic := field.Type.(*ast.Ellipsis); variadic {
+				arg += "..."
+			}
+			args = append(args, arg)
+		}
+		params.List = append(params.List, &ast.Field{Names: names, Type: field.Type})
+	}
+	return params, strings.Join(args, ", ")
+}
+
+// qualify returns the type expression with the types of the API package qualified by its name and
+// records the packages used.
+func (g *generator) qualify(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if types.Universe.Lookup(e.Name) != nil {
+			return e
+		}
+		return &ast.SelectorExpr{X: ast.NewIdent(g.pkgName), Sel: ast.NewIdent(e.Name)}
+	case *ast.SelectorExpr:
+		pkg := e.X.(*ast.Ident).Name
+		g.used[pkg] = g.imports[pkg]
+		return e
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: g.qualify(e.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: g.qualify(e.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: g.qualify(e.Key), Value: g.qualify(e.Value)}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: e.Dir, Value: g.qualify(e.Value)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: g.qualify(e.Elt)}
+	case *ast.IndexExpr:
+		return &ast.IndexExpr{X: g.qualify(e.X), Index: g.qualify(e.Index)}
+	case *ast.IndexListExpr:
+		indices := make([]ast.Expr, len(e.Indices))
+		for i, index := range e.Indices {
+			indices[i] = g.qualify(index)
+		}
+		return &ast.IndexListExpr{X: g.qualify(e.X), Indices: indices}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: g.qualifyFields(e.Params), Results: g.qualifyFields(e.Results)}
+	}
+	return expr
+}
+
+func (g *generator) qualifyFields(fields *ast.FieldList) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+	qualified := &ast.FieldList{}
+	for _, field := range fields.List {
+		qualified.List = append(qualified.List, &ast.Field{Names: field.Names, Type: g.qualify(field.Type)})
+	}
+	return qualified
+}
+
+func (g *generator) print(fset *token.FileSet, node ast.Node) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, node); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
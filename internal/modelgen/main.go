@@ -0,0 +1,539 @@
+// Command modelgen generates the model structs of an API package from the Swagger spec Amazon
+// publishes in github.com/amzn/selling-partner-api-models. Each generated package has a
+// modelgen.json next to its go:generate directive:
+//
+//	{
+//		"source": "https://raw.githubusercontent.com/amzn/selling-partner-api-models/main/models/sellers-api-model/sellers.json",
+//		"spec": "sellers.json",
+//		"types": {"Error": "apis.Error", "Marketplace.id": "constants.MarketplaceID"}
+//	}
+//
+// spec is the local copy of the spec at source, which -fetch updates before generating. types
+// replaces the Go type of a definition or of a property of a definition, e.g. to use the shared
+// apis.Error or the typed IDs of package constants. Definitions replaced by another type are not
+// generated.
+//
+//	//go:generate go run ../../internal/modelgen -config modelgen.json -out model.go
+//	go run ./internal/modelgen -config apis/sellers/modelgen.json -out apis/sellers/model.go -fetch
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const modulePath = "github.com/fond-of-vertigo/amazon-sp-api"
+
+// packagePaths are the import paths of the packages which the types of a config may refer to.
+var packagePaths = map[string]string{
+	"apis":      modulePath + "/apis",
+	"constants": modulePath + "/constants",
+	"json":      "encoding/json",
+	"time":      "time",
+}
+
+// config is the modelgen.json of a package.
+type config struct {
+	// Source is the URL of the spec published by Amazon.
+	Source string `json:"source"`
+	// Spec is the path of the local copy of the spec, relative to the config.
+	Spec string `json:"spec"`
+	// Types replaces the Go types of definitions ("Error") or properties ("Marketplace.id").
+	Types map[string]string `json:"types"`
+}
+
+func main() {
+	configPath := flag.String("config", "modelgen.json", "modelgen.json of the package")
+	out := flag.String("out", "model.go", "output file of the models")
+	pkgName := flag.String("package", os.Getenv("GOPACKAGE"), "package name, defaults to the package of go:generate")
+	fetch := flag.Bool("fetch", false, "download the spec from its source before generating")
+	flag.Parse()
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	specPath := filepath.Join(filepath.Dir(*configPath), cfg.Spec)
+	if *fetch {
+		if err = download(cfg.Source, specPath); err != nil {
+			log.Fatalf("fetching %s failed: %v", cfg.Source, err)
+		}
+	}
+	spec, err := os.ReadFile(specPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *pkgName == "" {
+		*pkgName = filepath.Base(filepath.Dir(*out))
+	}
+
+	src, err := generate(*pkgName, filepath.Base(cfg.Spec), spec, cfg.Types)
+	if err != nil {
+		log.Fatalf("generating models of %s failed: %v", specPath, err)
+	}
+	if err = os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func loadConfig(path string) (*config, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &config{}
+	if err = json.Unmarshal(content, cfg); err != nil {
+		return nil, fmt.Errorf("decoding %s failed: %w", path, err)
+	}
+	if cfg.Spec == "" {
+		return nil, fmt.Errorf("%s has no spec", path)
+	}
+	return cfg, nil
+}
+
+func download(url, path string) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0o644)
+}
+
+// schema is the subset of a Swagger 2.0 schema object modelgen supports.
+type schema struct {
+	Ref         string   `json:"$ref"`
+	Type        string   `json:"type"`
+	Format      string   `json:"format"`
+	Description string   `json:"description"`
+	Enum        []any    `json:"enum"`
+	Items       *schema  `json:"items"`
+	Required    []string `json:"required"`
+	AllOf       []schema `json:"allOf"`
+	// Properties are kept raw to generate the fields in the order of the spec.
+	Properties           json.RawMessage `json:"properties"`
+	AdditionalProperties json.RawMessage `json:"additionalProperties"`
+}
+
+type generator struct {
+	definitions map[string]*schema
+	types       map[string]string
+	// used are the import paths of the generated code.
+	used map[string]bool
+	// pending are the inline objects of properties, which are generated after their definition.
+	pending []namedSchema
+	out     bytes.Buffer
+}
+
+type namedSchema struct {
+	name   string
+	schema *schema
+}
+
+// generate returns the source of the models of the definitions of the spec.
+func generate(pkgName, specName string, spec []byte, types map[string]string) ([]byte, error) {
+	var doc struct {
+		Definitions json.RawMessage `json:"definitions"`
+	}
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		return nil, err
+	}
+	names, raw, err := orderedObject(doc.Definitions)
+	if err != nil {
+		return nil, fmt.Errorf("decoding definitions failed: %w", err)
+	}
+
+	g := &generator{definitions: map[string]*schema{}, types: types, used: map[string]bool{}}
+	for _, name := range names {
+		s := &schema{}
+		if err = json.Unmarshal(raw[name], s); err != nil {
+			return nil, fmt.Errorf("decoding definition %s failed: %w", name, err)
+		}
+		g.definitions[name] = s
+	}
+	for _, name := range names {
+		if err = g.definition(name, g.definitions[name]); err != nil {
+			return nil, err
+		}
+		for len(g.pending) > 0 {
+			inline := g.pending[0]
+			g.pending = g.pending[1:]
+			if err = g.definition(inline.name, inline.schema); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by internal/modelgen from %s. DO NOT EDIT.\n\npackage %s\n", specName, pkgName)
+	if len(g.used) > 0 {
+		paths := make([]string, 0, len(g.used))
+		for path := range g.used {
+			paths = append(paths, path)
+		}
+		sort.Slice(paths, func(i, j int) bool {
+			// standard library first
+			iStd, jStd := !strings.Contains(paths[i], "."), !strings.Contains(paths[j], ".")
+			if iStd != jStd {
+				return iStd
+			}
+			return paths[i] < paths[j]
+		})
+		src.WriteString("\nimport (\n")
+		for i, path := range paths {
+			if i > 0 && !strings.Contains(paths[i-1], ".") && strings.Contains(path, ".") {
+				src.WriteString("\n")
+			}
+			fmt.Fprintf(&src, "\t%q\n", path)
+		}
+		src.WriteString(")\n")
+	}
+	src.Write(g.out.Bytes())
+	return format.Source(src.Bytes())
+}
+
+// definition writes the type of a definition. Arrays and plain scalars are inlined where they are
+// referenced and don't get a type.
+func (g *generator) definition(name string, s *schema) error {
+	if _, replaced := g.types[name]; replaced {
+		return nil
+	}
+	switch {
+	case len(s.Enum) > 0:
+		g.enum(name, s)
+		return nil
+	case s.Type == "object" || s.Properties != nil || len(s.AllOf) > 0:
+		return g.object(name, s)
+	}
+	return nil
+}
+
+func (g *generator) enum(name string, s *schema) {
+	typeName := goTypeName(name)
+	comment(&g.out, "", typeName, s.Description)
+	fmt.Fprintf(&g.out, "type %s %s\n\nconst (\n", typeName, g.scalar(s))
+	for _, value := range s.Enum {
+		if str, ok := value.(string); ok {
+			fmt.Fprintf(&g.out, "\t%s%s %s = %q\n", typeName, goName(str), typeName, str)
+		} else {
+			fmt.Fprintf(&g.out, "\t%s%v %s = %v\n", typeName, value, typeName, value)
+		}
+	}
+	g.out.WriteString(")\n")
+}
+
+func (g *generator) object(name string, s *schema) error {
+	typeName := goTypeName(name)
+	var fields bytes.Buffer
+	for _, part := range s.AllOf {
+		if part.Ref != "" {
+			fmt.Fprintf(&fields, "\t%s\n", g.refType(part.Ref))
+			continue
+		}
+		if err := g.fields(&fields, name, &part); err != nil {
+			return err
+		}
+	}
+	if err := g.fields(&fields, name, s); err != nil {
+		return err
+	}
+	if fields.Len() == 0 && s.AdditionalProperties != nil {
+		comment(&g.out, "", typeName, s.Description)
+		fmt.Fprintf(&g.out, "type %s %s\n", typeName, g.mapType(name, s))
+		return nil
+	}
+	comment(&g.out, "", typeName, s.Description)
+	fmt.Fprintf(&g.out, "type %s struct {\n%s}\n", typeName, fields.String())
+	return nil
+}
+
+// fields writes the fields of the properties of s in the order of the spec.
+func (g *generator) fields(w *bytes.Buffer, definition string, s *schema) error {
+	if s.Properties == nil {
+		return nil
+	}
+	names, raw, err := orderedObject(s.Properties)
+	if err != nil {
+		return fmt.Errorf("decoding properties of %s failed: %w", definition, err)
+	}
+	required := map[string]bool{}
+	for _, name := range s.Required {
+		required[name] = true
+	}
+
+	for _, name := range names {
+		property := &schema{}
+		if err = json.Unmarshal(raw[name], property); err != nil {
+			return fmt.Errorf("decoding property %s.%s failed: %w", definition, name, err)
+		}
+		fieldName := goName(name)
+		goType, ok := g.types[definition+"."+name]
+		if ok {
+			g.use(goType)
+		} else {
+			goType = g.typeOf(goTypeName(definition)+fieldName, property)
+		}
+		tag := name
+		if !required[name] {
+			tag += ",omitempty"
+			if g.optionalAsPointer(property) && !ok {
+				goType = "*" + goType
+			}
+		}
+		if property.Description != "" {
+			comment(w, "\t", "", property.Description)
+		}
+		fmt.Fprintf(w, "\t%s %s `json:\"%s\"`\n", fieldName, goType, tag)
+	}
+	return nil
+}
+
+// typeOf returns the Go type of a property. Inline objects become types named after the definition
+// and the property.
+func (g *generator) typeOf(inlineName string, s *schema) string {
+	switch {
+	case s.Ref != "":
+		return g.refType(s.Ref)
+	case s.Type == "array":
+		if s.Items == nil {
+			return "[]any"
+		}
+		return "[]" + g.typeOf(inlineName, s.Items)
+	case s.Type == "object" || s.Properties != nil:
+		if s.Properties == nil {
+			return g.mapType(inlineName, s)
+		}
+		g.pending = append(g.pending, namedSchema{name: inlineName, schema: s})
+		return inlineName
+	}
+	return g.scalar(s)
+}
+
+// refType returns the Go type of the definition referenced by ref, e.g. "#/definitions/Error".
+func (g *generator) refType(ref string) string {
+	name := strings.TrimPrefix(ref, "#/definitions/")
+	if goType, ok := g.types[name]; ok {
+		g.use(goType)
+		return goType
+	}
+	s, ok := g.definitions[name]
+	if !ok {
+		return "any"
+	}
+	if len(s.Enum) == 0 && s.Type != "object" && s.Properties == nil && len(s.AllOf) == 0 {
+		// arrays and plain scalars are inlined
+		return g.typeOf(goTypeName(name), s)
+	}
+	return goTypeName(name)
+}
+
+func (g *generator) mapType(inlineName string, s *schema) string {
+	if len(s.AdditionalProperties) == 0 || string(s.AdditionalProperties) == "true" {
+		return "map[string]any"
+	}
+	values := &schema{}
+	if err := json.Unmarshal(s.AdditionalProperties, values); err != nil {
+		return "map[string]any"
+	}
+	return "map[string]" + g.typeOf(inlineName+"Value", values)
+}
+
+func (g *generator) scalar(s *schema) string {
+	switch s.Type {
+	case "string":
+		if s.Format == "date-time" {
+			g.used["time"] = true
+			return "time.Time"
+		}
+		return "string"
+	case "integer":
+		if s.Format == "int64" {
+			return "int64"
+		}
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	}
+	return "any"
+}
+
+// optionalAsPointer reports whether an optional property is a pointer, so that absent values can be
+// told apart from zero values and are omitted when encoding. Strings, slices and maps aren't.
+func (g *generator) optionalAsPointer(s *schema) bool {
+	if s.Ref != "" {
+		name := strings.TrimPrefix(s.Ref, "#/definitions/")
+		if goType, ok := g.types[name]; ok {
+			return !strings.HasPrefix(goType, "[]") && !strings.HasPrefix(goType, "map[")
+		}
+		if def, ok := g.definitions[name]; ok {
+			if len(def.Enum) > 0 {
+				return def.Type != "string"
+			}
+			return g.optionalAsPointer(def)
+		}
+		return true
+	}
+	switch s.Type {
+	case "string":
+		return s.Format == "date-time"
+	case "array", "":
+		return s.Type == "" && s.Properties != nil
+	case "object":
+		return s.Properties != nil
+	}
+	return true
+}
+
+// use records the import of the package of a type like "apis.Error".
+func (g *generator) use(goType string) {
+	goType = strings.TrimLeft(goType, "*[]")
+	if pkg, _, ok := strings.Cut(goType, "."); ok {
+		if path, known := packagePaths[pkg]; known {
+			g.used[path] = true
+		}
+	}
+}
+
+// comment writes the description as comment. Doc comments of types are prefixed with the type
+// name like the hand-written models, types without description get none.
+func comment(w *bytes.Buffer, indent, typeName, description string) {
+	description = strings.TrimSpace(description)
+	if typeName != "" {
+		w.WriteString("\n")
+		if description == "" {
+			return
+		}
+		description = typeName + " " + description
+	}
+	for _, line := range strings.Split(description, "\n") {
+		fmt.Fprintf(w, "%s// %s\n", indent, strings.TrimRightFunc(line, unicode.IsSpace))
+	}
+}
+
+// orderedObject returns the keys of a JSON object in their order and the raw values.
+func orderedObject(raw json.RawMessage) ([]string, map[string]json.RawMessage, error) {
+	values := map[string]json.RawMessage{}
+	if len(raw) == 0 {
+		return nil, values, nil
+	}
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if _, err := dec.Token(); err != nil {
+		return nil, nil, err
+	}
+	var keys []string
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, token.(string))
+		var skip json.RawMessage
+		if err = dec.Decode(&skip); err != nil {
+			return nil, nil, err
+		}
+	}
+	return keys, values, nil
+}
+
+// initialisms are written in upper case in Go names, e.g. "marketplaceIds" becomes "MarketplaceIDs".
+var initialisms = map[string]bool{
+	"API": true, "ASIN": true, "EAN": true, "FNSKU": true, "GTIN": true, "HTTP": true, "ID": true,
+	"ISBN": true, "JSON": true, "SKU": true, "UPC": true, "URL": true, "UUID": true, "VAT": true,
+}
+
+// goName converts a property name or enum value like "marketplaceIds" or "IN_PROGRESS" to an
+// exported Go name like "MarketplaceIDs" or "InProgress".
+func goName(name string) string {
+	var b strings.Builder
+	words := splitWords(name)
+	for _, word := range words {
+		upper := strings.ToUpper(word)
+		switch {
+		case initialisms[upper]:
+			b.WriteString(upper)
+		case len(word) > 1 && strings.HasSuffix(word, "s") && initialisms[strings.ToUpper(word[:len(word)-1])]:
+			b.WriteString(strings.ToUpper(word[:len(word)-1]) + "s")
+		case word == upper && len(words) == 1 && len(word) <= 3:
+			// abbreviations like "AFN"
+			b.WriteString(word)
+		case word == upper:
+			// SCREAMING_CASE enum values
+			b.WriteString(word[:1] + strings.ToLower(word[1:]))
+		default:
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	if b.Len() == 0 || unicode.IsDigit(rune(b.String()[0])) {
+		return "V" + b.String()
+	}
+	return b.String()
+}
+
+// goTypeName keeps the definition names of the spec, only dropping characters Go doesn't allow.
+func goTypeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return r
+		}
+		return -1
+	}, name)
+}
+
+// splitWords splits at non-alphanumeric characters and at lower to upper case transitions.
+func splitWords(name string) []string {
+	var words []string
+	var word []rune
+	runes := []rune(name)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if len(word) > 0 {
+				words = append(words, string(word))
+				word = nil
+			}
+			continue
+		}
+		if unicode.IsUpper(r) && len(word) > 0 && (unicode.IsLower(word[len(word)-1]) ||
+			(unicode.IsUpper(word[len(word)-1]) && startsWord(runes[i+1:]))) {
+			words = append(words, string(word))
+			word = nil
+		}
+		word = append(word, r)
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+	return words
+}
+
+// startsWord reports whether the lower case letters of rest continue a word starting with the
+// previous upper case letter, e.g. "tatus" of "HTTPStatus" but not the plural "s" of "ASINs".
+func startsWord(rest []rune) bool {
+	if len(rest) == 0 || !unicode.IsLower(rest[0]) {
+		return false
+	}
+	return !(rest[0] == 's' && (len(rest) == 1 || !unicode.IsLower(rest[1])))
+}
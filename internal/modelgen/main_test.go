@@ -0,0 +1,135 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerate_UpToDate fails if the spec or config of a generated package changed without running go generate.
+func TestGenerate_UpToDate(t *testing.T) {
+	configs, err := filepath.Glob("../../apis/*/modelgen.json")
+	require.NoError(t, err)
+	require.NotEmpty(t, configs)
+	versionConfigs, err := filepath.Glob("../../apis/*/v*/modelgen.json")
+	require.NoError(t, err)
+
+	for _, configPath := range append(configs, versionConfigs...) {
+		dir := filepath.Dir(configPath)
+		rel, err := filepath.Rel("../../apis", dir)
+		require.NoError(t, err)
+		rel = filepath.ToSlash(rel)
+		// versioned packages like apis/finances/v2024 are named after the API
+		pkgName, _, _ := strings.Cut(rel, "/")
+		t.Run(rel, func(t *testing.T) {
+			cfg, err := loadConfig(configPath)
+			require.NoError(t, err)
+			spec, err := os.ReadFile(filepath.Join(dir, cfg.Spec))
+			require.NoError(t, err)
+			want, err := os.ReadFile(filepath.Join(dir, "model.go"))
+			require.NoError(t, err)
+
+			got, err := generate(pkgName, cfg.Spec, spec, cfg.Types)
+			require.NoError(t, err)
+			assert.Equal(t, string(want), string(got), "run go generate ./apis/%s", rel)
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	spec := `{
+		"definitions": {
+			"Shipment": {
+				"description": "A shipment.",
+				"type": "object",
+				"required": ["shipmentId", "status"],
+				"properties": {
+					"shipmentId": {"type": "string"},
+					"status": {"$ref": "#/definitions/ShipmentStatus"},
+					"items": {"$ref": "#/definitions/ItemList"},
+					"createdAt": {"description": "Created at.\nIn UTC.", "type": "string", "format": "date-time"},
+					"quantity": {"type": "integer", "format": "int64"},
+					"isPartial": {"type": "boolean"},
+					"dimensions": {"type": "object", "properties": {"weight": {"type": "number"}}},
+					"attributes": {"type": "object", "additionalProperties": {"type": "string"}},
+					"error": {"$ref": "#/definitions/Error"}
+				}
+			},
+			"ShipmentStatus": {"type": "string", "enum": ["IN_TRANSIT", "Delivered"]},
+			"ItemList": {"type": "array", "items": {"$ref": "#/definitions/Item"}},
+			"Item": {"allOf": [{"$ref": "#/definitions/Shipment"}, {"properties": {"sku": {"type": "string"}}}]},
+			"Error": {"type": "object"}
+		}
+	}`
+
+	got, err := generate("shipping", "shipping.json", []byte(spec), map[string]string{"Error": "apis.Error"})
+
+	require.NoError(t, err)
+	want := `// Code generated by internal/modelgen from shipping.json. DO NOT EDIT.
+
+package shipping
+
+import (
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+)
+
+// Shipment A shipment.
+type Shipment struct {
+	ShipmentID string         ` + "`json:\"shipmentId\"`" + `
+	Status     ShipmentStatus ` + "`json:\"status\"`" + `
+	Items      []Item         ` + "`json:\"items,omitempty\"`" + `
+	// Created at.
+	// In UTC.
+	CreatedAt  *time.Time          ` + "`json:\"createdAt,omitempty\"`" + `
+	Quantity   *int64              ` + "`json:\"quantity,omitempty\"`" + `
+	IsPartial  *bool               ` + "`json:\"isPartial,omitempty\"`" + `
+	Dimensions *ShipmentDimensions ` + "`json:\"dimensions,omitempty\"`" + `
+	Attributes map[string]string   ` + "`json:\"attributes,omitempty\"`" + `
+	Error      *apis.Error         ` + "`json:\"error,omitempty\"`" + `
+}
+
+type ShipmentDimensions struct {
+	Weight *float64 ` + "`json:\"weight,omitempty\"`" + `
+}
+
+type ShipmentStatus string
+
+const (
+	ShipmentStatusInTransit ShipmentStatus = "IN_TRANSIT"
+	ShipmentStatusDelivered ShipmentStatus = "Delivered"
+)
+
+type Item struct {
+	Shipment
+	SKU string ` + "`json:\"sku,omitempty\"`" + `
+}
+`
+	assert.Equal(t, want, string(got))
+}
+
+func TestGoName(t *testing.T) {
+	for name, want := range map[string]string{
+		"marketplaceIds":    "MarketplaceIDs",
+		"id":                "ID",
+		"IN_PROGRESS":       "InProgress",
+		"storeName":         "StoreName",
+		"HTTPStatusCode":    "HTTPStatusCode",
+		"sellerSku":         "SellerSKU",
+		"3PL":               "V3PL",
+		"fulfillment-type":  "FulfillmentType",
+		"ASINs":             "ASINs",
+		"AFN":               "AFN",
+		"DONE":              "Done",
+		"hasSuspendedItems": "HasSuspendedItems",
+	} {
+		if got := goName(name); got != want {
+			t.Errorf("goName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+// Package sandbox contains the contract tests of the APIs against the static SP-API sandbox. They
+// are built with the sandbox tag only and need the LWA credentials of an application:
+//
+//	SPAPI_CLIENT_ID=... SPAPI_CLIENT_SECRET=... SPAPI_REFRESH_TOKEN=... go test -tags sandbox ./internal/sandbox
+//
+// SPAPI_SANDBOX_ENDPOINT selects another sandbox endpoint than constants.SandboxNorthAmerica.
+// The tests decode every response strictly, so fields Amazon added to a schema fail the test
+// until the model is updated.
+package sandbox
@@ -0,0 +1,189 @@
+//go:build sandbox
+
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"testing"
+
+	sp_api "github.com/fond-of-vertigo/amazon-sp-api"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/feeds"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/orders"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/reports"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+// bodyRecorder keeps the last response body of the sandbox for the strict decoding.
+type bodyRecorder struct {
+	host string
+	mu   sync.Mutex
+	last []byte
+}
+
+func (b *bodyRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil || req.URL.Host != b.host {
+		return resp, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.last = body
+	return resp, nil
+}
+
+func (b *bodyRecorder) lastBody() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.last
+}
+
+func newSandboxClient(t *testing.T) (*sp_api.Client, *bodyRecorder) {
+	t.Helper()
+	config := sp_api.Config{
+		ClientID:           os.Getenv("SPAPI_CLIENT_ID"),
+		ClientSecret:       os.Getenv("SPAPI_CLIENT_SECRET"),
+		RefreshToken:       os.Getenv("SPAPI_REFRESH_TOKEN"),
+		Endpoint:           constants.Endpoint(os.Getenv("SPAPI_SANDBOX_ENDPOINT")),
+		DisableCompression: true,
+	}
+	if config.ClientID == "" || config.ClientSecret == "" || config.RefreshToken == "" {
+		t.Skip("SPAPI_CLIENT_ID, SPAPI_CLIENT_SECRET and SPAPI_REFRESH_TOKEN are required for the sandbox tests")
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = constants.SandboxNorthAmerica
+	}
+	endpoint, err := url.Parse(string(config.Endpoint))
+	if err != nil {
+		t.Fatalf("invalid SPAPI_SANDBOX_ENDPOINT: %v", err)
+	}
+	recorder := &bodyRecorder{host: endpoint.Host}
+	config.Transport = recorder
+
+	client, err := sp_api.NewClient(config)
+	if err != nil {
+		t.Fatalf("creating client failed: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := client.Close(context.Background()); err != nil {
+			t.Errorf("closing client failed: %v", err)
+		}
+	})
+	return client, recorder
+}
+
+// checkDecoding calls the operation and decodes its response body again into T, failing on fields
+// missing in the model.
+func checkDecoding[T any](t *testing.T, recorder *bodyRecorder, call func(ctx context.Context) (*apis.CallResponse[T], error)) {
+	t.Helper()
+	resp, err := call(context.Background())
+	if err != nil {
+		t.Fatalf("call failed: %v", err)
+	}
+	if resp.ResponseBody == nil {
+		t.Fatal("response body was not decoded")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(recorder.lastBody()))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(new(T)); err != nil {
+		t.Errorf("response does not match the model: %v\n%s", err, recorder.lastBody())
+	}
+}
+
+func TestOrders(t *testing.T) {
+	client, recorder := newSandboxClient(t)
+	const orderID = "TEST_CASE_200"
+
+	t.Run("GetOrder", func(t *testing.T) {
+		checkDecoding(t, recorder, func(ctx context.Context) (*apis.CallResponse[orders.GetOrderResponse], error) {
+			return client.OrdersAPI.GetOrder(ctx, orderID, nil)
+		})
+	})
+	t.Run("GetOrderBuyerInfo", func(t *testing.T) {
+		checkDecoding(t, recorder, func(ctx context.Context) (*apis.CallResponse[orders.GetOrderBuyerInfoResponse], error) {
+			return client.OrdersAPI.GetOrderBuyerInfo(ctx, orderID)
+		})
+	})
+	t.Run("GetOrderAddress", func(t *testing.T) {
+		checkDecoding(t, recorder, func(ctx context.Context) (*apis.CallResponse[orders.GetOrderAddressResponse], error) {
+			return client.OrdersAPI.GetOrderAddress(ctx, orderID, nil)
+		})
+	})
+	t.Run("GetOrderItems", func(t *testing.T) {
+		checkDecoding(t, recorder, func(ctx context.Context) (*apis.CallResponse[orders.GetOrderItemsResponse], error) {
+			return client.OrdersAPI.GetOrderItems(ctx, orderID, nil, nil)
+		})
+	})
+}
+
+func TestReports(t *testing.T) {
+	client, recorder := newSandboxClient(t)
+
+	t.Run("GetReports", func(t *testing.T) {
+		filter := (&reports.GetReportsFilter{}).
+			WithReportTypes("FEE_DISCOUNTS_REPORT", "GET_AFN_INVENTORY_DATA").
+			WithProcessingStatuses(constants.InQueue, constants.InProgress)
+		checkDecoding(t, recorder, func(ctx context.Context) (*apis.CallResponse[reports.GetReportsResponse], error) {
+			return client.ReportsAPI.GetReports(ctx, filter)
+		})
+	})
+	t.Run("GetReport", func(t *testing.T) {
+		checkDecoding(t, recorder, func(ctx context.Context) (*apis.CallResponse[reports.GetReportResponse], error) {
+			return client.ReportsAPI.GetReport(ctx, "ID323")
+		})
+	})
+	t.Run("GetReportSchedule", func(t *testing.T) {
+		checkDecoding(t, recorder, func(ctx context.Context) (*apis.CallResponse[reports.GetReportScheduleResponse], error) {
+			return client.ReportsAPI.GetReportSchedule(ctx, "ID323")
+		})
+	})
+	t.Run("GetReportDocument", func(t *testing.T) {
+		checkDecoding(t, recorder, func(ctx context.Context) (*apis.CallResponse[reports.GetReportDocumentResponse], error) {
+			return client.ReportsAPI.GetReportDocument(ctx, "0356cf79-b8b0-4226-b4b9-0ee058ea5760", nil)
+		})
+	})
+}
+
+func TestFeeds(t *testing.T) {
+	client, recorder := newSandboxClient(t)
+
+	t.Run("GetFeeds", func(t *testing.T) {
+		filter := &feeds.GetFeedsRequestFilter{
+			FeedTypes:          []string{"POST_PRODUCT_DATA"},
+			ProcessingStatuses: []feeds.ProcessingStatus{feeds.ProcessingStatusCanceled, feeds.ProcessingStatusDone},
+		}
+		checkDecoding(t, recorder, func(ctx context.Context) (*apis.CallResponse[feeds.GetFeedsResponse], error) {
+			return client.FeedsAPI.GetFeeds(ctx, filter)
+		})
+	})
+	t.Run("GetFeed", func(t *testing.T) {
+		checkDecoding(t, recorder, func(ctx context.Context) (*apis.CallResponse[feeds.Feed], error) {
+			return client.FeedsAPI.GetFeed(ctx, "feedId1")
+		})
+	})
+	t.Run("CreateFeedDocument", func(t *testing.T) {
+		checkDecoding(t, recorder, func(ctx context.Context) (*apis.CallResponse[feeds.CreateFeedDocumentResponse], error) {
+			return client.FeedsAPI.CreateFeedDocument(ctx, &feeds.CreateFeedDocumentSpecification{ContentType: "text/xml; charset=UTF-8"})
+		})
+	})
+	t.Run("GetFeedDocument", func(t *testing.T) {
+		checkDecoding(t, recorder, func(ctx context.Context) (*apis.CallResponse[feeds.FeedDocument], error) {
+			return client.FeedsAPI.GetFeedDocument(ctx, "0356cf79-b8b0-4226-b4b9-0ee058ea5760")
+		})
+	})
+}
@@ -0,0 +1,110 @@
+// Package marketplaces describes the Amazon marketplaces served by SP-API with their country,
+// currency, locale and endpoint.
+package marketplaces
+
+import (
+	"strings"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+// Marketplace is an Amazon marketplace.
+type Marketplace struct {
+	ID constants.MarketplaceID
+	// Name is the English name of the marketplace's country.
+	Name string
+	// CountryCode is the ISO 3166-1 alpha-2 code of the marketplace's country, e.g. "GB".
+	CountryCode string
+	// Currency is the ISO 4217 code of the currency of the prices, e.g. "GBP".
+	Currency string
+	// Locale is the default locale of the marketplace, e.g. "en_GB".
+	Locale string
+	// Domain is the domain of the marketplace's website, e.g. "amazon.co.uk".
+	Domain   string
+	Endpoint constants.Endpoint
+}
+
+var (
+	Canada                = newMarketplace(constants.Canada, "Canada", "CA", "CAD", "en_CA", "amazon.ca")
+	UnitedStatesOfAmerica = newMarketplace(constants.UnitedStatesOfAmerica, "United States of America", "US", "USD", "en_US", "amazon.com")
+	Mexico                = newMarketplace(constants.Mexico, "Mexico", "MX", "MXN", "es_MX", "amazon.com.mx")
+	Brazil                = newMarketplace(constants.Brazil, "Brazil", "BR", "BRL", "pt_BR", "amazon.com.br")
+	Spain                 = newMarketplace(constants.Spain, "Spain", "ES", "EUR", "es_ES", "amazon.es")
+	UnitedKingdom         = newMarketplace(constants.UnitedKingdom, "United Kingdom", "GB", "GBP", "en_GB", "amazon.co.uk")
+	France                = newMarketplace(constants.France, "France", "FR", "EUR", "fr_FR", "amazon.fr")
+	Belgium               = newMarketplace(constants.Belgium, "Belgium", "BE", "EUR", "fr_BE", "amazon.com.be")
+	Netherlands           = newMarketplace(constants.Netherlands, "Netherlands", "NL", "EUR", "nl_NL", "amazon.nl")
+	Germany               = newMarketplace(constants.Germany, "Germany", "DE", "EUR", "de_DE", "amazon.de")
+	Italy                 = newMarketplace(constants.Italy, "Italy", "IT", "EUR", "it_IT", "amazon.it")
+	Sweden                = newMarketplace(constants.Sweden, "Sweden", "SE", "SEK", "sv_SE", "amazon.se")
+	Poland                = newMarketplace(constants.Poland, "Poland", "PL", "PLN", "pl_PL", "amazon.pl")
+	Ireland               = newMarketplace(constants.Ireland, "Ireland", "IE", "EUR", "en_IE", "amazon.ie")
+	SouthAfrica           = newMarketplace(constants.SouthAfrica, "South Africa", "ZA", "ZAR", "en_ZA", "amazon.co.za")
+	Egypt                 = newMarketplace(constants.Egypt, "Egypt", "EG", "EGP", "ar_EG", "amazon.eg")
+	Turkey                = newMarketplace(constants.Turkey, "Turkey", "TR", "TRY", "tr_TR", "amazon.com.tr")
+	SaudiArabia           = newMarketplace(constants.SaudiArabia, "Saudi Arabia", "SA", "SAR", "ar_SA", "amazon.sa")
+	UnitedArabEmirates    = newMarketplace(constants.UnitedArabEmirates, "United Arab Emirates", "AE", "AED", "ar_AE", "amazon.ae")
+	India                 = newMarketplace(constants.India, "India", "IN", "INR", "en_IN", "amazon.in")
+	Singapore             = newMarketplace(constants.Singapore, "Singapore", "SG", "SGD", "en_SG", "amazon.sg")
+	Australia             = newMarketplace(constants.Australia, "Australia", "AU", "AUD", "en_AU", "amazon.com.au")
+	Japan                 = newMarketplace(constants.Japan, "Japan", "JP", "JPY", "ja_JP", "amazon.co.jp")
+)
+
+var all = []Marketplace{
+	Canada, UnitedStatesOfAmerica, Mexico, Brazil,
+	Spain, UnitedKingdom, France, Belgium, Netherlands, Germany, Italy, Sweden, Poland, Ireland,
+	SouthAfrica, Egypt, Turkey, SaudiArabia, UnitedArabEmirates, India,
+	Singapore, Australia, Japan,
+}
+
+func newMarketplace(id constants.MarketplaceID, name, countryCode, currency, locale, domain string) Marketplace {
+	return Marketplace{
+		ID:          id,
+		Name:        name,
+		CountryCode: countryCode,
+		Currency:    currency,
+		Locale:      locale,
+		Domain:      domain,
+		Endpoint:    id.Endpoint(),
+	}
+}
+
+// All returns all marketplaces, grouped by endpoint.
+func All() []Marketplace {
+	return append([]Marketplace(nil), all...)
+}
+
+// ByID returns the marketplace with the ID.
+func ByID(id constants.MarketplaceID) (Marketplace, bool) {
+	for _, m := range all {
+		if m.ID == id {
+			return m, true
+		}
+	}
+	return Marketplace{}, false
+}
+
+// ByCountryCode returns the marketplace of the country, ignoring case. Besides "GB", the code
+// "UK" used by Amazon's documentation selects the United Kingdom.
+func ByCountryCode(countryCode string) (Marketplace, bool) {
+	if strings.EqualFold(countryCode, "UK") {
+		return UnitedKingdom, true
+	}
+	for _, m := range all {
+		if strings.EqualFold(m.CountryCode, countryCode) {
+			return m, true
+		}
+	}
+	return Marketplace{}, false
+}
+
+// ByEndpoint returns the marketplaces served by the endpoint.
+func ByEndpoint(endpoint constants.Endpoint) []Marketplace {
+	var marketplaces []Marketplace
+	for _, m := range all {
+		if m.Endpoint == endpoint {
+			marketplaces = append(marketplaces, m)
+		}
+	}
+	return marketplaces
+}
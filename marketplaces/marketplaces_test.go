@@ -0,0 +1,52 @@
+package marketplaces
+
+import (
+	"testing"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+func TestAll(t *testing.T) {
+	seen := map[constants.MarketplaceID]bool{}
+	for _, m := range All() {
+		if seen[m.ID] {
+			t.Errorf("marketplace %s is listed twice", m.ID)
+		}
+		seen[m.ID] = true
+		if m.Endpoint == "" || m.CountryCode == "" || m.Currency == "" || m.Locale == "" || m.Domain == "" {
+			t.Errorf("marketplace %s is incomplete: %+v", m.ID, m)
+		}
+	}
+}
+
+func TestByID(t *testing.T) {
+	m, ok := ByID(constants.Germany)
+	if !ok || m.CountryCode != "DE" || m.Currency != "EUR" || m.Endpoint != constants.Europe {
+		t.Errorf("ByID(Germany) = %+v, %v", m, ok)
+	}
+	if _, ok = ByID("UNKNOWN"); ok {
+		t.Error("ByID(UNKNOWN) should not find a marketplace")
+	}
+}
+
+func TestByCountryCode(t *testing.T) {
+	for code, want := range map[string]constants.MarketplaceID{
+		"JP": constants.Japan,
+		"us": constants.UnitedStatesOfAmerica,
+		"GB": constants.UnitedKingdom,
+		"UK": constants.UnitedKingdom,
+	} {
+		if m, ok := ByCountryCode(code); !ok || m.ID != want {
+			t.Errorf("ByCountryCode(%s) = %s, %v, want %s", code, m.ID, ok, want)
+		}
+	}
+	if _, ok := ByCountryCode("XX"); ok {
+		t.Error("ByCountryCode(XX) should not find a marketplace")
+	}
+}
+
+func TestByEndpoint(t *testing.T) {
+	if got := len(ByEndpoint(constants.FarEast)); got != 3 {
+		t.Errorf("ByEndpoint(FarEast) returned %d marketplaces, want 3", got)
+	}
+}
@@ -0,0 +1,23 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/appmanagement"
+)
+
+// AppmanagementClient mocks appmanagement.Client. Calling a method without its function panics.
+type AppmanagementClient struct {
+	RotateApplicationClientSecretFunc func(ctx context.Context) error
+}
+
+var _ appmanagement.Client = (*AppmanagementClient)(nil)
+
+func (m *AppmanagementClient) RotateApplicationClientSecret(ctx context.Context) error {
+	if m.RotateApplicationClientSecretFunc == nil {
+		panic("mocks: AppmanagementClient.RotateApplicationClientSecret called without RotateApplicationClientSecretFunc")
+	}
+	return m.RotateApplicationClientSecretFunc(ctx)
+}
@@ -0,0 +1,65 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/datakiosk"
+)
+
+// DatakioskClient mocks datakiosk.Client. Calling a method without its function panics.
+type DatakioskClient struct {
+	GetQueriesFunc   func(ctx context.Context, filter *datakiosk.GetQueriesFilter) (*apis.CallResponse[datakiosk.GetQueriesResponse], error)
+	CreateQueryFunc  func(ctx context.Context, specification *datakiosk.CreateQuerySpecification) (*apis.CallResponse[datakiosk.CreateQueryResponse], error)
+	GetQueryFunc     func(ctx context.Context, queryID string) (*apis.CallResponse[datakiosk.Query], error)
+	CancelQueryFunc  func(ctx context.Context, queryID string) error
+	GetDocumentFunc  func(ctx context.Context, documentID string) (*apis.CallResponse[datakiosk.GetDocumentResponse], error)
+	OpenDocumentFunc func(ctx context.Context, documentID string) (io.ReadCloser, error)
+}
+
+var _ datakiosk.Client = (*DatakioskClient)(nil)
+
+func (m *DatakioskClient) GetQueries(ctx context.Context, filter *datakiosk.GetQueriesFilter) (*apis.CallResponse[datakiosk.GetQueriesResponse], error) {
+	if m.GetQueriesFunc == nil {
+		panic("mocks: DatakioskClient.GetQueries called without GetQueriesFunc")
+	}
+	return m.GetQueriesFunc(ctx, filter)
+}
+
+func (m *DatakioskClient) CreateQuery(ctx context.Context, specification *datakiosk.CreateQuerySpecification) (*apis.CallResponse[datakiosk.CreateQueryResponse], error) {
+	if m.CreateQueryFunc == nil {
+		panic("mocks: DatakioskClient.CreateQuery called without CreateQueryFunc")
+	}
+	return m.CreateQueryFunc(ctx, specification)
+}
+
+func (m *DatakioskClient) GetQuery(ctx context.Context, queryID string) (*apis.CallResponse[datakiosk.Query], error) {
+	if m.GetQueryFunc == nil {
+		panic("mocks: DatakioskClient.GetQuery called without GetQueryFunc")
+	}
+	return m.GetQueryFunc(ctx, queryID)
+}
+
+func (m *DatakioskClient) CancelQuery(ctx context.Context, queryID string) error {
+	if m.CancelQueryFunc == nil {
+		panic("mocks: DatakioskClient.CancelQuery called without CancelQueryFunc")
+	}
+	return m.CancelQueryFunc(ctx, queryID)
+}
+
+func (m *DatakioskClient) GetDocument(ctx context.Context, documentID string) (*apis.CallResponse[datakiosk.GetDocumentResponse], error) {
+	if m.GetDocumentFunc == nil {
+		panic("mocks: DatakioskClient.GetDocument called without GetDocumentFunc")
+	}
+	return m.GetDocumentFunc(ctx, documentID)
+}
+
+func (m *DatakioskClient) OpenDocument(ctx context.Context, documentID string) (io.ReadCloser, error) {
+	if m.OpenDocumentFunc == nil {
+		panic("mocks: DatakioskClient.OpenDocument called without OpenDocumentFunc")
+	}
+	return m.OpenDocumentFunc(ctx, documentID)
+}
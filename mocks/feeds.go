@@ -0,0 +1,81 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/feeds"
+)
+
+// FeedsClient mocks feeds.Client. Calling a method without its function panics.
+type FeedsClient struct {
+	GetFeedsFunc           func(ctx context.Context, filter *feeds.GetFeedsRequestFilter) (*apis.CallResponse[feeds.GetFeedsResponse], error)
+	FeedsPaginatorFunc     func(filter *feeds.GetFeedsRequestFilter) *apis.Paginator[feeds.Feed]
+	CreateFeedFunc         func(ctx context.Context, specification *feeds.CreateFeedSpecification) (*apis.CallResponse[feeds.CreateFeedResponse], error)
+	GetFeedFunc            func(ctx context.Context, feedID string) (*apis.CallResponse[feeds.Feed], error)
+	CancelFeedFunc         func(ctx context.Context, feedID string) error
+	CreateFeedDocumentFunc func(ctx context.Context, specification *feeds.CreateFeedDocumentSpecification) (*apis.CallResponse[feeds.CreateFeedDocumentResponse], error)
+	UploadFeedDocumentFunc func(ctx context.Context, document *feeds.CreateFeedDocumentResponse, contentType string, r io.Reader, contentLength int64) error
+	GetFeedDocumentFunc    func(ctx context.Context, feedDocumentID string) (*apis.CallResponse[feeds.FeedDocument], error)
+}
+
+var _ feeds.Client = (*FeedsClient)(nil)
+
+func (m *FeedsClient) GetFeeds(ctx context.Context, filter *feeds.GetFeedsRequestFilter) (*apis.CallResponse[feeds.GetFeedsResponse], error) {
+	if m.GetFeedsFunc == nil {
+		panic("mocks: FeedsClient.GetFeeds called without GetFeedsFunc")
+	}
+	return m.GetFeedsFunc(ctx, filter)
+}
+
+func (m *FeedsClient) FeedsPaginator(filter *feeds.GetFeedsRequestFilter) *apis.Paginator[feeds.Feed] {
+	if m.FeedsPaginatorFunc == nil {
+		panic("mocks: FeedsClient.FeedsPaginator called without FeedsPaginatorFunc")
+	}
+	return m.FeedsPaginatorFunc(filter)
+}
+
+func (m *FeedsClient) CreateFeed(ctx context.Context, specification *feeds.CreateFeedSpecification) (*apis.CallResponse[feeds.CreateFeedResponse], error) {
+	if m.CreateFeedFunc == nil {
+		panic("mocks: FeedsClient.CreateFeed called without CreateFeedFunc")
+	}
+	return m.CreateFeedFunc(ctx, specification)
+}
+
+func (m *FeedsClient) GetFeed(ctx context.Context, feedID string) (*apis.CallResponse[feeds.Feed], error) {
+	if m.GetFeedFunc == nil {
+		panic("mocks: FeedsClient.GetFeed called without GetFeedFunc")
+	}
+	return m.GetFeedFunc(ctx, feedID)
+}
+
+func (m *FeedsClient) CancelFeed(ctx context.Context, feedID string) error {
+	if m.CancelFeedFunc == nil {
+		panic("mocks: FeedsClient.CancelFeed called without CancelFeedFunc")
+	}
+	return m.CancelFeedFunc(ctx, feedID)
+}
+
+func (m *FeedsClient) CreateFeedDocument(ctx context.Context, specification *feeds.CreateFeedDocumentSpecification) (*apis.CallResponse[feeds.CreateFeedDocumentResponse], error) {
+	if m.CreateFeedDocumentFunc == nil {
+		panic("mocks: FeedsClient.CreateFeedDocument called without CreateFeedDocumentFunc")
+	}
+	return m.CreateFeedDocumentFunc(ctx, specification)
+}
+
+func (m *FeedsClient) UploadFeedDocument(ctx context.Context, document *feeds.CreateFeedDocumentResponse, contentType string, r io.Reader, contentLength int64) error {
+	if m.UploadFeedDocumentFunc == nil {
+		panic("mocks: FeedsClient.UploadFeedDocument called without UploadFeedDocumentFunc")
+	}
+	return m.UploadFeedDocumentFunc(ctx, document, contentType, r, contentLength)
+}
+
+func (m *FeedsClient) GetFeedDocument(ctx context.Context, feedDocumentID string) (*apis.CallResponse[feeds.FeedDocument], error) {
+	if m.GetFeedDocumentFunc == nil {
+		panic("mocks: FeedsClient.GetFeedDocument called without GetFeedDocumentFunc")
+	}
+	return m.GetFeedDocumentFunc(ctx, feedDocumentID)
+}
@@ -0,0 +1,48 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/finances"
+)
+
+// FinancesClient mocks finances.Client. Calling a method without its function panics.
+type FinancesClient struct {
+	ListFinancialEventGroupsFunc     func(ctx context.Context, filter *finances.ListFinancialEventGroupsFilter) (*apis.CallResponse[finances.ListFinancialEventGroupsResponse], error)
+	ListFinancialEventsByGroupIDFunc func(ctx context.Context, eventGroupID string, filter *finances.ListFinancialEventsByIDFilter) (*apis.CallResponse[finances.ListFinancialEventsResponse], error)
+	ListFinancialEventsByOrderIDFunc func(ctx context.Context, orderID string, filter *finances.ListFinancialEventsByIDFilter) (*apis.CallResponse[finances.ListFinancialEventsResponse], error)
+	ListFinancialEventsFunc          func(ctx context.Context, filter *finances.ListFinancialEventsFilter) (*apis.CallResponse[finances.ListFinancialEventsResponse], error)
+}
+
+var _ finances.Client = (*FinancesClient)(nil)
+
+func (m *FinancesClient) ListFinancialEventGroups(ctx context.Context, filter *finances.ListFinancialEventGroupsFilter) (*apis.CallResponse[finances.ListFinancialEventGroupsResponse], error) {
+	if m.ListFinancialEventGroupsFunc == nil {
+		panic("mocks: FinancesClient.ListFinancialEventGroups called without ListFinancialEventGroupsFunc")
+	}
+	return m.ListFinancialEventGroupsFunc(ctx, filter)
+}
+
+func (m *FinancesClient) ListFinancialEventsByGroupID(ctx context.Context, eventGroupID string, filter *finances.ListFinancialEventsByIDFilter) (*apis.CallResponse[finances.ListFinancialEventsResponse], error) {
+	if m.ListFinancialEventsByGroupIDFunc == nil {
+		panic("mocks: FinancesClient.ListFinancialEventsByGroupID called without ListFinancialEventsByGroupIDFunc")
+	}
+	return m.ListFinancialEventsByGroupIDFunc(ctx, eventGroupID, filter)
+}
+
+func (m *FinancesClient) ListFinancialEventsByOrderID(ctx context.Context, orderID string, filter *finances.ListFinancialEventsByIDFilter) (*apis.CallResponse[finances.ListFinancialEventsResponse], error) {
+	if m.ListFinancialEventsByOrderIDFunc == nil {
+		panic("mocks: FinancesClient.ListFinancialEventsByOrderID called without ListFinancialEventsByOrderIDFunc")
+	}
+	return m.ListFinancialEventsByOrderIDFunc(ctx, orderID, filter)
+}
+
+func (m *FinancesClient) ListFinancialEvents(ctx context.Context, filter *finances.ListFinancialEventsFilter) (*apis.CallResponse[finances.ListFinancialEventsResponse], error) {
+	if m.ListFinancialEventsFunc == nil {
+		panic("mocks: FinancesClient.ListFinancialEvents called without ListFinancialEventsFunc")
+	}
+	return m.ListFinancialEventsFunc(ctx, filter)
+}
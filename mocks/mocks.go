@@ -0,0 +1,11 @@
+// Package mocks provides mocks of the Client interfaces of the API packages, e.g. to test code
+// using sp_api.Client without network access:
+//
+//	client.OrdersAPI = &mocks.OrdersClient{
+//		GetOrderFunc: func(ctx context.Context, orderID string, restrictedDataToken *string) (*apis.CallResponse[orders.GetOrderResponse], error) {
+//			return &apis.CallResponse[orders.GetOrderResponse]{Status: http.StatusOK, ResponseBody: &orders.GetOrderResponse{}}, nil
+//		},
+//	}
+package mocks
+
+//go:generate go run ../internal/mockgen -apis ../apis -out .
@@ -0,0 +1,29 @@
+package mocks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	sp_api "github.com/fond-of-vertigo/amazon-sp-api"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/orders"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrdersClient(t *testing.T) {
+	client := &sp_api.Client{
+		OrdersAPI: &OrdersClient{
+			GetOrderFunc: func(_ context.Context, orderID string, _ *string) (*apis.CallResponse[orders.GetOrderResponse], error) {
+				return &apis.CallResponse[orders.GetOrderResponse]{Status: http.StatusOK, RequestID: orderID}, nil
+			},
+		},
+	}
+
+	resp, err := client.OrdersAPI.GetOrder(context.Background(), "902-3159896-1390916", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "902-3159896-1390916", resp.RequestID)
+	assert.PanicsWithValue(t, "mocks: OrdersClient.GetOrders called without GetOrdersFunc", func() {
+		_, _ = client.OrdersAPI.GetOrders(context.Background(), nil, nil)
+	})
+}
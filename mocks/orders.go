@@ -0,0 +1,129 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"go/types"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/orders"
+)
+
+// OrdersClient mocks orders.Client. Calling a method without its function panics.
+type OrdersClient struct {
+	GetOrdersFunc                 func(ctx context.Context, filter *orders.GetOrdersFilter, restrictedDataToken *string) (*apis.CallResponse[orders.GetOrdersResponse], error)
+	OrdersPaginatorFunc           func(filter *orders.GetOrdersFilter, restrictedDataToken *string) *apis.Paginator[orders.Order]
+	GetOrderFunc                  func(ctx context.Context, orderID string, restrictedDataToken *string) (*apis.CallResponse[orders.GetOrderResponse], error)
+	GetOrderBuyerInfoFunc         func(ctx context.Context, orderID string) (*apis.CallResponse[orders.GetOrderBuyerInfoResponse], error)
+	GetOrderAddressFunc           func(ctx context.Context, orderID string, restrictedDataToken *string) (*apis.CallResponse[orders.GetOrderAddressResponse], error)
+	GetOrderItemsFunc             func(ctx context.Context, orderID string, nextToken *string, restrictedDataToken *string) (*apis.CallResponse[orders.GetOrderItemsResponse], error)
+	OrderItemsPaginatorFunc       func(orderID string, restrictedDataToken *string) *apis.Paginator[orders.OrderItem]
+	GetOrderItemsBuyerInfoFunc    func(ctx context.Context, orderID string, nextToken *string, restrictedDataToken *string) (*apis.CallResponse[orders.GetOrderItemsBuyerInfoResponse], error)
+	UpdateShipmentStatusFunc      func(ctx context.Context, orderID string, payload *orders.UpdateShipmentStatusRequest) (*apis.CallResponse[orders.UpdateShipmentStatusErrorResponse], error)
+	GetOrderRegulatedInfoFunc     func(ctx context.Context, orderID string) (*apis.CallResponse[orders.GetOrderRegulatedInfoResponse], error)
+	UpdateVerificationStatusFunc  func(ctx context.Context, orderID string, payload *orders.UpdateVerificationStatusRequest) (*apis.CallResponse[orders.UpdateVerificationStatusErrorResponse], error)
+	GetOrderItemsApprovalsFunc    func(ctx context.Context, orderID string, filter orders.GetOrderItemsApprovalsFilter) (*apis.CallResponse[orders.GetOrderApprovalsResponse], error)
+	UpdateOrderItemsApprovalsFunc func(ctx context.Context, orderID string, payload *orders.UpdateOrderApprovalsRequest) (*apis.CallResponse[types.Nil], error)
+	ConfirmShipmentFunc           func(ctx context.Context, orderID string, payload *orders.ConfirmShipmentRequest) (*apis.CallResponse[types.Nil], error)
+}
+
+var _ orders.Client = (*OrdersClient)(nil)
+
+func (m *OrdersClient) GetOrders(ctx context.Context, filter *orders.GetOrdersFilter, restrictedDataToken *string) (*apis.CallResponse[orders.GetOrdersResponse], error) {
+	if m.GetOrdersFunc == nil {
+		panic("mocks: OrdersClient.GetOrders called without GetOrdersFunc")
+	}
+	return m.GetOrdersFunc(ctx, filter, restrictedDataToken)
+}
+
+func (m *OrdersClient) OrdersPaginator(filter *orders.GetOrdersFilter, restrictedDataToken *string) *apis.Paginator[orders.Order] {
+	if m.OrdersPaginatorFunc == nil {
+		panic("mocks: OrdersClient.OrdersPaginator called without OrdersPaginatorFunc")
+	}
+	return m.OrdersPaginatorFunc(filter, restrictedDataToken)
+}
+
+func (m *OrdersClient) GetOrder(ctx context.Context, orderID string, restrictedDataToken *string) (*apis.CallResponse[orders.GetOrderResponse], error) {
+	if m.GetOrderFunc == nil {
+		panic("mocks: OrdersClient.GetOrder called without GetOrderFunc")
+	}
+	return m.GetOrderFunc(ctx, orderID, restrictedDataToken)
+}
+
+func (m *OrdersClient) GetOrderBuyerInfo(ctx context.Context, orderID string) (*apis.CallResponse[orders.GetOrderBuyerInfoResponse], error) {
+	if m.GetOrderBuyerInfoFunc == nil {
+		panic("mocks: OrdersClient.GetOrderBuyerInfo called without GetOrderBuyerInfoFunc")
+	}
+	return m.GetOrderBuyerInfoFunc(ctx, orderID)
+}
+
+func (m *OrdersClient) GetOrderAddress(ctx context.Context, orderID string, restrictedDataToken *string) (*apis.CallResponse[orders.GetOrderAddressResponse], error) {
+	if m.GetOrderAddressFunc == nil {
+		panic("mocks: OrdersClient.GetOrderAddress called without GetOrderAddressFunc")
+	}
+	return m.GetOrderAddressFunc(ctx, orderID, restrictedDataToken)
+}
+
+func (m *OrdersClient) GetOrderItems(ctx context.Context, orderID string, nextToken *string, restrictedDataToken *string) (*apis.CallResponse[orders.GetOrderItemsResponse], error) {
+	if m.GetOrderItemsFunc == nil {
+		panic("mocks: OrdersClient.GetOrderItems called without GetOrderItemsFunc")
+	}
+	return m.GetOrderItemsFunc(ctx, orderID, nextToken, restrictedDataToken)
+}
+
+func (m *OrdersClient) OrderItemsPaginator(orderID string, restrictedDataToken *string) *apis.Paginator[orders.OrderItem] {
+	if m.OrderItemsPaginatorFunc == nil {
+		panic("mocks: OrdersClient.OrderItemsPaginator called without OrderItemsPaginatorFunc")
+	}
+	return m.OrderItemsPaginatorFunc(orderID, restrictedDataToken)
+}
+
+func (m *OrdersClient) GetOrderItemsBuyerInfo(ctx context.Context, orderID string, nextToken *string, restrictedDataToken *string) (*apis.CallResponse[orders.GetOrderItemsBuyerInfoResponse], error) {
+	if m.GetOrderItemsBuyerInfoFunc == nil {
+		panic("mocks: OrdersClient.GetOrderItemsBuyerInfo called without GetOrderItemsBuyerInfoFunc")
+	}
+	return m.GetOrderItemsBuyerInfoFunc(ctx, orderID, nextToken, restrictedDataToken)
+}
+
+func (m *OrdersClient) UpdateShipmentStatus(ctx context.Context, orderID string, payload *orders.UpdateShipmentStatusRequest) (*apis.CallResponse[orders.UpdateShipmentStatusErrorResponse], error) {
+	if m.UpdateShipmentStatusFunc == nil {
+		panic("mocks: OrdersClient.UpdateShipmentStatus called without UpdateShipmentStatusFunc")
+	}
+	return m.UpdateShipmentStatusFunc(ctx, orderID, payload)
+}
+
+func (m *OrdersClient) GetOrderRegulatedInfo(ctx context.Context, orderID string) (*apis.CallResponse[orders.GetOrderRegulatedInfoResponse], error) {
+	if m.GetOrderRegulatedInfoFunc == nil {
+		panic("mocks: OrdersClient.GetOrderRegulatedInfo called without GetOrderRegulatedInfoFunc")
+	}
+	return m.GetOrderRegulatedInfoFunc(ctx, orderID)
+}
+
+func (m *OrdersClient) UpdateVerificationStatus(ctx context.Context, orderID string, payload *orders.UpdateVerificationStatusRequest) (*apis.CallResponse[orders.UpdateVerificationStatusErrorResponse], error) {
+	if m.UpdateVerificationStatusFunc == nil {
+		panic("mocks: OrdersClient.UpdateVerificationStatus called without UpdateVerificationStatusFunc")
+	}
+	return m.UpdateVerificationStatusFunc(ctx, orderID, payload)
+}
+
+func (m *OrdersClient) GetOrderItemsApprovals(ctx context.Context, orderID string, filter orders.GetOrderItemsApprovalsFilter) (*apis.CallResponse[orders.GetOrderApprovalsResponse], error) {
+	if m.GetOrderItemsApprovalsFunc == nil {
+		panic("mocks: OrdersClient.GetOrderItemsApprovals called without GetOrderItemsApprovalsFunc")
+	}
+	return m.GetOrderItemsApprovalsFunc(ctx, orderID, filter)
+}
+
+func (m *OrdersClient) UpdateOrderItemsApprovals(ctx context.Context, orderID string, payload *orders.UpdateOrderApprovalsRequest) (*apis.CallResponse[types.Nil], error) {
+	if m.UpdateOrderItemsApprovalsFunc == nil {
+		panic("mocks: OrdersClient.UpdateOrderItemsApprovals called without UpdateOrderItemsApprovalsFunc")
+	}
+	return m.UpdateOrderItemsApprovalsFunc(ctx, orderID, payload)
+}
+
+func (m *OrdersClient) ConfirmShipment(ctx context.Context, orderID string, payload *orders.ConfirmShipmentRequest) (*apis.CallResponse[types.Nil], error) {
+	if m.ConfirmShipmentFunc == nil {
+		panic("mocks: OrdersClient.ConfirmShipment called without ConfirmShipmentFunc")
+	}
+	return m.ConfirmShipmentFunc(ctx, orderID, payload)
+}
@@ -0,0 +1,169 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/reports"
+)
+
+// ReportsClient mocks reports.Client. Calling a method without its function panics.
+type ReportsClient struct {
+	GetReportsFunc              func(ctx context.Context, filter *reports.GetReportsFilter) (*apis.CallResponse[reports.GetReportsResponse], error)
+	GetReportsAllFunc           func(ctx context.Context, filter *reports.GetReportsFilter) ([]reports.ReportModel, error)
+	ReportsPaginatorFunc        func(filter *reports.GetReportsFilter) *apis.Paginator[reports.ReportModel]
+	CreateReportFunc            func(ctx context.Context, specification *reports.CreateReportSpecification) (*apis.CallResponse[reports.CreateReportResponse], error)
+	GetReportFunc               func(ctx context.Context, reportID string) (*apis.CallResponse[reports.GetReportResponse], error)
+	CancelReportFunc            func(ctx context.Context, reportID string) error
+	GetReportSchedulesFunc      func(ctx context.Context, reportTypes []string) (*apis.CallResponse[reports.GetReportsResponse], error)
+	CreateReportScheduleFunc    func(ctx context.Context, specification *reports.CreateReportScheduleSpecification) (*apis.CallResponse[reports.CreateReportScheduleResponse], error)
+	GetReportScheduleFunc       func(ctx context.Context, reportScheduleID string) (*apis.CallResponse[reports.GetReportScheduleResponse], error)
+	CancelReportScheduleFunc    func(ctx context.Context, reportScheduleID string) error
+	GetReportDocumentFunc       func(ctx context.Context, reportDocumentID string, restrictedDataToken *string) (*apis.CallResponse[reports.GetReportDocumentResponse], error)
+	WaitForReportFunc           func(ctx context.Context, reportID string) (*reports.ReportModel, error)
+	CreateAndDownloadReportFunc func(ctx context.Context, specification *reports.CreateReportSpecification, opts ...apis.DocumentOption) ([]byte, error)
+	CreateAndWriteReportFunc    func(ctx context.Context, specification *reports.CreateReportSpecification, w io.Writer, opts ...apis.DocumentOption) error
+	DownloadReportDocumentFunc  func(ctx context.Context, reportDocumentID string, opts ...apis.DocumentOption) ([]byte, error)
+	DownloadReportFunc          func(ctx context.Context, report *reports.ReportModel, opts ...apis.DocumentOption) ([]byte, error)
+	DownloadReportsFunc         func(ctx context.Context, reports []reports.ReportModel, parallelism int, opts ...apis.DocumentOption) <-chan reports.DownloadResult
+	WriteReportFunc             func(ctx context.Context, report *reports.ReportModel, w io.Writer, opts ...apis.DocumentOption) error
+	WriteReportDocumentFunc     func(ctx context.Context, reportDocumentID string, w io.Writer, opts ...apis.DocumentOption) error
+}
+
+var _ reports.Client = (*ReportsClient)(nil)
+
+func (m *ReportsClient) GetReports(ctx context.Context, filter *reports.GetReportsFilter) (*apis.CallResponse[reports.GetReportsResponse], error) {
+	if m.GetReportsFunc == nil {
+		panic("mocks: ReportsClient.GetReports called without GetReportsFunc")
+	}
+	return m.GetReportsFunc(ctx, filter)
+}
+
+func (m *ReportsClient) GetReportsAll(ctx context.Context, filter *reports.GetReportsFilter) ([]reports.ReportModel, error) {
+	if m.GetReportsAllFunc == nil {
+		panic("mocks: ReportsClient.GetReportsAll called without GetReportsAllFunc")
+	}
+	return m.GetReportsAllFunc(ctx, filter)
+}
+
+func (m *ReportsClient) ReportsPaginator(filter *reports.GetReportsFilter) *apis.Paginator[reports.ReportModel] {
+	if m.ReportsPaginatorFunc == nil {
+		panic("mocks: ReportsClient.ReportsPaginator called without ReportsPaginatorFunc")
+	}
+	return m.ReportsPaginatorFunc(filter)
+}
+
+func (m *ReportsClient) CreateReport(ctx context.Context, specification *reports.CreateReportSpecification) (*apis.CallResponse[reports.CreateReportResponse], error) {
+	if m.CreateReportFunc == nil {
+		panic("mocks: ReportsClient.CreateReport called without CreateReportFunc")
+	}
+	return m.CreateReportFunc(ctx, specification)
+}
+
+func (m *ReportsClient) GetReport(ctx context.Context, reportID string) (*apis.CallResponse[reports.GetReportResponse], error) {
+	if m.GetReportFunc == nil {
+		panic("mocks: ReportsClient.GetReport called without GetReportFunc")
+	}
+	return m.GetReportFunc(ctx, reportID)
+}
+
+func (m *ReportsClient) CancelReport(ctx context.Context, reportID string) error {
+	if m.CancelReportFunc == nil {
+		panic("mocks: ReportsClient.CancelReport called without CancelReportFunc")
+	}
+	return m.CancelReportFunc(ctx, reportID)
+}
+
+func (m *ReportsClient) GetReportSchedules(ctx context.Context, reportTypes []string) (*apis.CallResponse[reports.GetReportsResponse], error) {
+	if m.GetReportSchedulesFunc == nil {
+		panic("mocks: ReportsClient.GetReportSchedules called without GetReportSchedulesFunc")
+	}
+	return m.GetReportSchedulesFunc(ctx, reportTypes)
+}
+
+func (m *ReportsClient) CreateReportSchedule(ctx context.Context, specification *reports.CreateReportScheduleSpecification) (*apis.CallResponse[reports.CreateReportScheduleResponse], error) {
+	if m.CreateReportScheduleFunc == nil {
+		panic("mocks: ReportsClient.CreateReportSchedule called without CreateReportScheduleFunc")
+	}
+	return m.CreateReportScheduleFunc(ctx, specification)
+}
+
+func (m *ReportsClient) GetReportSchedule(ctx context.Context, reportScheduleID string) (*apis.CallResponse[reports.GetReportScheduleResponse], error) {
+	if m.GetReportScheduleFunc == nil {
+		panic("mocks: ReportsClient.GetReportSchedule called without GetReportScheduleFunc")
+	}
+	return m.GetReportScheduleFunc(ctx, reportScheduleID)
+}
+
+func (m *ReportsClient) CancelReportSchedule(ctx context.Context, reportScheduleID string) error {
+	if m.CancelReportScheduleFunc == nil {
+		panic("mocks: ReportsClient.CancelReportSchedule called without CancelReportScheduleFunc")
+	}
+	return m.CancelReportScheduleFunc(ctx, reportScheduleID)
+}
+
+func (m *ReportsClient) GetReportDocument(ctx context.Context, reportDocumentID string, restrictedDataToken *string) (*apis.CallResponse[reports.GetReportDocumentResponse], error) {
+	if m.GetReportDocumentFunc == nil {
+		panic("mocks: ReportsClient.GetReportDocument called without GetReportDocumentFunc")
+	}
+	return m.GetReportDocumentFunc(ctx, reportDocumentID, restrictedDataToken)
+}
+
+func (m *ReportsClient) WaitForReport(ctx context.Context, reportID string) (*reports.ReportModel, error) {
+	if m.WaitForReportFunc == nil {
+		panic("mocks: ReportsClient.WaitForReport called without WaitForReportFunc")
+	}
+	return m.WaitForReportFunc(ctx, reportID)
+}
+
+func (m *ReportsClient) CreateAndDownloadReport(ctx context.Context, specification *reports.CreateReportSpecification, opts ...apis.DocumentOption) ([]byte, error) {
+	if m.CreateAndDownloadReportFunc == nil {
+		panic("mocks: ReportsClient.CreateAndDownloadReport called without CreateAndDownloadReportFunc")
+	}
+	return m.CreateAndDownloadReportFunc(ctx, specification, opts...)
+}
+
+func (m *ReportsClient) CreateAndWriteReport(ctx context.Context, specification *reports.CreateReportSpecification, w io.Writer, opts ...apis.DocumentOption) error {
+	if m.CreateAndWriteReportFunc == nil {
+		panic("mocks: ReportsClient.CreateAndWriteReport called without CreateAndWriteReportFunc")
+	}
+	return m.CreateAndWriteReportFunc(ctx, specification, w, opts...)
+}
+
+func (m *ReportsClient) DownloadReportDocument(ctx context.Context, reportDocumentID string, opts ...apis.DocumentOption) ([]byte, error) {
+	if m.DownloadReportDocumentFunc == nil {
+		panic("mocks: ReportsClient.DownloadReportDocument called without DownloadReportDocumentFunc")
+	}
+	return m.DownloadReportDocumentFunc(ctx, reportDocumentID, opts...)
+}
+
+func (m *ReportsClient) DownloadReport(ctx context.Context, report *reports.ReportModel, opts ...apis.DocumentOption) ([]byte, error) {
+	if m.DownloadReportFunc == nil {
+		panic("mocks: ReportsClient.DownloadReport called without DownloadReportFunc")
+	}
+	return m.DownloadReportFunc(ctx, report, opts...)
+}
+
+func (m *ReportsClient) DownloadReports(ctx context.Context, reports []reports.ReportModel, parallelism int, opts ...apis.DocumentOption) <-chan reports.DownloadResult {
+	if m.DownloadReportsFunc == nil {
+		panic("mocks: ReportsClient.DownloadReports called without DownloadReportsFunc")
+	}
+	return m.DownloadReportsFunc(ctx, reports, parallelism, opts...)
+}
+
+func (m *ReportsClient) WriteReport(ctx context.Context, report *reports.ReportModel, w io.Writer, opts ...apis.DocumentOption) error {
+	if m.WriteReportFunc == nil {
+		panic("mocks: ReportsClient.WriteReport called without WriteReportFunc")
+	}
+	return m.WriteReportFunc(ctx, report, w, opts...)
+}
+
+func (m *ReportsClient) WriteReportDocument(ctx context.Context, reportDocumentID string, w io.Writer, opts ...apis.DocumentOption) error {
+	if m.WriteReportDocumentFunc == nil {
+		panic("mocks: ReportsClient.WriteReportDocument called without WriteReportDocumentFunc")
+	}
+	return m.WriteReportDocumentFunc(ctx, reportDocumentID, w, opts...)
+}
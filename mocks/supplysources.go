@@ -0,0 +1,64 @@
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/supplysources"
+)
+
+// SupplysourcesClient mocks supplysources.Client. Calling a method without its function panics.
+type SupplysourcesClient struct {
+	GetSupplySourcesFunc         func(ctx context.Context, filter *supplysources.GetSupplySourcesFilter) (*apis.CallResponse[supplysources.GetSupplySourcesResponse], error)
+	CreateSupplySourceFunc       func(ctx context.Context, request *supplysources.CreateSupplySourceRequest) (*apis.CallResponse[supplysources.CreateSupplySourceResponse], error)
+	GetSupplySourceFunc          func(ctx context.Context, supplySourceID string) (*apis.CallResponse[supplysources.SupplySource], error)
+	UpdateSupplySourceFunc       func(ctx context.Context, supplySourceID string, request *supplysources.UpdateSupplySourceRequest) error
+	UpdateSupplySourceStatusFunc func(ctx context.Context, supplySourceID string, request *supplysources.UpdateSupplySourceStatusRequest) error
+	ArchiveSupplySourceFunc      func(ctx context.Context, supplySourceID string) error
+}
+
+var _ supplysources.Client = (*SupplysourcesClient)(nil)
+
+func (m *SupplysourcesClient) GetSupplySources(ctx context.Context, filter *supplysources.GetSupplySourcesFilter) (*apis.CallResponse[supplysources.GetSupplySourcesResponse], error) {
+	if m.GetSupplySourcesFunc == nil {
+		panic("mocks: SupplysourcesClient.GetSupplySources called without GetSupplySourcesFunc")
+	}
+	return m.GetSupplySourcesFunc(ctx, filter)
+}
+
+func (m *SupplysourcesClient) CreateSupplySource(ctx context.Context, request *supplysources.CreateSupplySourceRequest) (*apis.CallResponse[supplysources.CreateSupplySourceResponse], error) {
+	if m.CreateSupplySourceFunc == nil {
+		panic("mocks: SupplysourcesClient.CreateSupplySource called without CreateSupplySourceFunc")
+	}
+	return m.CreateSupplySourceFunc(ctx, request)
+}
+
+func (m *SupplysourcesClient) GetSupplySource(ctx context.Context, supplySourceID string) (*apis.CallResponse[supplysources.SupplySource], error) {
+	if m.GetSupplySourceFunc == nil {
+		panic("mocks: SupplysourcesClient.GetSupplySource called without GetSupplySourceFunc")
+	}
+	return m.GetSupplySourceFunc(ctx, supplySourceID)
+}
+
+func (m *SupplysourcesClient) UpdateSupplySource(ctx context.Context, supplySourceID string, request *supplysources.UpdateSupplySourceRequest) error {
+	if m.UpdateSupplySourceFunc == nil {
+		panic("mocks: SupplysourcesClient.UpdateSupplySource called without UpdateSupplySourceFunc")
+	}
+	return m.UpdateSupplySourceFunc(ctx, supplySourceID, request)
+}
+
+func (m *SupplysourcesClient) UpdateSupplySourceStatus(ctx context.Context, supplySourceID string, request *supplysources.UpdateSupplySourceStatusRequest) error {
+	if m.UpdateSupplySourceStatusFunc == nil {
+		panic("mocks: SupplysourcesClient.UpdateSupplySourceStatus called without UpdateSupplySourceStatusFunc")
+	}
+	return m.UpdateSupplySourceStatusFunc(ctx, supplySourceID, request)
+}
+
+func (m *SupplysourcesClient) ArchiveSupplySource(ctx context.Context, supplySourceID string) error {
+	if m.ArchiveSupplySourceFunc == nil {
+		panic("mocks: SupplysourcesClient.ArchiveSupplySource called without ArchiveSupplySourceFunc")
+	}
+	return m.ArchiveSupplySourceFunc(ctx, supplySourceID)
+}
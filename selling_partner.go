@@ -1,56 +1,241 @@
 package sp_api
 
 import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net"
 	"net/http"
+	"net/url"
+	"time"
 
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/appintegrations"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/appmanagement"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/awd"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/customerfeedback"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/datakiosk"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/easyship"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/fbasmallandlight"
 	"github.com/fond-of-vertigo/amazon-sp-api/apis/feeds"
 	"github.com/fond-of-vertigo/amazon-sp-api/apis/finances"
+	financesv2024 "github.com/fond-of-vertigo/amazon-sp-api/apis/finances/v2024"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/invoices"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/notifications"
 	"github.com/fond-of-vertigo/amazon-sp-api/apis/orders"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/replenishment"
 	"github.com/fond-of-vertigo/amazon-sp-api/apis/reports"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/sellers"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/sellerwallet"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/supplysources"
 	"github.com/fond-of-vertigo/amazon-sp-api/apis/tokens"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/vehicles"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/vendordfinventory"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/vendordfpayments"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/vendordfshipping"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/vendordftransactions"
 	"github.com/fond-of-vertigo/amazon-sp-api/constants"
 	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
-	"github.com/fond-of-vertigo/logger"
 )
 
 type Config struct {
 	ClientID     string
 	ClientSecret string
 	RefreshToken string
-	Endpoint     constants.Endpoint
-	Log          logger.Logger
-	HTTPClient   *http.Client
+	// Endpoint is the SP-API endpoint, e.g. constants.Europe. If empty, the endpoint serving MarketplaceID is used.
+	Endpoint constants.Endpoint
+	// MarketplaceID selects the endpoint if Endpoint is empty.
+	MarketplaceID constants.MarketplaceID
+	// Log receives the logs of the token updater, of Debug and of calls of deprecated operations.
+	// Defaults to slog.Default(). Loggers of github.com/fond-of-vertigo/logger can be adapted with
+	// httpx.NewLegacyLogger.
+	Log *slog.Logger
+	// HTTPClient is used for all requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// Transport replaces the transport of HTTPClient, e.g. an instrumented http.RoundTripper
+	// or one with a custom CA bundle or proxy.
+	Transport http.RoundTripper
+	// ProxyURL routes all requests through the HTTP(S) proxy, instead of the proxy of the environment.
+	ProxyURL *url.URL
+	// TLSConfig replaces the TLS configuration, e.g. to trust the CA of a corporate proxy.
+	TLSConfig *tls.Config
+	// DialTimeout limits the time to establish a connection.
+	DialTimeout time.Duration
+	// MaxIdleConnsPerHost keeps up to this many idle connections per host for reuse, e.g. as many as
+	// parallel document downloads of reports.API.DownloadReports. Defaults to http.DefaultMaxIdleConnsPerHost.
+	MaxIdleConnsPerHost int
+	// Debug logs all requests and responses with the debug level of Log, see httpx.DebugTransport.
+	// Tokens, secrets and known PII fields are redacted.
+	Debug bool
+	// Application is appended to the User-Agent, e.g. "MyApp/2.1", see httpx.UserAgent.
+	Application string
+	// DisableCompression stops requesting gzip compressed responses from SP-API.
+	DisableCompression bool
+	// TokenStore persists the LWA access token, e.g. a httpx.FileTokenStore to reuse it across runs.
+	// Replicas sharing a TokenStore which implements httpx.TokenStoreLocker share one access token.
+	TokenStore httpx.TokenStore
+	// TokenURL overrides the LWA token endpoint. Defaults to httpx.DefaultTokenURL.
+	TokenURL string
+	// TokenHTTPClient is used for the requests to the LWA token endpoint. Defaults to HTTPClient.
+	TokenHTTPClient *http.Client
+	// TokenProvider replaces the built-in LWA token refresh, RefreshToken is not needed then.
+	TokenProvider httpx.TokenProvider
+	// TokenExpiryDelta is the time before expiry at which the access token is refreshed. Defaults to constants.ExpiryDelta.
+	TokenExpiryDelta time.Duration
+	// TokenRefreshJitter spreads the token refreshes of workers sharing the same credentials by up to this duration.
+	TokenRefreshJitter time.Duration
+	// Credentials supplies ClientID, ClientSecret and RefreshToken at every token request, e.g. from a
+	// secrets manager, so rotated secrets are used without a restart. The static fields are ignored then.
+	Credentials httpx.CredentialsFunc
+	// RateLimiting waits before each call until the rate limit of the operation allows it,
+	// instead of retrying after 429 errors only. Each client gets its own httpx.LocalRateLimiter.
+	RateLimiting bool
+	// RateLimiter replaces the per-process limiter of RateLimiting, e.g. with a limiter shared by all
+	// replicas of a deployment.
+	RateLimiter httpx.RateLimiter
+	// MaxConcurrentRequests caps the SP-API requests in flight across all APIs of the client. Further
+	// requests queue until a slot is free. Zero means no limit.
+	MaxConcurrentRequests int
+	// ConcurrencyLimiter shares a cap on the requests in flight between clients. It takes precedence
+	// over MaxConcurrentRequests.
+	ConcurrencyLimiter *httpx.ConcurrencyLimiter
+	// Metrics receives the metrics of all requests and token refreshes, e.g. an httpx.PrometheusMetrics.
+	Metrics httpx.MetricsRecorder
+	// AuditSink records the operation, time, status and request ID of every request, e.g. to evidence
+	// the data accessed. Bodies are not recorded.
+	AuditSink httpx.AuditSink
+	// SellerID identifies the seller in the audit records. ClientManager sets it to the seller's ID.
+	SellerID string
+	// RetryPolicy configures the retries of calls answered with 429, 500, 502 or 503 and of transient
+	// network errors. Defaults to apis.DefaultRetryPolicy.
+	RetryPolicy *apis.RetryPolicy
+	// Decoding configures the decoding of the response bodies, e.g. DisallowUnknownFields to detect
+	// changes of the SP-API schemas in CI.
+	Decoding apis.DecodeOptions
 }
 
 type Client struct {
-	httpClient  *httpx.Client
-	FinancesAPI *finances.API
-	FeedsAPI    *feeds.API
-	OrdersAPI   *orders.API
-	ReportsAPI  *reports.API
-	TokenAPI    *tokens.API
+	httpClient               *httpx.Client
+	FinancesAPI              finances.Client
+	FinancesV2024API         financesv2024.Client
+	FeedsAPI                 feeds.Client
+	OrdersAPI                orders.Client
+	ReportsAPI               reports.Client
+	TokenAPI                 tokens.Client
+	VendorDFShippingAPI      vendordfshipping.Client
+	VendorDFInventoryAPI     vendordfinventory.Client
+	VendorDFPaymentsAPI      vendordfpayments.Client
+	VendorDFTransactionsAPI  vendordftransactions.Client
+	EasyShipAPI              easyship.Client
+	ReplenishmentAPI         replenishment.Client
+	DataKioskAPI             datakiosk.Client
+	SupplySourcesAPI         supplysources.Client
+	ApplicationManagementAPI appmanagement.Client
+	AppIntegrationsAPI       appintegrations.Client
+	SellerWalletAPI          sellerwallet.Client
+	InvoicesAPI              invoices.Client
+	AWDAPI                   awd.Client
+	VehiclesAPI              vehicles.Client
+	CustomerFeedbackAPI      customerfeedback.Client
+	FBASmallAndLightAPI      fbasmallandlight.Client
+	NotificationsAPI         notifications.Client
+	SellersAPI               sellers.Client
 }
 
-// Close stops the TokenUpdater thread
-func (s *Client) Close() {
-	s.httpClient.Close()
+// Close stops the TokenUpdater thread, waits for the requests in flight and closes the idle
+// connections of the transport created for ProxyURL, TLSConfig, DialTimeout or
+// MaxIdleConnsPerHost. The HTTPClient of the config and http.DefaultClient are left alone. If ctx
+// is done first, the requests in flight are cancelled and an error is returned. Calls after Close
+// fail with httpx.ErrClientClosed. It is safe to call Close multiple times.
+func (s *Client) Close(ctx context.Context) error {
+	return s.httpClient.Close(ctx)
+}
+
+// Ping checks that SP-API is reachable and accepts the credentials of the client, e.g. in readiness
+// probes. It calls getMarketplaceParticipations of SellersAPI, which every selling partner can call.
+// The operation allows one call per minute after a burst of 15, so probes running more often should
+// cache the result.
+func (s *Client) Ping(ctx context.Context) error {
+	_, err := s.SellersAPI.GetMarketplaceParticipations(ctx)
+	return err
+}
+
+// TokenUpdater returns the LWA token updater to report the auth state in health checks,
+// see httpx.PeriodicTokenUpdater. It returns nil if Config.TokenProvider is set.
+func (s *Client) TokenUpdater() *httpx.PeriodicTokenUpdater {
+	return s.httpClient.TokenUpdater()
+}
+
+// RateLimiter returns the rate limiter of the client. With Config.RateLimiting it is an
+// *httpx.LocalRateLimiter, which reports the limits observed per operation. It returns nil
+// unless Config.RateLimiting or Config.RateLimiter is set.
+func (s *Client) RateLimiter() httpx.RateLimiter {
+	return s.httpClient.RateLimiter()
+}
+
+// RateLimitStats returns the rate limits reported by SP-API and the throttled requests per operation.
+func (s *Client) RateLimitStats() map[string]httpx.RateLimitStats {
+	return s.httpClient.RateLimitStats()
 }
 
 func NewClient(config Config) (*Client, error) {
-	hc := config.HTTPClient
-	if config.HTTPClient == nil {
-		hc = http.DefaultClient
+	if config.Endpoint == "" && config.MarketplaceID != "" {
+		endpoint, err := constants.EndpointForMarketplace(config.MarketplaceID)
+		if err != nil {
+			return nil, err
+		}
+		config.Endpoint = endpoint
+	}
+
+	hc, ownsHTTPClient, err := newHTTPClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenHTTPClient := config.TokenHTTPClient
+	if tokenHTTPClient == nil {
+		tokenHTTPClient = hc
+	}
+
+	rateLimiter := config.RateLimiter
+	if rateLimiter == nil && config.RateLimiting {
+		rateLimiter = httpx.NewRateLimiter()
+	}
+
+	concurrencyLimiter := config.ConcurrencyLimiter
+	if concurrencyLimiter == nil && config.MaxConcurrentRequests > 0 {
+		concurrencyLimiter = httpx.NewConcurrencyLimiter(config.MaxConcurrentRequests)
 	}
 
 	clientConfig := httpx.ClientConfig{
-		HTTPClient: hc,
-		Endpoint:   config.Endpoint,
+		HTTPClient:         hc,
+		Endpoint:           config.Endpoint,
+		TokenProvider:      config.TokenProvider,
+		RateLimiter:        rateLimiter,
+		ConcurrencyLimiter: concurrencyLimiter,
+		Metrics:            config.Metrics,
+		AuditSink:          config.AuditSink,
+		SellerID:           config.SellerID,
+		RetryPolicy:        config.RetryPolicy,
+		DecodeOptions:      config.Decoding,
+		Application:        config.Application,
+		DisableCompression: config.DisableCompression,
+		// the HTTPClient of the config, http.DefaultClient or the one of a ClientManager may be in
+		// use elsewhere in the process
+		CloseIdleConnections: ownsHTTPClient,
+		Log:                  config.Log,
 		TokenUpdaterConfig: httpx.TokenUpdaterConfig{
-			RefreshToken: config.RefreshToken,
-			ClientID:     config.ClientID,
-			ClientSecret: config.ClientSecret,
-			HTTPClient:   hc,
-			Logger:       config.Log,
+			RefreshToken:  config.RefreshToken,
+			ClientID:      config.ClientID,
+			ClientSecret:  config.ClientSecret,
+			HTTPClient:    tokenHTTPClient,
+			Logger:        config.Log,
+			TokenStore:    config.TokenStore,
+			TokenURL:      config.TokenURL,
+			ExpiryDelta:   config.TokenExpiryDelta,
+			RefreshJitter: config.TokenRefreshJitter,
+			Credentials:   config.Credentials,
 		},
 	}
 
@@ -60,11 +245,90 @@ func NewClient(config Config) (*Client, error) {
 	}
 
 	return &Client{
-		httpClient:  httpxClient,
-		FinancesAPI: finances.NewAPI(httpxClient),
-		FeedsAPI:    feeds.NewAPI(httpxClient),
-		OrdersAPI:   orders.NewAPI(httpxClient),
-		ReportsAPI:  reports.NewAPI(httpxClient),
-		TokenAPI:    tokens.NewAPI(httpxClient),
+		httpClient:               httpxClient,
+		FinancesAPI:              finances.NewAPI(httpxClient),
+		FinancesV2024API:         financesv2024.NewAPI(httpxClient),
+		FeedsAPI:                 feeds.NewAPI(httpxClient),
+		OrdersAPI:                orders.NewAPI(httpxClient),
+		ReportsAPI:               reports.NewAPI(httpxClient),
+		TokenAPI:                 tokens.NewAPI(httpxClient),
+		VendorDFShippingAPI:      vendordfshipping.NewAPI(httpxClient),
+		VendorDFInventoryAPI:     vendordfinventory.NewAPI(httpxClient),
+		VendorDFPaymentsAPI:      vendordfpayments.NewAPI(httpxClient),
+		VendorDFTransactionsAPI:  vendordftransactions.NewAPI(httpxClient),
+		EasyShipAPI:              easyship.NewAPI(httpxClient),
+		ReplenishmentAPI:         replenishment.NewAPI(httpxClient),
+		DataKioskAPI:             datakiosk.NewAPI(httpxClient),
+		SupplySourcesAPI:         supplysources.NewAPI(httpxClient),
+		ApplicationManagementAPI: appmanagement.NewAPI(httpxClient),
+		AppIntegrationsAPI:       appintegrations.NewAPI(httpxClient),
+		SellerWalletAPI:          sellerwallet.NewAPI(httpxClient),
+		InvoicesAPI:              invoices.NewAPI(httpxClient),
+		AWDAPI:                   awd.NewAPI(httpxClient),
+		VehiclesAPI:              vehicles.NewAPI(httpxClient),
+		CustomerFeedbackAPI:      customerfeedback.NewAPI(httpxClient),
+		FBASmallAndLightAPI:      fbasmallandlight.NewAPI(httpxClient),
+		NotificationsAPI:         notifications.NewAPI(httpxClient),
+		SellersAPI:               sellers.NewAPI(httpxClient),
 	}, nil
 }
+
+// newHTTPClient returns the HTTPClient of config with the Transport, proxy, TLS and debug options of config.
+// owned reports whether the transport was created for the client, so that its idle connections may
+// be closed without affecting other users of the HTTPClient or of http.DefaultTransport.
+func newHTTPClient(config Config) (hc *http.Client, owned bool, err error) {
+	hc = config.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	transport := config.Transport
+	if transport == nil {
+		transport = hc.Transport
+	}
+	if config.ProxyURL != nil || config.TLSConfig != nil || config.DialTimeout > 0 || config.MaxIdleConnsPerHost > 0 {
+		if transport, err = configureTransport(transport, config); err != nil {
+			return nil, false, err
+		}
+		owned = true
+	}
+	if config.Debug {
+		transport = &httpx.DebugTransport{Transport: transport, Log: config.Log}
+	}
+	if transport == hc.Transport {
+		return hc, owned, nil
+	}
+
+	withTransport := *hc
+	withTransport.Transport = transport
+	return &withTransport, owned, nil
+}
+
+// configureTransport returns a copy of the transport with the proxy, TLS and connection options of config.
+func configureTransport(transport http.RoundTripper, config Config) (*http.Transport, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	base, ok := transport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("ProxyURL, TLSConfig, DialTimeout and MaxIdleConnsPerHost require an *http.Transport, got %T", transport)
+	}
+
+	configured := base.Clone()
+	if config.ProxyURL != nil {
+		configured.Proxy = http.ProxyURL(config.ProxyURL)
+	}
+	if config.TLSConfig != nil {
+		configured.TLSClientConfig = config.TLSConfig.Clone()
+	}
+	if config.DialTimeout > 0 {
+		dialer := &net.Dialer{Timeout: config.DialTimeout, KeepAlive: 30 * time.Second}
+		configured.DialContext = dialer.DialContext
+	}
+	if config.MaxIdleConnsPerHost > 0 {
+		configured.MaxIdleConnsPerHost = config.MaxIdleConnsPerHost
+		if configured.MaxIdleConns > 0 && configured.MaxIdleConns < config.MaxIdleConnsPerHost {
+			configured.MaxIdleConns = config.MaxIdleConnsPerHost
+		}
+	}
+	return configured, nil
+}
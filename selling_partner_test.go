@@ -0,0 +1,109 @@
+package sp_api
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClient_WithTransport(t *testing.T) {
+	transport := &tokenRoundTripper{}
+	hc := &http.Client{Timeout: time.Minute}
+	c, err := NewClient(Config{
+		ClientID:     "ID",
+		ClientSecret: "SECRET",
+		RefreshToken: "REFRESH",
+		Endpoint:     constants.Europe,
+		Log:          slog.New(slog.NewTextHandler(io.Discard, nil)),
+		HTTPClient:   hc,
+		Transport:    transport,
+	})
+	assert.NoError(t, err)
+	defer c.Close(context.Background())
+
+	assert.Equal(t, int32(1), transport.calls.Load(), "the token should be fetched with the transport")
+	assert.Nil(t, hc.Transport, "the HTTPClient of the config should not be modified")
+}
+
+func TestNewHTTPClient_ProxyAndTLS(t *testing.T) {
+	proxyURL, _ := url.Parse("http://proxy.example.com:3128")
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	hc, owned, err := newHTTPClient(Config{ProxyURL: proxyURL, TLSConfig: tlsConfig, DialTimeout: 5 * time.Second})
+	assert.NoError(t, err)
+	assert.True(t, owned, "the configured transport belongs to the client")
+	transport, ok := hc.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Transport = %T, want *http.Transport", hc.Transport)
+	}
+	req, _ := http.NewRequest(http.MethodGet, string(constants.Europe), nil)
+	gotProxy, err := transport.Proxy(req)
+	assert.NoError(t, err)
+	assert.Equal(t, proxyURL, gotProxy)
+	assert.Equal(t, uint16(tls.VersionTLS12), transport.TLSClientConfig.MinVersion)
+	assert.NotSame(t, http.DefaultTransport, hc.Transport, "the default transport should not be modified")
+
+	_, _, err = newHTTPClient(Config{Transport: &tokenRoundTripper{}, ProxyURL: proxyURL})
+	assert.Error(t, err, "the proxy can't be set on a custom RoundTripper")
+}
+
+func TestNewHTTPClient_MaxIdleConnsPerHost(t *testing.T) {
+	hc, _, err := newHTTPClient(Config{MaxIdleConnsPerHost: 200})
+	assert.NoError(t, err)
+	transport, ok := hc.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Transport = %T, want *http.Transport", hc.Transport)
+	}
+	assert.Equal(t, 200, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 200, transport.MaxIdleConns, "the idle connections of all hosts must not be capped below the ones per host")
+	assert.NotEqual(t, 200, http.DefaultTransport.(*http.Transport).MaxIdleConnsPerHost)
+}
+
+// idleCountingTransport counts the calls of CloseIdleConnections, which http.Client passes on.
+type idleCountingTransport struct {
+	tokenRoundTripper
+	closedIdle atomic.Int32
+}
+
+func (rt *idleCountingTransport) CloseIdleConnections() {
+	rt.closedIdle.Add(1)
+}
+
+func TestClient_CloseLeavesForeignHTTPClients(t *testing.T) {
+	for name, config := range map[string]Config{
+		"HTTPClient": {HTTPClient: &http.Client{Transport: &idleCountingTransport{}}},
+		"Transport":  {Transport: &idleCountingTransport{}},
+		"Debug":      {HTTPClient: &http.Client{Transport: &idleCountingTransport{}}, Debug: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			config.ClientID = "ID"
+			config.ClientSecret = "SECRET"
+			config.RefreshToken = "REFRESH"
+			config.Endpoint = constants.Europe
+			config.Log = slog.New(slog.NewTextHandler(io.Discard, nil))
+			c, err := NewClient(config)
+			assert.NoError(t, err)
+
+			assert.NoError(t, c.Close(context.Background()))
+
+			transport, _ := config.Transport.(*idleCountingTransport)
+			if transport == nil {
+				transport = config.HTTPClient.Transport.(*idleCountingTransport)
+			}
+			assert.Zero(t, transport.closedIdle.Load(), "the idle connections of a transport the SDK didn't create must be kept")
+		})
+	}
+
+	_, owned, err := newHTTPClient(Config{})
+	assert.NoError(t, err)
+	assert.False(t, owned, "http.DefaultClient is shared by the process")
+}
@@ -0,0 +1,196 @@
+package spapitest
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/feeds"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+const feedsPathPrefix = "/feeds/2021-06-30"
+
+type feed struct {
+	model feeds.Feed
+	// polls counts the GetFeed calls of the feed.
+	polls int
+}
+
+// SetFeedResult sets the content of the processing reports of feeds of the feed type. Feeds of
+// types without result get an empty processing report.
+func (s *Server) SetFeedResult(feedType string, content []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.feedResults[feedType] = content
+}
+
+// Feeds returns the feeds created, ordered by creation.
+func (s *Server) Feeds() []feeds.Feed {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	models := make([]feeds.Feed, 0, len(s.feeds))
+	for _, f := range s.feeds {
+		models = append(models, f.model)
+	}
+	sort.Slice(models, func(i, j int) bool {
+		return models[i].CreatedTime.Before(models[j].CreatedTime)
+	})
+	return models
+}
+
+// FeedContent returns the content uploaded for the input document of the feed.
+func (s *Server) FeedContent(feedID string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, ok := s.feeds[feedID]
+	if !ok {
+		return nil, false
+	}
+	doc, ok := s.documents[f.inputFeedDocumentID()]
+	if !ok || !doc.uploaded {
+		return nil, false
+	}
+	return doc.content, true
+}
+
+func (f *feed) inputFeedDocumentID() string {
+	return "input-" + f.model.FeedId
+}
+
+func (s *Server) feedRoutes() []route {
+	return []route{
+		{http.MethodPost, feedsPathPrefix + "/documents", s.createFeedDocument},
+		{http.MethodGet, feedsPathPrefix + "/documents/{feedDocumentID}", s.getFeedDocument},
+		{http.MethodPost, feedsPathPrefix + "/feeds", s.createFeed},
+		{http.MethodGet, feedsPathPrefix + "/feeds", s.getFeeds},
+		{http.MethodGet, feedsPathPrefix + "/feeds/{feedID}", s.getFeed},
+		{http.MethodDelete, feedsPathPrefix + "/feeds/{feedID}", s.cancelFeed},
+	}
+}
+
+func (s *Server) createFeedDocument(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var specification feeds.CreateFeedDocumentSpecification
+	if !readJSON(w, r, &specification) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	documentID := s.newID("feed-document-")
+	s.documents[documentID] = &document{}
+	writeJSON(w, http.StatusCreated, feeds.CreateFeedDocumentResponse{
+		FeedDocumentId: documentID,
+		Url:            s.documentURL(documentID),
+	})
+}
+
+func (s *Server) getFeedDocument(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	documentID := params["feedDocumentID"]
+	s.mu.Lock()
+	_, ok := s.documents[documentID]
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "feed document not found")
+		return
+	}
+
+	compression := "GZIP"
+	writeJSON(w, http.StatusOK, feeds.FeedDocument{
+		FeedDocumentId:       documentID,
+		Url:                  s.documentURL(documentID),
+		CompressionAlgorithm: &compression,
+	})
+}
+
+// createFeed keeps the uploaded content of the input feed document, so FeedContent returns it
+// even if the document is reused for another feed.
+func (s *Server) createFeed(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var specification feeds.CreateFeedSpecification
+	if !readJSON(w, r, &specification) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	input, ok := s.documents[specification.InputFeedDocumentId]
+	if !ok || !input.uploaded {
+		writeError(w, http.StatusBadRequest, "InvalidInput", "inputFeedDocumentId does not refer to an uploaded feed document")
+		return
+	}
+
+	f := &feed{model: feeds.Feed{
+		FeedId:           s.newID("feed-"),
+		FeedType:         specification.FeedType,
+		MarketplaceIDs:   specification.MarketplaceIDs,
+		CreatedTime:      time.Now().UTC(),
+		ProcessingStatus: feeds.ProcessingStatusInQueue,
+	}}
+	s.documents[f.inputFeedDocumentID()] = &document{content: input.content, uploaded: true}
+	s.feeds[f.model.FeedId] = f
+	writeJSON(w, http.StatusAccepted, feeds.CreateFeedResponse{FeedId: f.model.FeedId})
+}
+
+func (s *Server) getFeeds(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	query := r.URL.Query()
+	feedTypes := splitQuery(query.Get("feedTypes"))
+	processingStatuses := splitQuery(query.Get("processingStatuses"))
+
+	var matching []feeds.Feed
+	for _, model := range s.Feeds() {
+		if len(feedTypes) > 0 && !contains(feedTypes, model.FeedType) {
+			continue
+		}
+		if len(processingStatuses) > 0 && !contains(processingStatuses, string(model.ProcessingStatus)) {
+			continue
+		}
+		matching = append(matching, model)
+	}
+	writeJSON(w, http.StatusOK, feeds.GetFeedsResponse{Feeds: matching})
+}
+
+// getFeed answers IN_PROGRESS for the first FeedPolls calls and completes the feed with a
+// processing report afterwards.
+func (s *Server) getFeed(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, ok := s.feeds[params["feedID"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "feed not found")
+		return
+	}
+
+	if !constants.ProcessingStatus(f.model.ProcessingStatus).IsTerminal() {
+		f.polls++
+		now := time.Now().UTC()
+		if f.model.ProcessingStartTime == nil {
+			f.model.ProcessingStartTime = &now
+		}
+		f.model.ProcessingStatus = feeds.ProcessingStatusInProgress
+		if f.polls > s.FeedPolls {
+			documentID := s.newID("feed-result-")
+			s.documents[documentID] = &document{content: s.feedResults[f.model.FeedType]}
+			f.model.ProcessingStatus = feeds.ProcessingStatusDone
+			f.model.ProcessingEndTime = &now
+			f.model.ResultFeedDocumentId = &documentID
+		}
+	}
+	writeJSON(w, http.StatusOK, f.model)
+}
+
+func (s *Server) cancelFeed(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, ok := s.feeds[params["feedID"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "feed not found")
+		return
+	}
+	if f.model.ProcessingStatus != feeds.ProcessingStatusInQueue {
+		writeError(w, http.StatusBadRequest, "InvalidInput", "only feeds in queue can be cancelled")
+		return
+	}
+	f.model.ProcessingStatus = feeds.ProcessingStatusCanceled
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,97 @@
+package spapitest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/orders"
+)
+
+const ordersPathPrefix = "/orders/v0"
+
+// AddOrders adds orders returned by GetOrders and GetOrder. Orders with the AmazonOrderId of
+// an existing order replace it.
+func (s *Server) AddOrders(newOrders ...orders.Order) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+next:
+	for _, order := range newOrders {
+		for i := range s.orders {
+			if s.orders[i].AmazonOrderId == order.AmazonOrderId {
+				s.orders[i] = order
+				continue next
+			}
+		}
+		s.orders = append(s.orders, order)
+	}
+}
+
+func (s *Server) orderRoutes() []route {
+	return []route{
+		{http.MethodGet, ordersPathPrefix + "/orders", s.getOrders},
+		{http.MethodGet, ordersPathPrefix + "/orders/{orderID}", s.getOrder},
+	}
+}
+
+// getOrders returns the orders matching the AmazonOrderIds and OrderStatuses filters in pages of
+// MaxResultsPerPage orders. The NextToken is the offset of the next page.
+func (s *Server) getOrders(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	query := r.URL.Query()
+	orderIDs := splitQuery(query.Get("AmazonOrderIds"))
+	orderStatuses := splitQuery(query.Get("OrderStatuses"))
+	pageSize := 100
+	if value := query.Get("MaxResultsPerPage"); value != "" {
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 1 || size > 100 {
+			writeError(w, http.StatusBadRequest, "InvalidInput", "MaxResultsPerPage must be between 1 and 100")
+			return
+		}
+		pageSize = size
+	}
+	offset := 0
+	if nextToken := query.Get("NextToken"); nextToken != "" {
+		var err error
+		if offset, err = strconv.Atoi(nextToken); err != nil || offset < 0 {
+			writeError(w, http.StatusBadRequest, "InvalidInput", "invalid NextToken")
+			return
+		}
+	}
+
+	s.mu.Lock()
+	var matching []orders.Order
+	for _, order := range s.orders {
+		if len(orderIDs) > 0 && !contains(orderIDs, order.AmazonOrderId) {
+			continue
+		}
+		if len(orderStatuses) > 0 && !contains(orderStatuses, order.OrderStatus) {
+			continue
+		}
+		matching = append(matching, order)
+	}
+	s.mu.Unlock()
+
+	page := &orders.OrdersList{Orders: []orders.Order{}}
+	if offset < len(matching) {
+		end := min(offset+pageSize, len(matching))
+		page.Orders = matching[offset:end]
+		if end < len(matching) {
+			nextToken := strconv.Itoa(end)
+			page.NextToken = &nextToken
+		}
+	}
+	writeJSON(w, http.StatusOK, orders.GetOrdersResponse{Payload: page})
+}
+
+func (s *Server) getOrder(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.orders {
+		if s.orders[i].AmazonOrderId == params["orderID"] {
+			order := s.orders[i]
+			writeJSON(w, http.StatusOK, orders.GetOrderResponse{Payload: &order})
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "NotFound", "order not found")
+}
@@ -0,0 +1,175 @@
+package spapitest
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/reports"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+const reportsPathPrefix = "/reports/2021-06-30"
+
+type report struct {
+	model reports.ReportModel
+	// polls counts the GetReport calls of the report.
+	polls int
+}
+
+// SetReportContent sets the content of the documents of reports of the report type. Reports
+// of types without content get an empty document.
+func (s *Server) SetReportContent(reportType reports.Type, content []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reportContents[reportType] = content
+}
+
+// Reports returns the reports created, ordered by creation.
+func (s *Server) Reports() []reports.ReportModel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	models := make([]reports.ReportModel, 0, len(s.reports))
+	for _, r := range s.reports {
+		models = append(models, r.model)
+	}
+	sort.Slice(models, func(i, j int) bool {
+		return models[i].CreatedTime.Before(models[j].CreatedTime)
+	})
+	return models
+}
+
+func (s *Server) reportRoutes() []route {
+	return []route{
+		{http.MethodPost, reportsPathPrefix + "/reports", s.createReport},
+		{http.MethodGet, reportsPathPrefix + "/reports", s.getReports},
+		{http.MethodGet, reportsPathPrefix + "/reports/{reportID}", s.getReport},
+		{http.MethodDelete, reportsPathPrefix + "/reports/{reportID}", s.cancelReport},
+		{http.MethodGet, reportsPathPrefix + "/documents/{reportDocumentID}", s.getReportDocument},
+	}
+}
+
+func (s *Server) createReport(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var specification struct {
+		ReportType     reports.Type              `json:"reportType"`
+		MarketplaceIDs []constants.MarketplaceID `json:"marketplaceIds"`
+	}
+	if !readJSON(w, r, &specification) {
+		return
+	}
+	if specification.ReportType == "" {
+		writeError(w, http.StatusBadRequest, "InvalidInput", "reportType is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	reportID := s.newID("report-")
+	s.reports[reportID] = &report{model: reports.ReportModel{
+		ReportID:         reportID,
+		ReportType:       specification.ReportType,
+		MarketplaceIDs:   specification.MarketplaceIDs,
+		CreatedTime:      time.Now().UTC(),
+		ProcessingStatus: constants.InQueue,
+	}}
+	writeJSON(w, http.StatusAccepted, reports.CreateReportResponse{ReportID: reportID})
+}
+
+func (s *Server) getReports(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	query := r.URL.Query()
+	reportTypes := splitQuery(query.Get("reportTypes"))
+	processingStatuses := splitQuery(query.Get("processingStatuses"))
+
+	var matching []reports.ReportModel
+	for _, model := range s.Reports() {
+		if len(reportTypes) > 0 && !contains(reportTypes, string(model.ReportType)) {
+			continue
+		}
+		if len(processingStatuses) > 0 && !contains(processingStatuses, string(model.ProcessingStatus)) {
+			continue
+		}
+		matching = append(matching, model)
+	}
+	writeJSON(w, http.StatusOK, reports.GetReportsResponse{Reports: matching})
+}
+
+// getReport answers IN_PROGRESS for the first ReportPolls calls and completes the report
+// with a document afterwards.
+func (s *Server) getReport(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rep, ok := s.reports[params["reportID"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "report not found")
+		return
+	}
+
+	if !rep.model.ProcessingStatus.IsTerminal() {
+		rep.polls++
+		now := time.Now().UTC()
+		if rep.model.ProcessingStartTime == nil {
+			rep.model.ProcessingStartTime = &now
+		}
+		rep.model.ProcessingStatus = constants.InProgress
+		if rep.polls > s.ReportPolls {
+			documentID := s.newID("report-document-")
+			s.documents[documentID] = &document{content: s.reportContents[rep.model.ReportType]}
+			rep.model.ProcessingStatus = constants.Done
+			rep.model.ProcessingEndTime = &now
+			rep.model.ReportDocumentID = &documentID
+		}
+	}
+	writeJSON(w, http.StatusOK, reports.GetReportResponse{ReportModel: rep.model})
+}
+
+func (s *Server) cancelReport(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rep, ok := s.reports[params["reportID"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "report not found")
+		return
+	}
+	if rep.model.ProcessingStatus != constants.InQueue {
+		writeError(w, http.StatusBadRequest, "InvalidInput", "only reports in queue can be cancelled")
+		return
+	}
+	rep.model.ProcessingStatus = constants.Cancelled
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getReportDocument(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	documentID := params["reportDocumentID"]
+	s.mu.Lock()
+	_, ok := s.documents[documentID]
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "report document not found")
+		return
+	}
+
+	compression := reports.CompressionAlgorithmGZIP
+	writeJSON(w, http.StatusOK, reports.ReportDocument{
+		ReportDocumentID:     documentID,
+		Url:                  s.documentURL(documentID),
+		CompressionAlgorithm: &compression,
+	})
+}
+
+func splitQuery(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
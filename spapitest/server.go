@@ -0,0 +1,257 @@
+// Package spapitest provides an in-memory fake of SP-API to test pipelines end to end without
+// network access or seller credentials.
+//
+// The Server implements the LWA token endpoint, restricted data tokens, the marketplace
+// participations called by Client.Ping and the reports, feeds and orders endpoints used by this SDK:
+//
+//	srv := spapitest.NewServer()
+//	defer srv.Close()
+//	srv.SetReportContent(reports.FBAInventoryLedgerReportSummaryView, []byte("sku\tqty\n"))
+//
+//	client, err := sp_api.NewClient(srv.Config())
+//	...
+//	content, err := client.ReportsAPI.CreateAndDownloadReport(ctx, specification)
+package spapitest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	sp_api "github.com/fond-of-vertigo/amazon-sp-api"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/orders"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/reports"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/sellers"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+)
+
+const (
+	// AccessToken is the LWA access token issued by the Server.
+	AccessToken = "Atza|spapitest"
+	// RestrictedDataToken is the restrictedDataToken issued by the Server.
+	RestrictedDataToken = "Atz.sprdt|spapitest"
+
+	tokenPath     = "/auth/o2/token"
+	documentsPath = "/documents/{documentID}"
+)
+
+// Server is a fake SP-API backed by an httptest.Server. Its state is kept in memory and is safe
+// for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	// ReportPolls is the number of GetReport calls answering IN_PROGRESS before a report is DONE.
+	// Zero completes a report at its first GetReport call.
+	ReportPolls int
+	// FeedPolls is the number of GetFeed calls answering IN_PROGRESS before a feed is DONE.
+	FeedPolls int
+
+	mu             sync.Mutex
+	nextID         int
+	routes         []route
+	reports        map[string]*report
+	reportContents map[reports.Type][]byte
+	feeds          map[string]*feed
+	feedResults    map[string][]byte
+	documents      map[string]*document
+	orders         []orders.Order
+}
+
+type route struct {
+	method  string
+	pattern string
+	handle  func(w http.ResponseWriter, r *http.Request, params map[string]string)
+}
+
+// document is a report or feed document, downloaded and uploaded at its presigned URL.
+type document struct {
+	content []byte
+	// uploaded is set once the content of a feed document was uploaded.
+	uploaded bool
+}
+
+// NewServer starts a Server. Close it at the end of the test.
+func NewServer() *Server {
+	s := &Server{
+		reports:        map[string]*report{},
+		reportContents: map[reports.Type][]byte{},
+		feeds:          map[string]*feed{},
+		feedResults:    map[string][]byte{},
+		documents:      map[string]*document{},
+	}
+	s.routes = []route{
+		{http.MethodPost, tokenPath, s.createAccessToken},
+		{http.MethodPost, "/tokens/2021-03-01/restrictedDataToken", s.createRestrictedDataToken},
+		{http.MethodGet, "/sellers/v1/marketplaceParticipations", s.getMarketplaceParticipations},
+		{http.MethodGet, documentsPath, s.downloadDocument},
+		{http.MethodPut, documentsPath, s.uploadDocument},
+	}
+	s.routes = append(s.routes, s.reportRoutes()...)
+	s.routes = append(s.routes, s.feedRoutes()...)
+	s.routes = append(s.routes, s.orderRoutes()...)
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// Config returns a configuration of sp_api.NewClient which sends all requests to the Server.
+func (s *Server) Config() sp_api.Config {
+	return sp_api.Config{
+		ClientID:     "spapitest-client",
+		ClientSecret: "spapitest-secret",
+		RefreshToken: "Atzr|spapitest",
+		Endpoint:     constants.Endpoint(s.URL),
+		TokenURL:     s.URL + tokenPath,
+		HTTPClient:   s.Client(),
+	}
+}
+
+// ServeHTTP routes the request to the fake operation. Requests to SP-API operations without the
+// access token of the Server are answered with 403 like SP-API does.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	for _, rt := range s.routes {
+		if rt.method != r.Method {
+			continue
+		}
+		params, ok := matchPath(rt.pattern, r.URL.Path)
+		if !ok {
+			continue
+		}
+		if rt.pattern != tokenPath && rt.pattern != documentsPath && !authorized(r) {
+			writeError(w, http.StatusForbidden, "Unauthorized", "Access to requested resource is denied.")
+			return
+		}
+		rt.handle(w, r, params)
+		return
+	}
+	writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("%s %s is not implemented by spapitest", r.Method, r.URL.Path))
+}
+
+// authorized accepts AccessToken, the tokens refreshed from it by TokenProvider and RestrictedDataToken.
+func authorized(r *http.Request) bool {
+	token := r.Header.Get(constants.AccessTokenHeader)
+	return token == AccessToken || strings.HasPrefix(token, AccessToken+"-") || token == RestrictedDataToken
+}
+
+// matchPath matches path against pattern, whose {name} segments match any segment.
+func matchPath(pattern, path string) (map[string]string, bool) {
+	patternSegments := strings.Split(pattern, "/")
+	pathSegments := strings.Split(path, "/")
+	if len(patternSegments) != len(pathSegments) {
+		return nil, false
+	}
+	params := map[string]string{}
+	for i, segment := range patternSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[segment[1:len(segment)-1]] = pathSegments[i]
+			continue
+		}
+		if segment != pathSegments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func (s *Server) createAccessToken(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": AccessToken,
+		"token_type":   "bearer",
+		"expires_in":   3600,
+	})
+}
+
+func (s *Server) createRestrictedDataToken(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"restrictedDataToken": RestrictedDataToken,
+		"expiresIn":           3600,
+	})
+}
+
+// getMarketplaceParticipations answers that the seller participates in the German marketplace.
+func (s *Server) getMarketplaceParticipations(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	writeJSON(w, http.StatusOK, sellers.GetMarketplaceParticipationsResponse{
+		Payload: []sellers.MarketplaceParticipation{{
+			Marketplace: sellers.Marketplace{
+				ID:                  constants.Germany,
+				Name:                "Amazon.de",
+				CountryCode:         "DE",
+				DefaultCurrencyCode: "EUR",
+				DefaultLanguageCode: "de_DE",
+				DomainName:          "www.amazon.de",
+			},
+			Participation: sellers.Participation{IsParticipating: true},
+			StoreName:     "spapitest",
+		}},
+	})
+}
+
+func (s *Server) downloadDocument(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	doc, ok := s.documents[params["documentID"]]
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "document not found")
+		return
+	}
+
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	_, _ = gz.Write(doc.content)
+	_ = gz.Close()
+	w.Header().Set("Content-Type", "application/octet-stream")
+	_, _ = w.Write(compressed.Bytes())
+}
+
+func (s *Server) uploadDocument(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var content bytes.Buffer
+	if _, err := content.ReadFrom(r.Body); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidInput", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	doc, ok := s.documents[params["documentID"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "document not found")
+		return
+	}
+	doc.content = content.Bytes()
+	doc.uploaded = true
+	w.WriteHeader(http.StatusOK)
+}
+
+// newID returns a new identifier with the prefix. s.mu must be held.
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s%d", prefix, s.nextID)
+}
+
+// documentURL returns the presigned URL of the document.
+func (s *Server) documentURL(documentID string) string {
+	return s.URL + strings.Replace(documentsPath, "{documentID}", documentID, 1)
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidInput", err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, statusCode, apis.ErrorList{Errors: []apis.Error{{Code: code, Message: message}}})
+}
@@ -0,0 +1,187 @@
+package spapitest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	sp_api "github.com/fond-of-vertigo/amazon-sp-api"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/feeds"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/orders"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/reports"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, srv *Server) *sp_api.Client {
+	config := srv.Config()
+	config.Log = slog.New(slog.NewTextHandler(io.Discard, nil))
+	client, err := sp_api.NewClient(config)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, client.Close(context.Background()))
+	})
+	return client
+}
+
+func TestServer_CreateAndDownloadReport(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.SetReportContent(reports.FBAInventoryLedgerReportSummaryView, []byte("sku\tquantity\nSKU-1\t3\n"))
+	client := newTestClient(t, srv)
+
+	content, err := client.ReportsAPI.CreateAndDownloadReport(context.Background(), &reports.CreateReportSpecification{
+		ReportType:     reports.FBAInventoryLedgerReportSummaryView,
+		MarketplaceIDs: []constants.MarketplaceID{constants.Germany},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "sku\tquantity\nSKU-1\t3\n", string(content))
+	created := srv.Reports()
+	require.Len(t, created, 1)
+	assert.Equal(t, constants.Done, created[0].ProcessingStatus)
+}
+
+func TestServer_DownloadReports(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.SetReportContent(reports.FBAInventoryLedgerReportSummaryView, []byte("sku\tquantity\nSKU-1\t3\n"))
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+	var created []reports.ReportModel
+	for i := 0; i < 5; i++ {
+		resp, err := client.ReportsAPI.CreateReport(ctx, &reports.CreateReportSpecification{ReportType: reports.FBAInventoryLedgerReportSummaryView})
+		require.NoError(t, err)
+		report, err := client.ReportsAPI.WaitForReport(ctx, resp.ResponseBody.ReportID)
+		require.NoError(t, err)
+		created = append(created, *report)
+	}
+
+	downloaded := map[string]bool{}
+	for result := range client.ReportsAPI.DownloadReports(ctx, created, 3) {
+		require.NoError(t, result.Err)
+		assert.Equal(t, "sku\tquantity\nSKU-1\t3\n", string(result.Content))
+		downloaded[result.Report.ReportID] = true
+	}
+	assert.Len(t, downloaded, len(created))
+}
+
+func TestServer_DownloadReportsCancelled(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := client.ReportsAPI.DownloadReports(ctx, make([]reports.ReportModel, 10), 2)
+	for result := range results {
+		assert.Error(t, result.Err)
+	}
+}
+
+func TestServer_ReportPolls(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.ReportPolls = 2
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	created, err := client.ReportsAPI.CreateReport(ctx, &reports.CreateReportSpecification{ReportType: reports.FBAInventoryLedgerReportSummaryView})
+	require.NoError(t, err)
+
+	var statuses []constants.ProcessingStatus
+	for i := 0; i < 3; i++ {
+		resp, err := client.ReportsAPI.GetReport(ctx, created.ResponseBody.ReportID)
+		require.NoError(t, err)
+		statuses = append(statuses, resp.ResponseBody.ProcessingStatus)
+	}
+	assert.Equal(t, []constants.ProcessingStatus{constants.InProgress, constants.InProgress, constants.Done}, statuses)
+}
+
+func TestServer_Feed(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+	content := []byte(`{"header":{"sellerId":"SELLER"},"messages":[]}`)
+
+	document, err := client.FeedsAPI.CreateFeedDocument(ctx, &feeds.CreateFeedDocumentSpecification{ContentType: "application/json"})
+	require.NoError(t, err)
+	err = client.FeedsAPI.UploadFeedDocument(ctx, document.ResponseBody, "application/json", bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	created, err := client.FeedsAPI.CreateFeed(ctx, &feeds.CreateFeedSpecification{
+		FeedType:            "JSON_LISTINGS_FEED",
+		MarketplaceIDs:      []constants.MarketplaceID{constants.Germany},
+		InputFeedDocumentId: document.ResponseBody.FeedDocumentId,
+	})
+	require.NoError(t, err)
+
+	got, err := client.FeedsAPI.GetFeed(ctx, created.ResponseBody.FeedId)
+	require.NoError(t, err)
+	assert.Equal(t, feeds.ProcessingStatusDone, got.ResponseBody.ProcessingStatus)
+	require.NotNil(t, got.ResponseBody.ResultFeedDocumentId)
+	uploaded, ok := srv.FeedContent(created.ResponseBody.FeedId)
+	assert.True(t, ok)
+	assert.Equal(t, content, uploaded)
+}
+
+func TestServer_Orders(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddOrders(
+		orders.Order{AmazonOrderId: "028-1111111-1111111", OrderStatus: "Shipped"},
+		orders.Order{AmazonOrderId: "028-2222222-2222222", OrderStatus: "Unshipped"},
+		orders.Order{AmazonOrderId: "028-3333333-3333333", OrderStatus: "Shipped"},
+	)
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	filter := &orders.GetOrdersFilter{
+		CreateAfter:       apis.JsonTimeISO8601{Time: time.Now().Add(-time.Hour)},
+		OrderStatuses:     []orders.OrderStatus{orders.OrderShipped},
+		MaxResultsPerPage: 1,
+	}
+	found, err := client.OrdersAPI.OrdersPaginator(filter, nil).All(ctx)
+	require.NoError(t, err)
+	var ids []string
+	for _, order := range found {
+		ids = append(ids, order.AmazonOrderId)
+	}
+	assert.Equal(t, []string{"028-1111111-1111111", "028-3333333-3333333"}, ids)
+
+	order, err := client.OrdersAPI.GetOrder(ctx, "028-2222222-2222222", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Unshipped", order.ResponseBody.Payload.OrderStatus)
+
+	_, err = client.OrdersAPI.GetOrder(ctx, "028-4444444-4444444", nil)
+	var apiErr *apis.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
+
+func TestServer_Ping(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := newTestClient(t, srv)
+
+	assert.NoError(t, client.Ping(context.Background()))
+
+	config := srv.Config()
+	config.TokenProvider = NewTokenProvider("Atza|unknown", 0)
+	config.Log = slog.New(slog.NewTextHandler(io.Discard, nil))
+	unauthorized, err := sp_api.NewClient(config)
+	require.NoError(t, err)
+	defer unauthorized.Close(context.Background())
+
+	err = unauthorized.Ping(context.Background())
+	var apiErr *apis.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+}
@@ -0,0 +1,119 @@
+package spapitest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fond-of-vertigo/amazon-sp-api/httpx"
+)
+
+// TokenProvider is a deterministic httpx.TokenProvider for unit tests. Set it as
+// sp_api.Config.TokenProvider to use client code without the background token updater and
+// without requests to the LWA token endpoint.
+//
+// It returns the token it was created with until the token expires on its fake clock, see
+// Advance and Expire. Each refresh returns the next token of the sequence token, token-2,
+// token-3 and so on, so tests can assert which token a request carried.
+type TokenProvider struct {
+	mu        sync.Mutex
+	token     string
+	ttl       time.Duration
+	now       time.Time
+	expiresAt time.Time
+	refreshes int
+	err       error
+}
+
+var (
+	_ httpx.TokenProvider  = (*TokenProvider)(nil)
+	_ httpx.TokenRefresher = (*TokenProvider)(nil)
+)
+
+// NewTokenProvider returns a TokenProvider issuing token, which expires after ttl on the fake
+// clock. A ttl of zero never expires. Use AccessToken to get tokens accepted by Server.
+func NewTokenProvider(token string, ttl time.Duration) *TokenProvider {
+	p := &TokenProvider{token: token, ttl: ttl, now: time.Unix(0, 0).UTC()}
+	p.expiresAt = p.expiry()
+	return p
+}
+
+// AccessToken implements httpx.TokenProvider. It refreshes the token if it expired and returns
+// the error set by SetError instead of a token.
+func (p *TokenProvider) AccessToken(_ context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return "", p.err
+	}
+	if !p.expiresAt.IsZero() && !p.now.Before(p.expiresAt) {
+		p.refresh()
+	}
+	return p.currentToken(), nil
+}
+
+// RefreshAccessToken implements httpx.TokenRefresher. It refreshes the token, unless staleToken
+// was already replaced.
+func (p *TokenProvider) RefreshAccessToken(_ context.Context, staleToken string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	if staleToken == p.currentToken() {
+		p.refresh()
+	}
+	return nil
+}
+
+// Advance moves the fake clock forward by d, expiring the token once its ttl has passed.
+func (p *TokenProvider) Advance(d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.now = p.now.Add(d)
+}
+
+// Expire expires the current token, so the next AccessToken call refreshes it.
+func (p *TokenProvider) Expire() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.expiresAt = p.now
+}
+
+// SetError makes AccessToken and RefreshAccessToken fail with err, e.g. to test the handling of
+// revoked refresh tokens. A nil err lets them succeed again.
+func (p *TokenProvider) SetError(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+}
+
+// Refreshes returns the number of token refreshes.
+func (p *TokenProvider) Refreshes() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.refreshes
+}
+
+// refresh issues the next token. p.mu must be held.
+func (p *TokenProvider) refresh() {
+	p.refreshes++
+	p.expiresAt = p.expiry()
+}
+
+// expiry returns the expiry of a token issued now. p.mu must be held.
+func (p *TokenProvider) expiry() time.Time {
+	if p.ttl <= 0 {
+		return time.Time{}
+	}
+	return p.now.Add(p.ttl)
+}
+
+// currentToken returns the token of the current refresh. p.mu must be held.
+func (p *TokenProvider) currentToken() string {
+	if p.refreshes == 0 {
+		return p.token
+	}
+	return fmt.Sprintf("%s-%d", p.token, p.refreshes+1)
+}
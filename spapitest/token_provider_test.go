@@ -0,0 +1,65 @@
+package spapitest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sp_api "github.com/fond-of-vertigo/amazon-sp-api"
+	"github.com/fond-of-vertigo/amazon-sp-api/apis/orders"
+	"github.com/fond-of-vertigo/amazon-sp-api/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenProvider(t *testing.T) {
+	ctx := context.Background()
+	p := NewTokenProvider("Atza|TOKEN", time.Hour)
+
+	token, err := p.AccessToken(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Atza|TOKEN", token)
+
+	p.Advance(59 * time.Minute)
+	token, _ = p.AccessToken(ctx)
+	assert.Equal(t, "Atza|TOKEN", token, "the token should be valid until its ttl passed")
+
+	p.Advance(time.Minute)
+	token, _ = p.AccessToken(ctx)
+	assert.Equal(t, "Atza|TOKEN-2", token)
+
+	require.NoError(t, p.RefreshAccessToken(ctx, "Atza|TOKEN"))
+	assert.Equal(t, 1, p.Refreshes(), "a stale token which was already replaced should not be refreshed")
+	require.NoError(t, p.RefreshAccessToken(ctx, "Atza|TOKEN-2"))
+	p.Expire()
+	token, _ = p.AccessToken(ctx)
+	assert.Equal(t, "Atza|TOKEN-4", token)
+	assert.Equal(t, 3, p.Refreshes())
+
+	revoked := errors.New("refresh token revoked")
+	p.SetError(revoked)
+	_, err = p.AccessToken(ctx)
+	assert.ErrorIs(t, err, revoked)
+}
+
+func TestTokenProvider_WithClient(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddOrders(orders.Order{AmazonOrderId: "028-1111111-1111111"})
+	p := NewTokenProvider(AccessToken, 0)
+
+	client, err := sp_api.NewClient(sp_api.Config{
+		Endpoint:      constants.Endpoint(srv.URL),
+		HTTPClient:    srv.Client(),
+		TokenProvider: p,
+	})
+	require.NoError(t, err)
+	defer client.Close(context.Background())
+	assert.Nil(t, client.TokenUpdater(), "no token updater should be started")
+
+	p.Expire()
+	_, err = client.OrdersAPI.GetOrder(context.Background(), "028-1111111-1111111", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, p.Refreshes())
+}